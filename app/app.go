@@ -7,10 +7,12 @@ import (
 	"runtime"
 	"time"
 
+	"github.com/kobradag/kobrad/app/dbversion"
 	"github.com/kobradag/kobrad/infrastructure/config"
 	"github.com/kobradag/kobrad/infrastructure/db/database"
-	"github.com/kobradag/kobrad/infrastructure/db/database/ldb"
+	"github.com/kobradag/kobrad/infrastructure/db/database/backends"
 	"github.com/kobradag/kobrad/infrastructure/logger"
+	"github.com/kobradag/kobrad/infrastructure/metrics"
 	"github.com/kobradag/kobrad/infrastructure/os/execenv"
 	"github.com/kobradag/kobrad/infrastructure/os/limits"
 	"github.com/kobradag/kobrad/infrastructure/os/signal"
@@ -21,8 +23,7 @@ import (
 )
 
 const (
-	leveldbCacheSizeMiB = 256
-	defaultDataDirname  = "datadir2"
+	defaultDataDirname = "datadir2"
 )
 
 var desiredLimits = &limits.DesiredLimits{
@@ -89,6 +90,11 @@ func (app *kobradApp) main(startedChan chan<- struct{}) error {
 	}
 	profiling.TrackHeap(app.cfg.AppDir, log)
 
+	// Enable the metrics server if requested.
+	if app.cfg.MetricsListen != "" {
+		metrics.Start(app.cfg.MetricsListen)
+	}
+
 	// Return now if an interrupt signal was triggered.
 	if signal.InterruptRequested(interrupt) {
 		return nil
@@ -174,13 +180,13 @@ func removeDatabase(cfg *config.Config) error {
 func openDB(cfg *config.Config) (database.Database, error) {
 	dbPath := databasePath(cfg)
 
-	err := checkDatabaseVersion(dbPath)
+	err := dbversion.Check(dbPath)
 	if err != nil {
 		return nil, err
 	}
 
-	log.Infof("Loading database from '%s'", dbPath)
-	db, err := ldb.NewLevelDB(dbPath, leveldbCacheSizeMiB)
+	log.Infof("Loading %s database from '%s'", cfg.DbType, dbPath)
+	db, err := backends.Open(cfg.DbType, dbPath, cfg.DatabaseOptions())
 	if err != nil {
 		return nil, err
 	}
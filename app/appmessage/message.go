@@ -163,6 +163,18 @@ const (
 	CmdGetMempoolEntriesByAddressesResponseMessage
 	CmdGetCoinSupplyRequestMessage
 	CmdGetCoinSupplyResponseMessage
+	CmdGetTransactionRequestMessage
+	CmdGetTransactionResponseMessage
+	CmdGetFeeEstimateRequestMessage
+	CmdGetFeeEstimateResponseMessage
+	CmdSubmitTransactionReplacementRequestMessage
+	CmdSubmitTransactionReplacementResponseMessage
+	CmdGetTransactionsByAddressesRequestMessage
+	CmdGetTransactionsByAddressesResponseMessage
+	CmdGetDatabaseStatsRequestMessage
+	CmdGetDatabaseStatsResponseMessage
+	CmdBackupRequestMessage
+	CmdBackupResponseMessage
 )
 
 // ProtocolMessageCommandToString maps all MessageCommands to their string representation
@@ -259,6 +271,8 @@ var RPCMessageCommandToString = map[MessageCommand]string{
 	CmdFinalityConflictResolvedNotificationMessage:                "FinalityConflictResolvedNotification",
 	CmdGetMempoolEntriesRequestMessage:                            "GetMempoolEntriesRequest",
 	CmdGetMempoolEntriesResponseMessage:                           "GetMempoolEntriesResponse",
+	CmdShutDownRequestMessage:                                     "ShutDownRequest",
+	CmdShutDownResponseMessage:                                    "ShutDownResponse",
 	CmdGetHeadersRequestMessage:                                   "GetHeadersRequest",
 	CmdGetHeadersResponseMessage:                                  "GetHeadersResponse",
 	CmdNotifyUTXOsChangedRequestMessage:                           "NotifyUTXOsChangedRequest",
@@ -300,6 +314,18 @@ var RPCMessageCommandToString = map[MessageCommand]string{
 	CmdGetMempoolEntriesByAddressesResponseMessage:                "GetMempoolEntriesByAddressesResponse",
 	CmdGetCoinSupplyRequestMessage:                                "GetCoinSupplyRequest",
 	CmdGetCoinSupplyResponseMessage:                               "GetCoinSupplyResponse",
+	CmdGetTransactionRequestMessage:                               "GetTransactionRequest",
+	CmdGetTransactionResponseMessage:                              "GetTransactionResponse",
+	CmdGetFeeEstimateRequestMessage:                               "GetFeeEstimateRequest",
+	CmdGetFeeEstimateResponseMessage:                              "GetFeeEstimateResponse",
+	CmdSubmitTransactionReplacementRequestMessage:                 "SubmitTransactionReplacementRequest",
+	CmdSubmitTransactionReplacementResponseMessage:                "SubmitTransactionReplacementResponse",
+	CmdGetTransactionsByAddressesRequestMessage:                   "GetTransactionsByAddressesRequest",
+	CmdGetTransactionsByAddressesResponseMessage:                  "GetTransactionsByAddressesResponse",
+	CmdGetDatabaseStatsRequestMessage:                             "GetDatabaseStatsRequest",
+	CmdGetDatabaseStatsResponseMessage:                            "GetDatabaseStatsResponse",
+	CmdBackupRequestMessage:                                       "BackupRequest",
+	CmdBackupResponseMessage:                                      "BackupResponse",
 }
 
 // Message is an interface that describes a kobra message. A type that
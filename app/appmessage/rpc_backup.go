@@ -0,0 +1,48 @@
+package appmessage
+
+// BackupRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type BackupRequestMessage struct {
+	baseMessage
+	TargetDirectory string
+}
+
+// Command returns the protocol command string for the message
+func (msg *BackupRequestMessage) Command() MessageCommand {
+	return CmdBackupRequestMessage
+}
+
+// NewBackupRequestMessage returns a instance of the message
+func NewBackupRequestMessage(targetDirectory string) *BackupRequestMessage {
+	return &BackupRequestMessage{
+		TargetDirectory: targetDirectory,
+	}
+}
+
+// BackupSection describes the data of a single section of a backup
+type BackupSection struct {
+	Name     string
+	KeyCount uint64
+	Size     uint64
+}
+
+// BackupResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type BackupResponseMessage struct {
+	baseMessage
+	Sections []*BackupSection
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *BackupResponseMessage) Command() MessageCommand {
+	return CmdBackupResponseMessage
+}
+
+// NewBackupResponseMessage returns a instance of the message
+func NewBackupResponseMessage(sections []*BackupSection) *BackupResponseMessage {
+	return &BackupResponseMessage{
+		Sections: sections,
+	}
+}
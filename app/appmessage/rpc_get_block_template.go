@@ -6,6 +6,21 @@ type GetBlockTemplateRequestMessage struct {
 	baseMessage
 	PayAddress string
 	ExtraData  string
+
+	// Payees, if not empty, are the scripts the block rewards are
+	// split to instead of PayAddress
+	Payees []*RPCCoinbasePayee
+
+	// LongPollID, if set, delays the response until the template
+	// changes materially from the template of this ID
+	LongPollID string
+}
+
+// RPCCoinbasePayee is a script the block rewards are paid to in
+// proportion to its weight
+type RPCCoinbasePayee struct {
+	ScriptPublicKey *RPCScriptPublicKey
+	Weight          uint32
 }
 
 // Command returns the protocol command string for the message
@@ -21,12 +36,22 @@ func NewGetBlockTemplateRequestMessage(payAddress, extraData string) *GetBlockTe
 	}
 }
 
+// NewGetBlockTemplateRequestMessageWithPayees returns a instance of the message
+// whose block rewards are split to the given payees
+func NewGetBlockTemplateRequestMessageWithPayees(payees []*RPCCoinbasePayee, extraData string) *GetBlockTemplateRequestMessage {
+	return &GetBlockTemplateRequestMessage{
+		ExtraData: extraData,
+		Payees:    payees,
+	}
+}
+
 // GetBlockTemplateResponseMessage is an appmessage corresponding to
 // its respective RPC message
 type GetBlockTemplateResponseMessage struct {
 	baseMessage
-	Block    *RPCBlock
-	IsSynced bool
+	Block      *RPCBlock
+	IsSynced   bool
+	TemplateID string
 
 	Error *RPCError
 }
@@ -37,9 +62,10 @@ func (msg *GetBlockTemplateResponseMessage) Command() MessageCommand {
 }
 
 // NewGetBlockTemplateResponseMessage returns a instance of the message
-func NewGetBlockTemplateResponseMessage(block *RPCBlock, isSynced bool) *GetBlockTemplateResponseMessage {
+func NewGetBlockTemplateResponseMessage(block *RPCBlock, isSynced bool, templateID string) *GetBlockTemplateResponseMessage {
 	return &GetBlockTemplateResponseMessage{
-		Block:    block,
-		IsSynced: isSynced,
+		Block:      block,
+		IsSynced:   isSynced,
+		TemplateID: templateID,
 	}
 }
@@ -0,0 +1,55 @@
+package appmessage
+
+// GetDatabaseStatsRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type GetDatabaseStatsRequestMessage struct {
+	baseMessage
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetDatabaseStatsRequestMessage) Command() MessageCommand {
+	return CmdGetDatabaseStatsRequestMessage
+}
+
+// NewGetDatabaseStatsRequestMessage returns a instance of the message
+func NewGetDatabaseStatsRequestMessage() *GetDatabaseStatsRequestMessage {
+	return &GetDatabaseStatsRequestMessage{}
+}
+
+// DatabaseLevelStats holds the statistics of a single level of the database
+type DatabaseLevelStats struct {
+	Level                  uint32
+	TableCount             int64
+	Size                   int64
+	ReadBytes              int64
+	WriteBytes             int64
+	CompactionMilliseconds uint64
+}
+
+// GetDatabaseStatsResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type GetDatabaseStatsResponseMessage struct {
+	baseMessage
+	DatabaseType             string
+	Levels                   []*DatabaseLevelStats
+	MemCompactionCount       uint32
+	Level0CompactionCount    uint32
+	NonLevel0CompactionCount uint32
+	SeekCompactionCount      uint32
+	WriteDelayCount          int32
+	WriteDelayMilliseconds   uint64
+	IsWritePaused            bool
+	ReadBytes                uint64
+	WriteBytes               uint64
+	BlockCacheSize           int64
+	OpenedTableCount         int64
+	AliveSnapshotCount       int32
+	AliveIteratorCount       int32
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetDatabaseStatsResponseMessage) Command() MessageCommand {
+	return CmdGetDatabaseStatsResponseMessage
+}
@@ -0,0 +1,67 @@
+package appmessage
+
+// AddressIndexUnavailableMessage is the error message of the address index
+// methods when kobrad is run without --addressindex
+const AddressIndexUnavailableMessage = "Method unavailable when kobrad is run without --addressindex"
+
+// GetTransactionsByAddressesRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type GetTransactionsByAddressesRequestMessage struct {
+	baseMessage
+	Addresses []string
+	Cursor    string
+	Limit     uint32
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetTransactionsByAddressesRequestMessage) Command() MessageCommand {
+	return CmdGetTransactionsByAddressesRequestMessage
+}
+
+// NewGetTransactionsByAddressesRequestMessage returns a instance of the message
+func NewGetTransactionsByAddressesRequestMessage(addresses []string, cursor string,
+	limit uint32) *GetTransactionsByAddressesRequestMessage {
+
+	return &GetTransactionsByAddressesRequestMessage{
+		Addresses: addresses,
+		Cursor:    cursor,
+		Limit:     limit,
+	}
+}
+
+// GetTransactionsByAddressesResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type GetTransactionsByAddressesResponseMessage struct {
+	baseMessage
+	Entries    []*TransactionsByAddressesEntry
+	NextCursor string
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetTransactionsByAddressesResponseMessage) Command() MessageCommand {
+	return CmdGetTransactionsByAddressesResponseMessage
+}
+
+// NewGetTransactionsByAddressesResponseMessage returns a instance of the message
+func NewGetTransactionsByAddressesResponseMessage(entries []*TransactionsByAddressesEntry,
+	nextCursor string) *GetTransactionsByAddressesResponseMessage {
+
+	return &GetTransactionsByAddressesResponseMessage{
+		Entries:    entries,
+		NextCursor: nextCursor,
+	}
+}
+
+// TransactionsByAddressesEntry represents an accepted transaction that
+// credited or debited an address
+type TransactionsByAddressesEntry struct {
+	Address                string
+	TransactionID          string
+	IncludingBlockHash     string
+	AcceptingBlockHash     string
+	AcceptingBlockDAAScore uint64
+	Received               uint64
+	Sent                   uint64
+}
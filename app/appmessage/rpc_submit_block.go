@@ -6,6 +6,13 @@ type SubmitBlockRequestMessage struct {
 	baseMessage
 	Block             *RPCBlock
 	AllowNonDAABlocks bool
+
+	// TemplateID, if set, is the ID of the template the submitted block
+	// was mined from, and replaces Block along with Nonce and Timestamp.
+	// A Timestamp of 0 keeps the timestamp of the template.
+	TemplateID string
+	Nonce      uint64
+	Timestamp  int64
 }
 
 // Command returns the protocol command string for the message
@@ -21,6 +28,19 @@ func NewSubmitBlockRequestMessage(block *RPCBlock, allowNonDAABlocks bool) *Subm
 	}
 }
 
+// NewSubmitBlockByTemplateIDRequestMessage returns a instance of the message
+// that submits the block mined from the template of the given ID
+func NewSubmitBlockByTemplateIDRequestMessage(templateID string, nonce uint64, timestamp int64,
+	allowNonDAABlocks bool) *SubmitBlockRequestMessage {
+
+	return &SubmitBlockRequestMessage{
+		AllowNonDAABlocks: allowNonDAABlocks,
+		TemplateID:        templateID,
+		Nonce:             nonce,
+		Timestamp:         timestamp,
+	}
+}
+
 // RejectReason describes the reason why a block sent by SubmitBlock was rejected
 type RejectReason byte
 
@@ -0,0 +1,217 @@
+// Package backup writes consistent copies of the database of a running node,
+// and restores them into the database directory of a stopped node.
+//
+// A backup is a database directory in its own right, which holds the data
+// of the active consensus, the UTXO index and the address manager. The
+// transaction index and the address index aren't backed up, since they
+// are rebuilt from consensus when the restored node starts.
+package backup
+
+import (
+	"os"
+	"sync"
+
+	"github.com/kobradag/kobrad/app/dbversion"
+	"github.com/kobradag/kobrad/domain/prefixmanager"
+	"github.com/kobradag/kobrad/domain/utxoindex"
+	"github.com/kobradag/kobrad/infrastructure/db/database"
+	"github.com/kobradag/kobrad/infrastructure/db/database/backends"
+	"github.com/kobradag/kobrad/infrastructure/network/addressmanager"
+	"github.com/pkg/errors"
+)
+
+// maxBatchSize is the size of the data that is copied in a single
+// database transaction
+const maxBatchSize = 16 * 1024 * 1024
+
+// SectionStats describes the data of a single section of a backup
+type SectionStats struct {
+	Name     string
+	KeyCount uint64
+	Size     uint64
+}
+
+// section is a part of the database that is backed up
+type section struct {
+	name    string
+	buckets []*database.Bucket
+	keys    []*database.Key
+}
+
+// backupLock makes sure that a single backup is written at a time
+var backupLock sync.Mutex
+
+// Backup writes a consistent copy of the given database into a new database
+// of the given type at targetPath. The copy is read from a snapshot, so the
+// database may keep being written to while it's backed up. The version file
+// is written last, so an interrupted backup can't be restored.
+func Backup(db database.Database, targetPath string, dbType string, options *backends.Options) ([]*SectionStats, error) {
+	if !backupLock.TryLock() {
+		return nil, errors.New("a backup is already in progress")
+	}
+	defer backupLock.Unlock()
+
+	err := checkTargetIsEmpty(targetPath)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, err := db.Snapshot()
+	if err != nil {
+		return nil, err
+	}
+	defer snapshot.Release()
+
+	activePrefix, exists, err := prefixmanager.ActivePrefix(snapshot)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.New("the database has no active consensus to back up")
+	}
+
+	utxoIndexBuckets, utxoIndexKeys := utxoindex.StoredData()
+	addressManagerBuckets, addressManagerKeys := addressmanager.StoredData()
+	sections := []*section{
+		{
+			name:    "consensus",
+			buckets: []*database.Bucket{database.MakeBucket(activePrefix.Serialize())},
+		},
+		{
+			name:    "utxoindex",
+			buckets: utxoIndexBuckets,
+			keys:    utxoIndexKeys,
+		},
+		{
+			name:    "addressmanager",
+			buckets: addressManagerBuckets,
+			keys:    addressManagerKeys,
+		},
+	}
+
+	log.Infof("Backing up the database to %s", targetPath)
+	target, err := backends.Open(dbType, targetPath, options)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := backupSections(snapshot, target, sections)
+	if err == nil {
+		err = prefixmanager.SetPrefixAsActive(target, activePrefix)
+	}
+	closeErr := target.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = dbversion.Write(targetPath)
+	}
+	if err != nil {
+		removeErr := os.RemoveAll(targetPath)
+		if removeErr != nil {
+			log.Warnf("Failed removing the incomplete backup at %s: %s", targetPath, removeErr)
+		}
+		return nil, err
+	}
+
+	for _, sectionStats := range stats {
+		log.Infof("Backed up %d keys (%d bytes) of %s", sectionStats.KeyCount, sectionStats.Size, sectionStats.Name)
+	}
+	return stats, nil
+}
+
+func backupSections(snapshot database.Snapshot, target database.Database, sections []*section) ([]*SectionStats, error) {
+	stats := make([]*SectionStats, len(sections))
+	for i, section := range sections {
+		stats[i] = &SectionStats{Name: section.name}
+		for _, bucket := range section.buckets {
+			err := copyBucket(snapshot, target, bucket, stats[i])
+			if err != nil {
+				return nil, err
+			}
+		}
+		for _, key := range section.keys {
+			value, err := snapshot.Get(key)
+			if database.IsNotFoundError(err) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			err = target.Put(key, value)
+			if err != nil {
+				return nil, err
+			}
+			stats[i].KeyCount++
+			stats[i].Size += uint64(len(value))
+		}
+	}
+	return stats, nil
+}
+
+// copyBucket copies the data of the given bucket from one database to
+// another, in transactions of up to maxBatchSize, and adds it to the
+// given stats
+func copyBucket(from database.DataReader, to database.Database, bucket *database.Bucket, stats *SectionStats) error {
+	cursor, err := from.Cursor(bucket)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+
+	dbTx, err := to.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// dbTx is replaced by every commit
+		dbTx.RollbackUnlessClosed()
+	}()
+
+	batchSize := 0
+	for ok := cursor.First(); ok; ok = cursor.Next() {
+		key, err := cursor.Key()
+		if err != nil {
+			return err
+		}
+		value, err := cursor.Value()
+		if err != nil {
+			return err
+		}
+		err = dbTx.Put(key, value)
+		if err != nil {
+			return err
+		}
+		stats.KeyCount++
+		stats.Size += uint64(len(value))
+
+		batchSize += len(key.Bytes()) + len(value)
+		if batchSize >= maxBatchSize {
+			err := dbTx.Commit()
+			if err != nil {
+				return err
+			}
+			dbTx, err = to.Begin()
+			if err != nil {
+				return err
+			}
+			batchSize = 0
+		}
+	}
+	return dbTx.Commit()
+}
+
+// checkTargetIsEmpty returns an error if there's anything at the given path,
+// other than an empty directory
+func checkTargetIsEmpty(path string) error {
+	entries, err := os.ReadDir(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if len(entries) > 0 {
+		return errors.Errorf("%s is not empty", path)
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package backup
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kobradag/kobrad/domain/prefixmanager"
+	"github.com/kobradag/kobrad/domain/prefixmanager/prefix"
+	"github.com/kobradag/kobrad/domain/utxoindex"
+	"github.com/kobradag/kobrad/infrastructure/db/database"
+	"github.com/kobradag/kobrad/infrastructure/db/database/backends"
+	"github.com/kobradag/kobrad/infrastructure/network/addressmanager"
+)
+
+func TestBackupAndRestore(t *testing.T) {
+	directory := t.TempDir()
+	options := backends.DefaultOptions(8)
+	db, err := backends.Open("ldb", filepath.Join(directory, "node"), options)
+	if err != nil {
+		t.Fatalf("Open: %+v", err)
+	}
+	defer db.Close()
+
+	activePrefix, err := prefix.Deserialize([]byte{0})
+	if err != nil {
+		t.Fatalf("Deserialize: %+v", err)
+	}
+	err = prefixmanager.SetPrefixAsActive(db, activePrefix)
+	if err != nil {
+		t.Fatalf("SetPrefixAsActive: %+v", err)
+	}
+	err = prefixmanager.SetPrefixAsInactive(db, activePrefix.Flip())
+	if err != nil {
+		t.Fatalf("SetPrefixAsInactive: %+v", err)
+	}
+
+	utxoIndexBuckets, utxoIndexKeys := utxoindex.StoredData()
+	addressManagerBuckets, _ := addressmanager.StoredData()
+	activeConsensusKey := database.MakeBucket(activePrefix.Serialize()).Key([]byte("block"))
+	backedUpKeys := []*database.Key{
+		activeConsensusKey,
+		utxoIndexBuckets[0].Key([]byte("utxo")),
+		utxoIndexKeys[0],
+		addressManagerBuckets[0].Key([]byte("address")),
+	}
+	skippedKeys := []*database.Key{
+		database.MakeBucket(activePrefix.Flip().Serialize()).Key([]byte("staging block")),
+		database.MakeBucket([]byte("tx-index")).Key([]byte("tx")),
+	}
+	for _, key := range append(backedUpKeys, skippedKeys...) {
+		err := db.Put(key, key.Bytes())
+		if err != nil {
+			t.Fatalf("Put: %+v", err)
+		}
+	}
+
+	backupPath := filepath.Join(directory, "backup")
+	stats, err := Backup(db, backupPath, "ldb", options)
+	if err != nil {
+		t.Fatalf("Backup: %+v", err)
+	}
+	expectedKeyCounts := map[string]uint64{"consensus": 1, "utxoindex": 2, "addressmanager": 1}
+	for _, sectionStats := range stats {
+		if sectionStats.KeyCount != expectedKeyCounts[sectionStats.Name] {
+			t.Fatalf("Backed up %d keys of %s, want %d",
+				sectionStats.KeyCount, sectionStats.Name, expectedKeyCounts[sectionStats.Name])
+		}
+	}
+
+	// Writes that happen after the backup aren't in it
+	err = db.Put(activeConsensusKey, []byte("modified"))
+	if err != nil {
+		t.Fatalf("Put: %+v", err)
+	}
+
+	_, err = Backup(db, backupPath, "ldb", options)
+	if err == nil || !strings.Contains(err.Error(), "not empty") {
+		t.Fatalf("Backing up into a directory that isn't empty returned %v", err)
+	}
+
+	// The backup is restored into a database of another type
+	restoredPath := filepath.Join(directory, "restored")
+	keyCount, err := Restore(backupPath, restoredPath, "logdb", options)
+	if err != nil {
+		t.Fatalf("Restore: %+v", err)
+	}
+	if keyCount != 5 {
+		t.Fatalf("Restored %d keys, want 5", keyCount)
+	}
+	restoredDB, err := backends.OpenExisting(restoredPath, options)
+	if err != nil {
+		t.Fatalf("OpenExisting: %+v", err)
+	}
+	defer restoredDB.Close()
+
+	restoredPrefix, exists, err := prefixmanager.ActivePrefix(restoredDB)
+	if err != nil || !exists || !restoredPrefix.Equal(activePrefix) {
+		t.Fatalf("The restored active prefix is %v, exists: %t, error: %v", restoredPrefix, exists, err)
+	}
+	for _, key := range backedUpKeys {
+		value, err := restoredDB.Get(key)
+		if err != nil {
+			t.Fatalf("Get %s: %+v", key, err)
+		}
+		if !bytes.Equal(value, key.Bytes()) {
+			t.Fatalf("Get %s returned %x, want %x", key, value, key.Bytes())
+		}
+	}
+	for _, key := range skippedKeys {
+		exists, err := restoredDB.Has(key)
+		if err != nil {
+			t.Fatalf("Has %s: %+v", key, err)
+		}
+		if exists {
+			t.Fatalf("The key %s was unexpectedly restored", key)
+		}
+	}
+	_, exists, err = prefixmanager.InactivePrefix(restoredDB)
+	if err != nil || exists {
+		t.Fatalf("The inactive prefix was restored, or an error: %v", err)
+	}
+
+	_, err = Restore(backupPath, restoredPath, "", options)
+	if err == nil || !strings.Contains(err.Error(), "already a database") {
+		t.Fatalf("Restoring over an existing database returned %v", err)
+	}
+
+	// A backup of another version of the database isn't restored
+	err = os.WriteFile(filepath.Join(backupPath, "version"), []byte("0"), 0600)
+	if err != nil {
+		t.Fatalf("WriteFile: %+v", err)
+	}
+	_, err = Restore(backupPath, filepath.Join(directory, "other"), "", options)
+	if err == nil || !strings.Contains(err.Error(), "database version 0") {
+		t.Fatalf("Restoring a backup of another version returned %v", err)
+	}
+	_, err = os.Stat(filepath.Join(directory, "other"))
+	if !os.IsNotExist(err) {
+		t.Fatalf("A database was created by a failed restore: %v", err)
+	}
+}
@@ -0,0 +1,7 @@
+package backup
+
+import (
+	"github.com/kobradag/kobrad/infrastructure/logger"
+)
+
+var log = logger.RegisterSubSystem("BKUP")
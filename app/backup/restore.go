@@ -0,0 +1,96 @@
+package backup
+
+import (
+	"os"
+
+	"github.com/kobradag/kobrad/app/dbversion"
+	"github.com/kobradag/kobrad/infrastructure/db/database"
+	"github.com/kobradag/kobrad/infrastructure/db/database/backends"
+	"github.com/pkg/errors"
+)
+
+// restoreDirectorySuffix is appended to the path of the restored database
+// for the directory that the backup is restored into. The directory is
+// renamed once the restore is done, so that an interrupted restore never
+// leaves an incomplete database in place.
+const restoreDirectorySuffix = ".restore"
+
+// Restore restores the backup at backupPath into a new database of the given
+// type at dbPath, or of the type of the backup if dbType is empty. It fails
+// if the version of the backup isn't the version of the database of this
+// version of kobrad, or if there's already a database at dbPath.
+func Restore(backupPath string, dbPath string, dbType string, options *backends.Options) (keyCount uint64, err error) {
+	version, exists, err := dbversion.Read(backupPath)
+	if err != nil {
+		return 0, err
+	}
+	if !exists {
+		return 0, errors.Errorf("%s is not a complete backup: it has no version file", backupPath)
+	}
+	if version != dbversion.Current {
+		return 0, errors.Errorf("the backup at %s has database version %d, while this version of kobrad "+
+			"expects version %d", backupPath, version, dbversion.Current)
+	}
+
+	_, err = os.Stat(dbPath)
+	if err == nil {
+		return 0, errors.Errorf("there's already a database at %s", dbPath)
+	}
+	if !os.IsNotExist(err) {
+		return 0, errors.WithStack(err)
+	}
+
+	if dbType == "" {
+		dbType, err = backends.TypeOf(backupPath)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	restorePath := dbPath + restoreDirectorySuffix
+	err = os.RemoveAll(restorePath)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	stats, err := restoreInto(backupPath, restorePath, dbType, options)
+	if err == nil {
+		err = dbversion.Write(restorePath)
+	}
+	if err == nil {
+		err = errors.WithStack(os.Rename(restorePath, dbPath))
+	}
+	if err != nil {
+		removeErr := os.RemoveAll(restorePath)
+		if removeErr != nil {
+			log.Warnf("Failed removing the incomplete restore at %s: %s", restorePath, removeErr)
+		}
+		return 0, err
+	}
+	return stats.KeyCount, nil
+}
+
+// restoreInto copies all the data of the backup into a new database
+func restoreInto(backupPath string, restorePath string, dbType string, options *backends.Options) (*SectionStats, error) {
+	backupDB, err := backends.OpenExisting(backupPath, options)
+	if err != nil {
+		return nil, err
+	}
+	defer backupDB.Close()
+
+	restoredDB, err := backends.Open(dbType, restorePath, options)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &SectionStats{}
+	err = copyBucket(backupDB, restoredDB, database.MakeBucket(nil), stats)
+	closeErr := restoredDB.Close()
+	if err != nil {
+		return nil, err
+	}
+	if closeErr != nil {
+		return nil, closeErr
+	}
+	return stats, nil
+}
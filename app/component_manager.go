@@ -10,8 +10,12 @@ import (
 
 	"github.com/kobradag/kobrad/app/protocol"
 	"github.com/kobradag/kobrad/app/rpc"
+	"github.com/kobradag/kobrad/app/rpc/rpcauth"
 	"github.com/kobradag/kobrad/domain"
+	"github.com/kobradag/kobrad/domain/addressindex"
 	"github.com/kobradag/kobrad/domain/consensus"
+	"github.com/kobradag/kobrad/domain/mempoolstore"
+	"github.com/kobradag/kobrad/domain/txindex"
 	"github.com/kobradag/kobrad/domain/utxoindex"
 	"github.com/kobradag/kobrad/infrastructure/config"
 	infrastructuredatabase "github.com/kobradag/kobrad/infrastructure/db/database"
@@ -30,6 +34,7 @@ type ComponentManager struct {
 	rpcManager        *rpc.Manager
 	connectionManager *connmanager.ConnectionManager
 	netAdapter        *netadapter.NetAdapter
+	mempoolStore      *mempoolstore.MempoolStore
 
 	started, shutdown int32
 }
@@ -43,6 +48,14 @@ func (a *ComponentManager) Start() {
 
 	log.Trace("Starting kobrad")
 
+	if a.mempoolStore != nil {
+		err := a.mempoolStore.Load()
+		if err != nil {
+			log.Errorf("Error loading the persisted mempool: %+v", err)
+		}
+		a.mempoolStore.Start()
+	}
+
 	err := a.netAdapter.Start()
 	if err != nil {
 		panics.Exit(log, fmt.Sprintf("Error starting the net adapter: %+v", err))
@@ -68,6 +81,13 @@ func (a *ComponentManager) Stop() {
 		log.Errorf("Error stopping the net adapter: %+v", err)
 	}
 
+	if a.mempoolStore != nil {
+		err = a.mempoolStore.Stop()
+		if err != nil {
+			log.Errorf("Error persisting the mempool: %+v", err)
+		}
+	}
+
 	a.protocolManager.Close()
 	close(a.protocolManager.Context().Domain().ConsensusEventsChannel())
 
@@ -113,6 +133,31 @@ func NewComponentManager(cfg *config.Config, db infrastructuredatabase.Database,
 		log.Infof("UTXO index started")
 	}
 
+	var txIndex *txindex.TXIndex
+	if cfg.TXIndex {
+		txIndex, err = txindex.New(domain, db)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Infof("TX index started")
+	}
+
+	var addressIndex *addressindex.AddressIndex
+	if cfg.AddressIndex {
+		addressIndex, err = addressindex.New(domain, db)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Infof("Address index started")
+	}
+
+	var mempoolStore *mempoolstore.MempoolStore
+	if !cfg.NoMempoolPersistence {
+		mempoolStore = mempoolstore.New(domain, db)
+	}
+
 	connectionManager, err := connmanager.New(cfg, netAdapter, addressManager)
 	if err != nil {
 		return nil, err
@@ -121,7 +166,10 @@ func NewComponentManager(cfg *config.Config, db infrastructuredatabase.Database,
 	if err != nil {
 		return nil, err
 	}
-	rpcManager := setupRPC(cfg, domain, netAdapter, protocolManager, connectionManager, addressManager, utxoIndex, domain.ConsensusEventsChannel(), interrupt)
+	rpcManager, err := setupRPC(cfg, domain, db, netAdapter, protocolManager, connectionManager, addressManager, utxoIndex, txIndex, addressIndex, domain.ConsensusEventsChannel(), interrupt)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ComponentManager{
 		cfg:               cfg,
@@ -130,6 +178,7 @@ func NewComponentManager(cfg *config.Config, db infrastructuredatabase.Database,
 		connectionManager: connectionManager,
 		netAdapter:        netAdapter,
 		addressManager:    addressManager,
+		mempoolStore:      mempoolStore,
 	}, nil
 
 }
@@ -137,30 +186,42 @@ func NewComponentManager(cfg *config.Config, db infrastructuredatabase.Database,
 func setupRPC(
 	cfg *config.Config,
 	domain domain.Domain,
+	db infrastructuredatabase.Database,
 	netAdapter *netadapter.NetAdapter,
 	protocolManager *protocol.Manager,
 	connectionManager *connmanager.ConnectionManager,
 	addressManager *addressmanager.AddressManager,
 	utxoIndex *utxoindex.UTXOIndex,
+	txIndex *txindex.TXIndex,
+	addressIndex *addressindex.AddressIndex,
 	consensusEventsChan chan externalapi.ConsensusEvent,
 	shutDownChan chan<- struct{},
-) *rpc.Manager {
+) (*rpc.Manager, error) {
+
+	authenticator, err := rpcauth.New(cfg.RPCUsers, cfg.RPCTokens, cfg.RPCRoles)
+	if err != nil {
+		return nil, err
+	}
 
 	rpcManager := rpc.NewManager(
 		cfg,
 		domain,
+		db,
 		netAdapter,
 		protocolManager,
 		connectionManager,
 		addressManager,
 		utxoIndex,
+		txIndex,
+		addressIndex,
 		consensusEventsChan,
 		shutDownChan,
+		authenticator,
 	)
 	protocolManager.SetOnNewBlockTemplateHandler(rpcManager.NotifyNewBlockTemplate)
 	protocolManager.SetOnPruningPointUTXOSetOverrideHandler(rpcManager.NotifyPruningPointUTXOSetOverride)
 
-	return rpcManager
+	return rpcManager, nil
 }
 
 // P2PNodeID returns the network ID associated with this ComponentManager
@@ -0,0 +1,76 @@
+// Package dbversion manages the version file of the kobrad database, which
+// records the version of the format of the data in the database.
+package dbversion
+
+import (
+	"os"
+	"path"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// Current is the version of the database that this version of kobrad uses
+const Current = 1
+
+// Check checks that the database at the given path is of the current
+// version. A database without a version file is assumed to be new, and the
+// file is created for it.
+func Check(dbPath string) (err error) {
+	databaseVersion, exists, err := Read(dbPath)
+	if err != nil {
+		return err
+	}
+	if !exists { // If version file doesn't exist, we assume that the database is new
+		return Write(dbPath)
+	}
+
+	if databaseVersion != Current {
+		// TODO: Once there's more then one database version, it might make sense to add upgrade logic at this point
+		return errors.Errorf("Invalid database version %d. Expected version: %d", databaseVersion, Current)
+	}
+
+	return nil
+}
+
+// Read returns the version of the database at the given path, and false if
+// the database has no version file
+func Read(dbPath string) (version int, exists bool, err error) {
+	versionBytes, err := os.ReadFile(versionFilePath(dbPath))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+
+	databaseVersion, err := strconv.Atoi(string(versionBytes))
+	if err != nil {
+		return 0, false, err
+	}
+	return databaseVersion, true, nil
+}
+
+// Write writes the current version to the version file of the database at
+// the given path
+func Write(dbPath string) error {
+	err := os.MkdirAll(dbPath, 0700)
+	if err != nil {
+		return err
+	}
+
+	versionFile, err := os.Create(versionFilePath(dbPath))
+	if err != nil {
+		return err
+	}
+	defer versionFile.Close()
+
+	versionString := strconv.Itoa(Current)
+	_, err = versionFile.Write([]byte(versionString))
+	return err
+}
+
+func versionFilePath(dbPath string) string {
+	dbVersionFileName := path.Join(dbPath, "version")
+	return dbVersionFileName
+}
@@ -0,0 +1,24 @@
+package flowcontext
+
+import (
+	peerpkg "github.com/kobradag/kobrad/app/protocol/peer"
+	"github.com/kobradag/kobrad/infrastructure/metrics"
+)
+
+var (
+	ibdRunning = metrics.NewGauge("kobrad_ibd_running",
+		"Whether the node is currently running IBD (1) or not (0)")
+
+	outboundPeers = metrics.NewGauge("kobrad_peers_outbound",
+		"The number of ready outbound peers")
+	inboundPeers = metrics.NewGauge("kobrad_peers_inbound",
+		"The number of ready inbound peers")
+)
+
+// peerCountGauge returns the gauge counting peers of the same direction as the given peer
+func peerCountGauge(peer *peerpkg.Peer) *metrics.Gauge {
+	if peer.IsOutbound() {
+		return outboundPeers
+	}
+	return inboundPeers
+}
@@ -29,6 +29,7 @@ func (f *FlowContext) AddToPeers(peer *peerpkg.Peer) error {
 	}
 
 	f.peers[*peer.ID()] = peer
+	peerCountGauge(peer).Inc()
 
 	return nil
 }
@@ -38,7 +39,11 @@ func (f *FlowContext) RemoveFromPeers(peer *peerpkg.Peer) {
 	f.peersMutex.Lock()
 	defer f.peersMutex.Unlock()
 
+	if _, ok := f.peers[*peer.ID()]; !ok {
+		return
+	}
 	delete(f.peers, *peer.ID())
+	peerCountGauge(peer).Dec()
 }
 
 // readyPeerConnections returns the NetConnections of all the ready peers.
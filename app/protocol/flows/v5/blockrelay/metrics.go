@@ -0,0 +1,12 @@
+package blockrelay
+
+import (
+	"github.com/kobradag/kobrad/infrastructure/metrics"
+)
+
+var (
+	ibdProcessed = metrics.NewCounterVec("kobrad_ibd_processed_total",
+		"The number of objects processed during IBD, by object type", "object")
+	ibdProgressPercent = metrics.NewGauge("kobrad_ibd_progress_percent",
+		"The progress of the current or last IBD stage, in percent")
+)
@@ -185,19 +185,20 @@ func (flow *handleRelayedTransactionsFlow) receiveTransactions(requestedTransact
 
 		acceptedTransactions, err :=
 			flow.Domain().MiningManager().ValidateAndInsertTransaction(tx, false, true)
+		if err != nil && rejectCode(err) == mempool.RejectDoubleSpend {
+			// The transaction might be a replacement of the transactions it
+			// double spends, so it gets the same chance it would have gotten
+			// had it been submitted to this node
+			acceptedTransactions, _, err =
+				flow.Domain().MiningManager().ValidateAndInsertTransactionReplacement(tx, false)
+		}
 		if err != nil {
 			ruleErr := &mempool.RuleError{}
 			if !errors.As(err, ruleErr) {
 				return errors.Wrapf(err, "failed to process transaction %s", txID)
 			}
 
-			shouldBan := false
-			if txRuleErr := (&mempool.TxRuleError{}); errors.As(ruleErr.Err, txRuleErr) {
-				if txRuleErr.RejectCode == mempool.RejectInvalid {
-					shouldBan = true
-				}
-			}
-
+			shouldBan := rejectCode(err) == mempool.RejectInvalid
 			if !shouldBan {
 				continue
 			}
@@ -212,3 +213,13 @@ func (flow *handleRelayedTransactionsFlow) receiveTransactions(requestedTransact
 	}
 	return nil
 }
+
+// rejectCode returns the reject code of the given mempool rule error, or
+// zero if it isn't a transaction rule error
+func rejectCode(err error) mempool.RejectCode {
+	txRuleErr := &mempool.TxRuleError{}
+	if !errors.As(err, txRuleErr) {
+		return 0
+	}
+	return txRuleErr.RejectCode
+}
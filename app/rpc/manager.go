@@ -3,11 +3,16 @@ package rpc
 import (
 	"github.com/kobradag/kobrad/app/appmessage"
 	"github.com/kobradag/kobrad/app/protocol"
+	"github.com/kobradag/kobrad/app/rpc/ratelimit"
+	"github.com/kobradag/kobrad/app/rpc/rpcauth"
 	"github.com/kobradag/kobrad/app/rpc/rpccontext"
 	"github.com/kobradag/kobrad/domain"
+	"github.com/kobradag/kobrad/domain/addressindex"
 	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/domain/txindex"
 	"github.com/kobradag/kobrad/domain/utxoindex"
 	"github.com/kobradag/kobrad/infrastructure/config"
+	"github.com/kobradag/kobrad/infrastructure/db/database"
 	"github.com/kobradag/kobrad/infrastructure/logger"
 	"github.com/kobradag/kobrad/infrastructure/network/addressmanager"
 	"github.com/kobradag/kobrad/infrastructure/network/connmanager"
@@ -17,32 +22,44 @@ import (
 
 // Manager is an RPC manager
 type Manager struct {
-	context *rpccontext.Context
+	context       *rpccontext.Context
+	authenticator *rpcauth.Authenticator
+	rateLimiter   *ratelimit.Limiter
 }
 
 // NewManager creates a new RPC Manager
 func NewManager(
 	cfg *config.Config,
 	domain domain.Domain,
+	db database.Database,
 	netAdapter *netadapter.NetAdapter,
 	protocolManager *protocol.Manager,
 	connectionManager *connmanager.ConnectionManager,
 	addressManager *addressmanager.AddressManager,
 	utxoIndex *utxoindex.UTXOIndex,
+	txIndex *txindex.TXIndex,
+	addressIndex *addressindex.AddressIndex,
 	consensusEventsChan chan externalapi.ConsensusEvent,
-	shutDownChan chan<- struct{}) *Manager {
+	shutDownChan chan<- struct{},
+	authenticator *rpcauth.Authenticator) *Manager {
 
 	manager := Manager{
 		context: rpccontext.NewContext(
 			cfg,
 			domain,
+			db,
 			netAdapter,
 			protocolManager,
 			connectionManager,
 			addressManager,
 			utxoIndex,
+			txIndex,
+			addressIndex,
 			shutDownChan,
 		),
+		authenticator: authenticator,
+		rateLimiter: ratelimit.New(cfg.RPCRateLimit, cfg.RPCRateLimitBurst,
+			cfg.RPCRateLimitPerIP, cfg.RPCRateLimitPerIPBurst),
 	}
 	netAdapter.SetRPCRouterInitializer(manager.routerInitializer)
 
@@ -113,6 +130,20 @@ func (m *Manager) notifyVirtualChange(virtualChangeSet *externalapi.VirtualChang
 		}
 	}
 
+	if m.context.Config.TXIndex {
+		err := m.context.TXIndex.Update(virtualChangeSet)
+		if err != nil {
+			return err
+		}
+	}
+
+	if m.context.Config.AddressIndex {
+		err := m.context.AddressIndex.Update(virtualChangeSet)
+		if err != nil {
+			return err
+		}
+	}
+
 	err := m.notifyVirtualSelectedParentBlueScoreChanged(virtualChangeSet.VirtualSelectedParentBlueScore)
 	if err != nil {
 		return err
@@ -141,6 +172,8 @@ func (m *Manager) notifyVirtualChange(virtualChangeSet *externalapi.VirtualChang
 // NotifyNewBlockTemplate notifies the manager that a new
 // block template is available for miners
 func (m *Manager) NotifyNewBlockTemplate() error {
+	m.context.BlockTemplates.NotifyNewBlockTemplate()
+
 	notification := appmessage.NewNewBlockTemplateNotificationMessage()
 	return m.context.NotificationManager.NotifyNewBlockTemplate(notification)
 }
@@ -151,6 +184,20 @@ func (m *Manager) NotifyPruningPointUTXOSetOverride() error {
 	onEnd := logger.LogAndMeasureExecutionTime(log, "RPCManager.NotifyPruningPointUTXOSetOverride")
 	defer onEnd()
 
+	if m.context.Config.TXIndex {
+		err := m.context.TXIndex.Reset()
+		if err != nil {
+			return err
+		}
+	}
+
+	if m.context.Config.AddressIndex {
+		err := m.context.AddressIndex.Reset()
+		if err != nil {
+			return err
+		}
+	}
+
 	if m.context.Config.UTXOIndex {
 		err := m.notifyPruningPointUTXOSetOverride()
 		if err != nil {
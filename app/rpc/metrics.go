@@ -0,0 +1,12 @@
+package rpc
+
+import (
+	"github.com/kobradag/kobrad/infrastructure/metrics"
+)
+
+var (
+	rpcRequestDuration = metrics.NewHistogramVec("kobrad_rpc_request_duration_seconds",
+		"The time it took to handle RPC requests, by method", "method", metrics.DurationBuckets)
+	rpcRateLimitedRequests = metrics.NewCounter("kobrad_rpc_rate_limited_requests_total",
+		"The number of RPC requests refused for exceeding a rate limit")
+)
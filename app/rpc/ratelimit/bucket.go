@@ -0,0 +1,46 @@
+package ratelimit
+
+import (
+	"math"
+	"time"
+)
+
+// tokenBucket is a bucket that holds up to capacity tokens and is refilled
+// at a constant rate. It is not safe for concurrent use.
+type tokenBucket struct {
+	rate       float64
+	capacity   float64
+	tokens     float64
+	lastRefill time.Time
+}
+
+func newTokenBucket(rate float64, capacity float64, now time.Time) *tokenBucket {
+	return &tokenBucket{
+		rate:       rate,
+		capacity:   capacity,
+		tokens:     capacity,
+		lastRefill: now,
+	}
+}
+
+func (b *tokenBucket) refill(now time.Time) {
+	elapsed := now.Sub(b.lastRefill).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+	b.tokens = math.Min(b.capacity, b.tokens+elapsed*b.rate)
+	b.lastRefill = now
+}
+
+// waitTime returns how long it would take for the bucket to hold the
+// given number of tokens. It assumes the bucket was just refilled.
+func (b *tokenBucket) waitTime(tokens float64) time.Duration {
+	if b.tokens >= tokens {
+		return 0
+	}
+	return time.Duration((tokens - b.tokens) / b.rate * float64(time.Second))
+}
+
+func (b *tokenBucket) isFull() bool {
+	return b.tokens >= b.capacity
+}
@@ -0,0 +1,51 @@
+package ratelimit
+
+import (
+	"github.com/kobradag/kobrad/app/appmessage"
+)
+
+// defaultCost is the cost of RPC methods that aren't listed in methodCosts
+const defaultCost = 1
+
+// methodCosts are the costs of the RPC methods that are more expensive
+// to serve than the rest
+var methodCosts = map[appmessage.MessageCommand]float64{
+	appmessage.CmdGetUTXOsByAddressesRequestMessage:                    10,
+	appmessage.CmdGetBalancesByAddressesRequestMessage:                 5,
+	appmessage.CmdGetBalanceByAddressRequestMessage:                    2,
+	appmessage.CmdGetMempoolEntriesByAddressesRequestMessage:           5,
+	appmessage.CmdGetMempoolEntriesRequestMessage:                      5,
+	appmessage.CmdGetBlocksRequestMessage:                              20,
+	appmessage.CmdGetHeadersRequestMessage:                             10,
+	appmessage.CmdGetVirtualSelectedParentChainFromBlockRequestMessage: 10,
+	appmessage.CmdEstimateNetworkHashesPerSecondRequestMessage:         10,
+	appmessage.CmdGetCoinSupplyRequestMessage:                          5,
+	appmessage.CmdGetTransactionsByAddressesRequestMessage:             10,
+	appmessage.CmdGetDatabaseStatsRequestMessage:                       5,
+	appmessage.CmdBackupRequestMessage:                                 20,
+}
+
+// addressesPerCostUnit is the number of addresses in a request that
+// queries by addresses which add a single unit to its cost
+const addressesPerCostUnit = 10
+
+// Cost returns the number of tokens the given RPC request costs
+func Cost(request appmessage.Message) float64 {
+	cost, ok := methodCosts[request.Command()]
+	if !ok {
+		cost = defaultCost
+	}
+
+	var addresses []string
+	switch request := request.(type) {
+	case *appmessage.GetUTXOsByAddressesRequestMessage:
+		addresses = request.Addresses
+	case *appmessage.GetBalancesByAddressesRequestMessage:
+		addresses = request.Addresses
+	case *appmessage.GetMempoolEntriesByAddressesRequestMessage:
+		addresses = request.Addresses
+	case *appmessage.GetTransactionsByAddressesRequestMessage:
+		addresses = request.Addresses
+	}
+	return cost + float64(len(addresses))/addressesPerCostUnit
+}
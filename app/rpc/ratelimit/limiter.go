@@ -0,0 +1,159 @@
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/kobradag/kobrad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+// ErrRateLimitExceeded indicates that an RPC request was refused
+// because its client exceeded its rate limit
+var ErrRateLimitExceeded = errors.New("rate limit exceeded")
+
+// sweepInterval is the interval in which the buckets of IPs that have no
+// connections left are checked for removal
+const sweepInterval = time.Minute
+
+// Limiter limits the rate of RPC requests with token buckets, both per
+// connection and per IP. Every request takes tokens according to its
+// cost from the bucket of its connection and from the bucket of its IP,
+// and is refused if either of them doesn't hold enough tokens.
+type Limiter struct {
+	connectionRate  float64
+	connectionBurst float64
+	ipRate          float64
+	ipBurst         float64
+
+	ipBuckets map[string]*ipBucket
+	lastSweep time.Time
+	mutex     sync.Mutex
+
+	now func() time.Time
+}
+
+type ipBucket struct {
+	*tokenBucket
+	connectionCount int
+}
+
+// New creates a new Limiter. The rates are in tokens per second, and the
+// bursts are the capacities of the buckets. A rate of 0 disables the
+// respective limit.
+func New(connectionRate float64, connectionBurst float64, ipRate float64, ipBurst float64) *Limiter {
+	return &Limiter{
+		connectionRate:  connectionRate,
+		connectionBurst: connectionBurst,
+		ipRate:          ipRate,
+		ipBurst:         ipBurst,
+		ipBuckets:       make(map[string]*ipBucket),
+		now:             time.Now,
+	}
+}
+
+// Client is the rate limiting state of a single RPC connection
+type Client struct {
+	limiter          *Limiter
+	connectionBucket *tokenBucket
+	ipBucket         *ipBucket
+}
+
+// NewClient returns the rate limiting state of a new RPC connection from
+// the given IP. Client.Close must be called once the connection is closed.
+func (l *Limiter) NewClient(ip string) *Client {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := l.now()
+	l.sweepIfRequired(now)
+
+	client := &Client{limiter: l}
+	if l.connectionRate > 0 {
+		client.connectionBucket = newTokenBucket(l.connectionRate, l.connectionBurst, now)
+	}
+	if l.ipRate > 0 {
+		bucket, ok := l.ipBuckets[ip]
+		if !ok {
+			bucket = &ipBucket{tokenBucket: newTokenBucket(l.ipRate, l.ipBurst, now)}
+			l.ipBuckets[ip] = bucket
+		}
+		bucket.connectionCount++
+		client.ipBucket = bucket
+	}
+	return client
+}
+
+// sweepIfRequired removes the buckets of IPs that have no connections and
+// are full, since a full bucket is no different than a new one. The buckets
+// of IPs with no connections are otherwise kept, so that the per-IP limit
+// can't be escaped by reconnecting.
+func (l *Limiter) sweepIfRequired(now time.Time) {
+	if now.Sub(l.lastSweep) < sweepInterval {
+		return
+	}
+	l.lastSweep = now
+
+	for ip, bucket := range l.ipBuckets {
+		if bucket.connectionCount > 0 {
+			continue
+		}
+		bucket.refill(now)
+		if bucket.isFull() {
+			delete(l.ipBuckets, ip)
+		}
+	}
+}
+
+// Take takes the cost of the given request from the buckets of the client.
+// If they don't hold enough tokens, nothing is taken and an error wrapping
+// ErrRateLimitExceeded that explains when to retry is returned. A request
+// that costs more than a bucket can hold only requires it to be full.
+func (c *Client) Take(request appmessage.Message) error {
+	c.limiter.mutex.Lock()
+	defer c.limiter.mutex.Unlock()
+
+	buckets := make([]*tokenBucket, 0, 2)
+	if c.connectionBucket != nil {
+		buckets = append(buckets, c.connectionBucket)
+	}
+	if c.ipBucket != nil {
+		buckets = append(buckets, c.ipBucket.tokenBucket)
+	}
+	if len(buckets) == 0 {
+		return nil
+	}
+
+	cost := Cost(request)
+	now := c.limiter.now()
+	var waitTime time.Duration
+	for _, bucket := range buckets {
+		// A request that costs more than the capacity of the bucket can't
+		// ever be covered by it, so it's allowed once the bucket is full,
+		// and leaves the bucket in debt for the rest of its cost
+		requiredTokens := math.Min(cost, bucket.capacity)
+		bucket.refill(now)
+		if bucketWaitTime := bucket.waitTime(requiredTokens); bucketWaitTime > waitTime {
+			waitTime = bucketWaitTime
+		}
+	}
+	if waitTime > 0 {
+		return errors.Wrapf(ErrRateLimitExceeded, "try again in %s", waitTime.Round(time.Millisecond))
+	}
+
+	for _, bucket := range buckets {
+		bucket.tokens -= cost
+	}
+	return nil
+}
+
+// Close releases the client's hold of the bucket of its IP
+func (c *Client) Close() {
+	c.limiter.mutex.Lock()
+	defer c.limiter.mutex.Unlock()
+
+	if c.ipBucket != nil {
+		c.ipBucket.connectionCount--
+	}
+}
@@ -0,0 +1,161 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kobradag/kobrad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) advance(duration time.Duration) {
+	c.now = c.now.Add(duration)
+}
+
+func newTestLimiter(connectionRate, connectionBurst, ipRate, ipBurst float64) (*Limiter, *fakeClock) {
+	clock := &fakeClock{now: time.Unix(1_000_000, 0)}
+	limiter := New(connectionRate, connectionBurst, ipRate, ipBurst)
+	limiter.now = func() time.Time { return clock.now }
+	return limiter, clock
+}
+
+func TestConnectionLimit(t *testing.T) {
+	limiter, clock := newTestLimiter(1, 5, 0, 0)
+	client := limiter.NewClient("127.0.0.1")
+	defer client.Close()
+
+	for i := 0; i < 5; i++ {
+		err := client.Take(appmessage.NewGetInfoRequestMessage())
+		if err != nil {
+			t.Fatalf("Take %d: %+v", i, err)
+		}
+	}
+	err := client.Take(appmessage.NewGetInfoRequestMessage())
+	if !errors.Is(err, ErrRateLimitExceeded) {
+		t.Fatalf("Expected ErrRateLimitExceeded once the burst is spent, but got %v", err)
+	}
+
+	clock.advance(time.Second)
+	err = client.Take(appmessage.NewGetInfoRequestMessage())
+	if err != nil {
+		t.Fatalf("Expected the bucket to be refilled after a second, but got %+v", err)
+	}
+
+	// A separate connection from the same IP has its own bucket
+	otherClient := limiter.NewClient("127.0.0.1")
+	defer otherClient.Close()
+	err = otherClient.Take(appmessage.NewGetInfoRequestMessage())
+	if err != nil {
+		t.Fatalf("Take: %+v", err)
+	}
+}
+
+func TestIPLimit(t *testing.T) {
+	limiter, clock := newTestLimiter(0, 0, 1, 3)
+
+	client := limiter.NewClient("10.0.0.1")
+	for i := 0; i < 3; i++ {
+		err := client.Take(appmessage.NewGetInfoRequestMessage())
+		if err != nil {
+			t.Fatalf("Take %d: %+v", i, err)
+		}
+	}
+	client.Close()
+
+	// Reconnecting from the same IP doesn't reset the limit
+	client = limiter.NewClient("10.0.0.1")
+	err := client.Take(appmessage.NewGetInfoRequestMessage())
+	if !errors.Is(err, ErrRateLimitExceeded) {
+		t.Fatalf("Expected ErrRateLimitExceeded after reconnecting, but got %v", err)
+	}
+
+	// Other IPs are unaffected
+	otherClient := limiter.NewClient("10.0.0.2")
+	err = otherClient.Take(appmessage.NewGetInfoRequestMessage())
+	if err != nil {
+		t.Fatalf("Take: %+v", err)
+	}
+	otherClient.Close()
+	client.Close()
+
+	// Idle full buckets are eventually swept
+	clock.advance(2 * sweepInterval)
+	limiter.NewClient("10.0.0.3").Close()
+	if len(limiter.ipBuckets) != 1 {
+		t.Fatalf("Expected only the bucket of the new IP to remain, but got %d buckets", len(limiter.ipBuckets))
+	}
+}
+
+func TestCost(t *testing.T) {
+	limiter, _ := newTestLimiter(1, 20, 0, 0)
+	client := limiter.NewClient("127.0.0.1")
+	defer client.Close()
+
+	addresses := make([]string, 100)
+	request := appmessage.NewGetUTXOsByAddressesRequestMessage(addresses)
+	if cost := Cost(request); cost != 20 {
+		t.Fatalf("Expected a cost of 20, but got %f", cost)
+	}
+	err := client.Take(request)
+	if err != nil {
+		t.Fatalf("Take: %+v", err)
+	}
+	err = client.Take(appmessage.NewGetInfoRequestMessage())
+	if !errors.Is(err, ErrRateLimitExceeded) {
+		t.Fatalf("Expected the expensive request to spend the whole burst, but got %v", err)
+	}
+}
+
+func TestCostAboveBurst(t *testing.T) {
+	limiter, clock := newTestLimiter(10, 100, 10, 100)
+	client := limiter.NewClient("127.0.0.1")
+	defer client.Close()
+
+	// Costs 10 + 2000/10 = 210, which is more than the burst
+	request := appmessage.NewGetUTXOsByAddressesRequestMessage(make([]string, 2000))
+	err := client.Take(appmessage.NewGetInfoRequestMessage())
+	if err != nil {
+		t.Fatalf("Take: %+v", err)
+	}
+	err = client.Take(request)
+	if !errors.Is(err, ErrRateLimitExceeded) {
+		t.Fatalf("Expected a request costing more than the burst to wait for a full bucket, but got %v", err)
+	}
+
+	clock.advance(time.Second)
+	err = client.Take(request)
+	if err != nil {
+		t.Fatalf("Expected a request costing more than the burst to be allowed once the bucket is full, "+
+			"but got %+v", err)
+	}
+
+	// The bucket is now in a debt of 110 tokens, which takes 11 seconds to
+	// repay, and another second to refill the cost of the next request
+	clock.advance(11 * time.Second)
+	err = client.Take(appmessage.NewGetInfoRequestMessage())
+	if !errors.Is(err, ErrRateLimitExceeded) {
+		t.Fatalf("Expected the debt to be repaid before the next request, but got %v", err)
+	}
+	clock.advance(time.Second)
+	err = client.Take(appmessage.NewGetInfoRequestMessage())
+	if err != nil {
+		t.Fatalf("Take: %+v", err)
+	}
+}
+
+func TestDisabled(t *testing.T) {
+	limiter, _ := newTestLimiter(0, 0, 0, 0)
+	client := limiter.NewClient("127.0.0.1")
+	defer client.Close()
+
+	for i := 0; i < 1000; i++ {
+		err := client.Take(appmessage.NewGetUTXOsByAddressesRequestMessage(make([]string, 1000)))
+		if err != nil {
+			t.Fatalf("Take: %+v", err)
+		}
+	}
+}
@@ -1,11 +1,17 @@
 package rpc
 
 import (
+	"strings"
+	"sync/atomic"
+
 	"github.com/kobradag/kobrad/app/appmessage"
+	"github.com/kobradag/kobrad/app/rpc/ratelimit"
+	"github.com/kobradag/kobrad/app/rpc/rpcauth"
 	"github.com/kobradag/kobrad/app/rpc/rpccontext"
 	"github.com/kobradag/kobrad/app/rpc/rpchandlers"
 	"github.com/kobradag/kobrad/infrastructure/network/netadapter"
 	"github.com/kobradag/kobrad/infrastructure/network/netadapter/router"
+	"github.com/kobradag/kobrad/infrastructure/network/netadapter/server/grpcserver/protowire"
 	"github.com/pkg/errors"
 )
 
@@ -50,6 +56,12 @@ var handlers = map[appmessage.MessageCommand]handler{
 	appmessage.CmdNotifyVirtualDaaScoreChangedRequestMessage:                rpchandlers.HandleNotifyVirtualDaaScoreChanged,
 	appmessage.CmdNotifyNewBlockTemplateRequestMessage:                      rpchandlers.HandleNotifyNewBlockTemplate,
 	appmessage.CmdGetCoinSupplyRequestMessage:                               rpchandlers.HandleGetCoinSupply,
+	appmessage.CmdGetTransactionRequestMessage:                              rpchandlers.HandleGetTransaction,
+	appmessage.CmdGetTransactionsByAddressesRequestMessage:                  rpchandlers.HandleGetTransactionsByAddresses,
+	appmessage.CmdGetDatabaseStatsRequestMessage:                            rpchandlers.HandleGetDatabaseStats,
+	appmessage.CmdBackupRequestMessage:                                      rpchandlers.HandleBackup,
+	appmessage.CmdGetFeeEstimateRequestMessage:                              rpchandlers.HandleGetFeeEstimate,
+	appmessage.CmdSubmitTransactionReplacementRequestMessage:                rpchandlers.HandleSubmitTransactionReplacement,
 	appmessage.CmdGetMempoolEntriesByAddressesRequestMessage:                rpchandlers.HandleGetMempoolEntriesByAddresses,
 }
 
@@ -64,15 +76,26 @@ func (m *Manager) routerInitializer(router *router.Router, netConnection *netada
 	}
 	m.context.NotificationManager.AddListener(router)
 
+	client := &rpcClient{
+		rateLimit: m.rateLimiter.NewClient(netConnection.NetAddress().IP.String()),
+	}
+	client.role, client.authenticationErr = m.authenticator.Authenticate(netConnection.Authorization())
+	if client.authenticationErr != nil {
+		log.Warnf("RPC client %s failed to authenticate: %s", netConnection, client.authenticationErr)
+	}
+
 	spawn("routerInitializer-handleIncomingMessages", func() {
 		defer m.context.NotificationManager.RemoveListener(router)
+		defer client.rateLimit.Close()
 
-		err := m.handleIncomingMessages(router, incomingRoute)
+		err := m.handleIncomingMessages(router, incomingRoute, client, netConnection)
 		m.handleError(err, netConnection)
 	})
 }
 
-func (m *Manager) handleIncomingMessages(router *router.Router, incomingRoute *router.Route) error {
+func (m *Manager) handleIncomingMessages(router *router.Router, incomingRoute *router.Route, client *rpcClient,
+	netConnection *netadapter.NetConnection) error {
+
 	outgoingRoute := router.OutgoingRoute()
 	for {
 		request, err := incomingRoute.Dequeue()
@@ -83,17 +106,123 @@ func (m *Manager) handleIncomingMessages(router *router.Router, incomingRoute *r
 		if !ok {
 			return err
 		}
-		response, err := handler(m.context, router, request)
-		if err != nil {
-			return err
+		if rpcError := client.refusal(request); rpcError != nil {
+			response, err := protowire.NewErrorResponse(request, rpcError)
+			if err != nil {
+				return err
+			}
+			err = outgoingRoute.Enqueue(response)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		// A long poll may take up to a minute, and a backup may take minutes,
+		// so they're handled on their own to not hold back the requests that
+		// follow them, such as the submission of a block found meanwhile
+		if pendingFlag := client.pendingFlag(request); pendingFlag != nil {
+			atomic.StoreUint32(pendingFlag, 1)
+			spawn("handleIncomingMessages-"+request.Command().String(), func() {
+				// The flag is only cleared once the response is sent, so that
+				// a following request of the same kind is answered after it
+				defer atomic.StoreUint32(pendingFlag, 0)
+
+				err := m.handleRequest(router, handler, request)
+				if err != nil {
+					m.handleError(err, netConnection)
+				}
+			})
+			continue
 		}
-		err = outgoingRoute.Enqueue(response)
+		err = m.handleRequest(router, handler, request)
 		if err != nil {
 			return err
 		}
 	}
 }
 
+// handleRequest handles the given request and sends its response
+func (m *Manager) handleRequest(router *router.Router, handler handler, request appmessage.Message) error {
+	onEnd := rpcRequestDuration.WithLabelValue(request.Command().String()).MeasureExecutionTime()
+	response, err := handler(m.context, router, request)
+	onEnd()
+	if err != nil {
+		return err
+	}
+	return router.OutgoingRoute().Enqueue(response)
+}
+
+// isLongPoll returns whether the given request waits for a new block template
+func isLongPoll(request appmessage.Message) bool {
+	getBlockTemplateRequest, ok := request.(*appmessage.GetBlockTemplateRequestMessage)
+	return ok && getBlockTemplateRequest.LongPollID != ""
+}
+
+// rpcClient is the state of a single RPC connection that decides
+// which of its requests may be handled
+type rpcClient struct {
+	role              *rpcauth.Role
+	authenticationErr error
+	rateLimit         *ratelimit.Client
+
+	// hasPendingLongPoll and hasPendingBackup are set to 1 while a long
+	// poll or a backup of the client is being handled. They're accessed
+	// atomically.
+	hasPendingLongPoll uint32
+	hasPendingBackup   uint32
+}
+
+// pendingFlag returns the flag that is set while the given request is being
+// handled, if it's a request that is handled apart from the requests that
+// follow it, or nil otherwise
+func (c *rpcClient) pendingFlag(request appmessage.Message) *uint32 {
+	if isLongPoll(request) {
+		return &c.hasPendingLongPoll
+	}
+	if request.Command() == appmessage.CmdBackupRequestMessage {
+		return &c.hasPendingBackup
+	}
+	return nil
+}
+
+// refusal returns the error to respond with to the given request if it may
+// not be handled - because the client failed to authenticate, because its
+// role doesn't allow the requested method, because it requested a block
+// template while its long poll is pending or a backup while its backup is
+// pending, or because it exceeded its rate limit - or nil if it may be handled
+func (c *rpcClient) refusal(request appmessage.Message) *appmessage.RPCError {
+	if !c.role.IsAllowed(request.Command()) {
+		if c.authenticationErr != nil {
+			return appmessage.RPCErrorf("Unauthenticated: %s", c.authenticationErr)
+		}
+		return appmessage.RPCErrorf("Unauthorized: role %s may not call %s", c.role.Name(),
+			strings.TrimSuffix(appmessage.RPCMessageCommandToString[request.Command()], "Request"))
+	}
+
+	// Every client may have a single long poll at a time, which also bounds
+	// the block templates that are built for it. Responses are matched to
+	// requests by their command, so a block template response that overtakes
+	// the response of the long poll would be mistaken for it.
+	if request.Command() == appmessage.CmdGetBlockTemplateRequestMessage &&
+		atomic.LoadUint32(&c.hasPendingLongPoll) == 1 {
+
+		return appmessage.RPCErrorf("A long poll for a block template is already pending on this connection")
+	}
+	if request.Command() == appmessage.CmdBackupRequestMessage &&
+		atomic.LoadUint32(&c.hasPendingBackup) == 1 {
+
+		return appmessage.RPCErrorf("A backup is already pending on this connection")
+	}
+
+	err := c.rateLimit.Take(request)
+	if err != nil {
+		rpcRateLimitedRequests.Inc()
+		return appmessage.RPCErrorf("Rate limited: %s", err)
+	}
+	return nil
+}
+
 func (m *Manager) handleError(err error, netConnection *netadapter.NetConnection) {
 	if errors.Is(err, router.ErrTimeout) {
 		log.Warnf("Got timeout from %s. Disconnecting...", netConnection)
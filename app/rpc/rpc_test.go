@@ -0,0 +1,190 @@
+package rpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kobradag/kobrad/app/appmessage"
+	"github.com/kobradag/kobrad/app/rpc/ratelimit"
+	"github.com/kobradag/kobrad/app/rpc/rpcauth"
+	"github.com/kobradag/kobrad/app/rpc/rpccontext"
+	"github.com/kobradag/kobrad/infrastructure/network/netadapter/router"
+)
+
+func TestSubmitBlockDuringLongPoll(t *testing.T) {
+	// The long poll only ends once the block was submitted, which
+	// can't happen if it holds back the requests that follow it
+	submittedBlock := make(chan struct{})
+	originalHandlers := handlers
+	handlers = map[appmessage.MessageCommand]handler{
+		appmessage.CmdGetBlockTemplateRequestMessage: func(_ *rpccontext.Context, _ *router.Router,
+			_ appmessage.Message) (appmessage.Message, error) {
+
+			select {
+			case <-submittedBlock:
+			case <-time.After(10 * time.Second):
+			}
+			return &appmessage.GetBlockTemplateResponseMessage{}, nil
+		},
+		appmessage.CmdSubmitBlockRequestMessage: func(_ *rpccontext.Context, _ *router.Router,
+			_ appmessage.Message) (appmessage.Message, error) {
+
+			close(submittedBlock)
+			return &appmessage.SubmitBlockResponseMessage{}, nil
+		},
+	}
+	defer func() { handlers = originalHandlers }()
+
+	testRouter := startTestConnection(t, "TestSubmitBlockDuringLongPoll")
+	defer testRouter.Close()
+
+	requests := []appmessage.Message{
+		&appmessage.GetBlockTemplateRequestMessage{LongPollID: "previous"},
+		&appmessage.SubmitBlockRequestMessage{},
+	}
+	enqueueRequests(t, testRouter, requests)
+
+	expectResponse(t, testRouter, appmessage.CmdSubmitBlockResponseMessage)
+	expectResponse(t, testRouter, appmessage.CmdGetBlockTemplateResponseMessage)
+}
+
+func TestGetBlockTemplateDuringLongPoll(t *testing.T) {
+	endLongPoll := make(chan struct{})
+	originalHandlers := handlers
+	handlers = map[appmessage.MessageCommand]handler{
+		appmessage.CmdGetBlockTemplateRequestMessage: func(_ *rpccontext.Context, _ *router.Router,
+			request appmessage.Message) (appmessage.Message, error) {
+
+			if request.(*appmessage.GetBlockTemplateRequestMessage).LongPollID != "" {
+				<-endLongPoll
+			}
+			return &appmessage.GetBlockTemplateResponseMessage{}, nil
+		},
+	}
+	defer func() { handlers = originalHandlers }()
+
+	testRouter := startTestConnection(t, "TestGetBlockTemplateDuringLongPoll")
+	defer testRouter.Close()
+
+	// Any block template request is refused while the long poll is pending,
+	// so that its response can't be mistaken for the one of the long poll
+	enqueueRequests(t, testRouter, []appmessage.Message{
+		&appmessage.GetBlockTemplateRequestMessage{LongPollID: "previous"},
+		&appmessage.GetBlockTemplateRequestMessage{LongPollID: "previous"},
+		&appmessage.GetBlockTemplateRequestMessage{},
+	})
+	for i := 0; i < 2; i++ {
+		response := expectResponse(t, testRouter, appmessage.CmdGetBlockTemplateResponseMessage)
+		if response.(*appmessage.GetBlockTemplateResponseMessage).Error == nil {
+			t.Fatalf("Expected a block template request during a long poll to be refused")
+		}
+	}
+
+	close(endLongPoll)
+	response := expectResponse(t, testRouter, appmessage.CmdGetBlockTemplateResponseMessage)
+	if response.(*appmessage.GetBlockTemplateResponseMessage).Error != nil {
+		t.Fatalf("Unexpected error in the response of the long poll: %s",
+			response.(*appmessage.GetBlockTemplateResponseMessage).Error)
+	}
+
+	// Once the long poll is over, block templates may be requested again
+	enqueueRequests(t, testRouter, []appmessage.Message{&appmessage.GetBlockTemplateRequestMessage{}})
+	response = expectResponse(t, testRouter, appmessage.CmdGetBlockTemplateResponseMessage)
+	if response.(*appmessage.GetBlockTemplateResponseMessage).Error != nil {
+		t.Fatalf("Unexpected error after the long poll: %s", response.(*appmessage.GetBlockTemplateResponseMessage).Error)
+	}
+}
+
+func TestRequestsDuringBackup(t *testing.T) {
+	endBackup := make(chan struct{})
+	originalHandlers := handlers
+	handlers = map[appmessage.MessageCommand]handler{
+		appmessage.CmdBackupRequestMessage: func(_ *rpccontext.Context, _ *router.Router,
+			_ appmessage.Message) (appmessage.Message, error) {
+
+			<-endBackup
+			return &appmessage.BackupResponseMessage{}, nil
+		},
+		appmessage.CmdGetInfoRequestMessage: func(_ *rpccontext.Context, _ *router.Router,
+			_ appmessage.Message) (appmessage.Message, error) {
+
+			return &appmessage.GetInfoResponseMessage{}, nil
+		},
+	}
+	defer func() { handlers = originalHandlers }()
+
+	testRouter := startTestConnection(t, "TestRequestsDuringBackup")
+	defer testRouter.Close()
+
+	// The backup doesn't hold back the requests that follow it, and a second
+	// backup is refused while it's pending
+	enqueueRequests(t, testRouter, []appmessage.Message{
+		&appmessage.BackupRequestMessage{},
+		&appmessage.BackupRequestMessage{},
+		&appmessage.GetInfoRequestMessage{},
+	})
+	response := expectResponse(t, testRouter, appmessage.CmdBackupResponseMessage)
+	if response.(*appmessage.BackupResponseMessage).Error == nil {
+		t.Fatalf("Expected a backup request during a backup to be refused")
+	}
+	expectResponse(t, testRouter, appmessage.CmdGetInfoResponseMessage)
+
+	close(endBackup)
+	response = expectResponse(t, testRouter, appmessage.CmdBackupResponseMessage)
+	if response.(*appmessage.BackupResponseMessage).Error != nil {
+		t.Fatalf("Unexpected error in the response of the backup: %s",
+			response.(*appmessage.BackupResponseMessage).Error)
+	}
+}
+
+// startTestConnection starts handling the requests of a connection of an
+// authenticated client with no rate limit, and returns its router
+func startTestConnection(t *testing.T, name string) *router.Router {
+	authenticator, err := rpcauth.New(nil, nil, nil)
+	if err != nil {
+		t.Fatalf("rpcauth.New: %s", err)
+	}
+	role, err := authenticator.Authenticate("")
+	if err != nil {
+		t.Fatalf("Authenticate: %s", err)
+	}
+	client := &rpcClient{
+		role:      role,
+		rateLimit: ratelimit.New(0, 0, 0, 0).NewClient("127.0.0.1"),
+	}
+
+	manager := &Manager{}
+	testRouter := router.NewRouter(name)
+	commands := make([]appmessage.MessageCommand, 0, len(handlers))
+	for command := range handlers {
+		commands = append(commands, command)
+	}
+	incomingRoute, err := testRouter.AddIncomingRoute("rpc router", commands)
+	if err != nil {
+		t.Fatalf("AddIncomingRoute: %s", err)
+	}
+	go func() {
+		_ = manager.handleIncomingMessages(testRouter, incomingRoute, client, nil)
+	}()
+	return testRouter
+}
+
+func enqueueRequests(t *testing.T, testRouter *router.Router, requests []appmessage.Message) {
+	for _, request := range requests {
+		err := testRouter.EnqueueIncomingMessage(request)
+		if err != nil {
+			t.Fatalf("EnqueueIncomingMessage: %s", err)
+		}
+	}
+}
+
+func expectResponse(t *testing.T, testRouter *router.Router, expectedCommand appmessage.MessageCommand) appmessage.Message {
+	response, err := testRouter.OutgoingRoute().DequeueWithTimeout(5 * time.Second)
+	if err != nil {
+		t.Fatalf("Expected a %s but got: %s", expectedCommand, err)
+	}
+	if response.Command() != expectedCommand {
+		t.Fatalf("Expected a %s but got a %s", expectedCommand, response.Command())
+	}
+	return response
+}
@@ -0,0 +1,138 @@
+package rpcauth
+
+import (
+	"crypto/subtle"
+	"encoding/base64"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ErrAuthenticationFailed indicates that an RPC client presented no
+// credentials or credentials that don't match any user or token
+var ErrAuthenticationFailed = errors.New("authentication failed")
+
+type credential struct {
+	secret string
+	role   *Role
+}
+
+// Authenticator authenticates RPC clients and resolves the roles that
+// decide which RPC methods they may call
+type Authenticator struct {
+	users  map[string]*credential
+	tokens []*credential
+}
+
+// New creates an Authenticator out of the given users, tokens and custom
+// roles, as given in the rpcuser, rpctoken and rpcrole config options:
+//   - users are in the form <username>:<password>[:<role>]
+//   - tokens are in the form <token>[:<role>]
+//   - roles are in the form <name>:<method>[,<method>...]
+//
+// Users and tokens that don't name a role are admins. If there are no users
+// and no tokens, authentication is disabled and every client is an admin.
+func New(users []string, tokens []string, roles []string) (*Authenticator, error) {
+	rolesByName := builtInRoles()
+	for _, roleString := range roles {
+		role, err := parseRole(roleString)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := rolesByName[role.name]; ok {
+			return nil, errors.Errorf("RPC role '%s' is defined more than once", role.name)
+		}
+		rolesByName[role.name] = role
+	}
+	lookUpRole := func(name string) (*Role, error) {
+		if name == "" {
+			name = AdminRoleName
+		}
+		role, ok := rolesByName[name]
+		if !ok {
+			return nil, errors.Errorf("unknown RPC role '%s'", name)
+		}
+		return role, nil
+	}
+
+	authenticator := &Authenticator{users: make(map[string]*credential)}
+	for _, user := range users {
+		parts := strings.SplitN(user, ":", 3)
+		if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+			return nil, errors.Errorf("invalid RPC user '%s': expected <username>:<password>[:<role>]", parts[0])
+		}
+		if _, ok := authenticator.users[parts[0]]; ok {
+			return nil, errors.Errorf("RPC user '%s' is defined more than once", parts[0])
+		}
+		roleName := ""
+		if len(parts) == 3 {
+			roleName = parts[2]
+		}
+		role, err := lookUpRole(roleName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid RPC user '%s'", parts[0])
+		}
+		authenticator.users[parts[0]] = &credential{secret: parts[1], role: role}
+	}
+	for _, token := range tokens {
+		secret, roleName, _ := strings.Cut(token, ":")
+		if secret == "" {
+			return nil, errors.New("invalid RPC token: expected <token>[:<role>]")
+		}
+		role, err := lookUpRole(roleName)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid RPC token")
+		}
+		authenticator.tokens = append(authenticator.tokens, &credential{secret: secret, role: role})
+	}
+
+	return authenticator, nil
+}
+
+// IsEnabled returns whether RPC clients are required to authenticate
+func (a *Authenticator) IsEnabled() bool {
+	return len(a.users) > 0 || len(a.tokens) > 0
+}
+
+// Authenticate returns the role of the client that presented the given
+// authorization, which is in the format of an HTTP Authorization header:
+// either "Basic <base64 of username:password>" or "Bearer <token>".
+func (a *Authenticator) Authenticate(authorization string) (*Role, error) {
+	if !a.IsEnabled() {
+		return builtInRoles()[AdminRoleName], nil
+	}
+
+	scheme, credentials, _ := strings.Cut(strings.TrimSpace(authorization), " ")
+	credentials = strings.TrimSpace(credentials)
+	switch strings.ToLower(scheme) {
+	case "basic":
+		decoded, err := base64.StdEncoding.DecodeString(credentials)
+		if err != nil {
+			return nil, errors.Wrap(ErrAuthenticationFailed, "malformed basic credentials")
+		}
+		username, password, ok := strings.Cut(string(decoded), ":")
+		if !ok {
+			return nil, errors.Wrap(ErrAuthenticationFailed, "malformed basic credentials")
+		}
+		user, ok := a.users[username]
+		if !ok || !secretsEqual(user.secret, password) {
+			return nil, errors.Wrap(ErrAuthenticationFailed, "wrong username or password")
+		}
+		return user.role, nil
+	case "bearer":
+		for _, token := range a.tokens {
+			if secretsEqual(token.secret, credentials) {
+				return token.role, nil
+			}
+		}
+		return nil, errors.Wrap(ErrAuthenticationFailed, "unknown token")
+	case "":
+		return nil, errors.Wrap(ErrAuthenticationFailed, "no credentials were presented")
+	default:
+		return nil, errors.Wrapf(ErrAuthenticationFailed, "unsupported authorization scheme '%s'", scheme)
+	}
+}
+
+func secretsEqual(expected string, actual string) bool {
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(actual)) == 1
+}
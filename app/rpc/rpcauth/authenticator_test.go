@@ -0,0 +1,131 @@
+package rpcauth
+
+import (
+	"testing"
+
+	"github.com/kobradag/kobrad/app/appmessage"
+	"github.com/kobradag/kobrad/infrastructure/config"
+	"github.com/pkg/errors"
+)
+
+func TestAuthenticate(t *testing.T) {
+	authenticator, err := New(
+		[]string{"alice:secret", "partner:hunter2:readonly", "explorer:pass:explorer"},
+		[]string{"sometoken:readonly"},
+		[]string{"explorer:getBlock,GetBlockDAGInfo"})
+	if err != nil {
+		t.Fatalf("New: %+v", err)
+	}
+
+	tests := []struct {
+		name          string
+		authorization string
+		expectedRole  string
+		expectedError bool
+	}{
+		{name: "admin user", authorization: config.BasicAuthorization("alice", "secret"), expectedRole: AdminRoleName},
+		{name: "read-only user", authorization: config.BasicAuthorization("partner", "hunter2"), expectedRole: ReadOnlyRoleName},
+		{name: "custom role", authorization: config.BasicAuthorization("explorer", "pass"), expectedRole: "explorer"},
+		{name: "token", authorization: config.BearerAuthorization("sometoken"), expectedRole: ReadOnlyRoleName},
+		{name: "lowercase scheme", authorization: "bearer sometoken", expectedRole: ReadOnlyRoleName},
+		{name: "wrong password", authorization: config.BasicAuthorization("alice", "wrong"), expectedError: true},
+		{name: "unknown user", authorization: config.BasicAuthorization("mallory", "secret"), expectedError: true},
+		{name: "unknown token", authorization: config.BearerAuthorization("othertoken"), expectedError: true},
+		{name: "malformed", authorization: "Basic !!!", expectedError: true},
+		{name: "no credentials", authorization: "", expectedError: true},
+	}
+	for _, test := range tests {
+		role, err := authenticator.Authenticate(test.authorization)
+		if test.expectedError {
+			if !errors.Is(err, ErrAuthenticationFailed) {
+				t.Errorf("%s: expected ErrAuthenticationFailed, but got %v", test.name, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: Authenticate: %+v", test.name, err)
+			continue
+		}
+		if role.Name() != test.expectedRole {
+			t.Errorf("%s: expected role %s, but got %s", test.name, test.expectedRole, role.Name())
+		}
+	}
+}
+
+func TestRoles(t *testing.T) {
+	authenticator, err := New([]string{"explorer:pass:explorer"}, []string{"admintoken", "readtoken:readonly"},
+		[]string{"explorer:getBlock,GetBlockDAGInfo"})
+	if err != nil {
+		t.Fatalf("New: %+v", err)
+	}
+	admin, _ := authenticator.Authenticate(config.BearerAuthorization("admintoken"))
+	readOnly, _ := authenticator.Authenticate(config.BearerAuthorization("readtoken"))
+	explorer, _ := authenticator.Authenticate(config.BasicAuthorization("explorer", "pass"))
+
+	tests := []struct {
+		role     *Role
+		command  appmessage.MessageCommand
+		expected bool
+	}{
+		{admin, appmessage.CmdShutDownRequestMessage, true},
+		{admin, appmessage.CmdGetInfoRequestMessage, true},
+		{readOnly, appmessage.CmdGetInfoRequestMessage, true},
+		{readOnly, appmessage.CmdNotifyBlockAddedRequestMessage, true},
+		{readOnly, appmessage.CmdShutDownRequestMessage, false},
+		{readOnly, appmessage.CmdBanRequestMessage, false},
+		{readOnly, appmessage.CmdSubmitTransactionRequestMessage, false},
+		{readOnly, appmessage.CmdBackupRequestMessage, false},
+		// A method that isn't explicitly read-only, such as one added
+		// later, isn't allowed to the read-only role
+		{readOnly, appmessage.MessageCommand(1 << 20), false},
+		{explorer, appmessage.CmdGetBlockRequestMessage, true},
+		{explorer, appmessage.CmdGetBlockDAGInfoRequestMessage, true},
+		{explorer, appmessage.CmdGetInfoRequestMessage, false},
+		{nil, appmessage.CmdGetInfoRequestMessage, false},
+	}
+	for i, test := range tests {
+		if test.role.IsAllowed(test.command) != test.expected {
+			t.Errorf("Test %d: expected IsAllowed(%s) to be %t", i, test.command, test.expected)
+		}
+	}
+}
+
+func TestAuthenticationDisabled(t *testing.T) {
+	authenticator, err := New(nil, nil, nil)
+	if err != nil {
+		t.Fatalf("New: %+v", err)
+	}
+	if authenticator.IsEnabled() {
+		t.Fatalf("Expected authentication to be disabled")
+	}
+	role, err := authenticator.Authenticate("")
+	if err != nil {
+		t.Fatalf("Authenticate: %+v", err)
+	}
+	if !role.IsAllowed(appmessage.CmdShutDownRequestMessage) {
+		t.Errorf("Expected every method to be allowed when authentication is disabled")
+	}
+}
+
+func TestNewErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		users  []string
+		tokens []string
+		roles  []string
+	}{
+		{name: "user without password", users: []string{"alice"}},
+		{name: "user with unknown role", users: []string{"alice:secret:nosuchrole"}},
+		{name: "duplicate user", users: []string{"alice:secret", "alice:other"}},
+		{name: "empty token", tokens: []string{":readonly"}},
+		{name: "role without methods", roles: []string{"explorer"}},
+		{name: "role with unknown method", roles: []string{"explorer:getBlock,noSuchMethod"}},
+		{name: "redefined built-in role", roles: []string{"admin:getInfo"}},
+	}
+	for _, test := range tests {
+		_, err := New(test.users, test.tokens, test.roles)
+		if err == nil {
+			t.Errorf("%s: expected an error", test.name)
+		}
+	}
+}
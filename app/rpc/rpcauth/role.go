@@ -0,0 +1,135 @@
+package rpcauth
+
+import (
+	"strings"
+
+	"github.com/kobradag/kobrad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+// The names of the built-in roles
+const (
+	// AdminRoleName is the name of the role that may call every RPC method
+	AdminRoleName = "admin"
+
+	// ReadOnlyRoleName is the name of the role that may call every RPC
+	// method that doesn't affect the state of the node
+	ReadOnlyRoleName = "readonly"
+)
+
+// readOnlyMethods are the requests of the RPC methods that don't affect the
+// state of the node, which are the only ones the read-only role may call.
+// New RPC methods must be added here explicitly to be allowed to it.
+var readOnlyMethods = []appmessage.MessageCommand{
+	appmessage.CmdGetCurrentNetworkRequestMessage,
+	appmessage.CmdGetBlockTemplateRequestMessage,
+	appmessage.CmdGetPeerAddressesRequestMessage,
+	appmessage.CmdGetSelectedTipHashRequestMessage,
+	appmessage.CmdGetMempoolEntryRequestMessage,
+	appmessage.CmdGetMempoolEntriesRequestMessage,
+	appmessage.CmdGetMempoolEntriesByAddressesRequestMessage,
+	appmessage.CmdGetConnectedPeerInfoRequestMessage,
+	appmessage.CmdGetBlockRequestMessage,
+	appmessage.CmdGetSubnetworkRequestMessage,
+	appmessage.CmdGetVirtualSelectedParentChainFromBlockRequestMessage,
+	appmessage.CmdGetBlocksRequestMessage,
+	appmessage.CmdGetBlockCountRequestMessage,
+	appmessage.CmdGetBlockDAGInfoRequestMessage,
+	appmessage.CmdGetHeadersRequestMessage,
+	appmessage.CmdGetUTXOsByAddressesRequestMessage,
+	appmessage.CmdGetBalanceByAddressRequestMessage,
+	appmessage.CmdGetBalancesByAddressesRequestMessage,
+	appmessage.CmdGetVirtualSelectedParentBlueScoreRequestMessage,
+	appmessage.CmdGetInfoRequestMessage,
+	appmessage.CmdEstimateNetworkHashesPerSecondRequestMessage,
+	appmessage.CmdGetCoinSupplyRequestMessage,
+	appmessage.CmdGetFeeEstimateRequestMessage,
+	appmessage.CmdGetTransactionRequestMessage,
+	appmessage.CmdGetTransactionsByAddressesRequestMessage,
+	appmessage.CmdGetDatabaseStatsRequestMessage,
+	appmessage.CmdNotifyBlockAddedRequestMessage,
+	appmessage.CmdNotifyVirtualSelectedParentChainChangedRequestMessage,
+	appmessage.CmdNotifyFinalityConflictsRequestMessage,
+	appmessage.CmdNotifyUTXOsChangedRequestMessage,
+	appmessage.CmdStopNotifyingUTXOsChangedRequestMessage,
+	appmessage.CmdNotifyVirtualSelectedParentBlueScoreChangedRequestMessage,
+	appmessage.CmdNotifyPruningPointUTXOSetOverrideRequestMessage,
+	appmessage.CmdStopNotifyingPruningPointUTXOSetOverrideRequestMessage,
+	appmessage.CmdNotifyVirtualDaaScoreChangedRequestMessage,
+	appmessage.CmdNotifyNewBlockTemplateRequestMessage,
+}
+
+const requestSuffix = "Request"
+
+// methods maps the lowercase names of the RPC methods to their request
+// commands. The name of a method is the name of its request without the
+// "Request" suffix, e.g. GetBlockDAGInfo.
+var methods = func() map[string]appmessage.MessageCommand {
+	methods := make(map[string]appmessage.MessageCommand)
+	for command, name := range appmessage.RPCMessageCommandToString {
+		if !strings.HasSuffix(name, requestSuffix) {
+			continue
+		}
+		methods[strings.ToLower(strings.TrimSuffix(name, requestSuffix))] = command
+	}
+	return methods
+}()
+
+// Role is a set of RPC methods that the users and tokens it is
+// assigned to are allowed to call
+type Role struct {
+	name      string
+	allowsAll bool
+	methods   map[appmessage.MessageCommand]struct{}
+}
+
+// Name returns the name of the role
+func (r *Role) Name() string {
+	return r.name
+}
+
+// IsAllowed returns whether the role may call the RPC method of the given
+// request command. A nil role, which is the role of clients that failed
+// to authenticate, may call nothing.
+func (r *Role) IsAllowed(command appmessage.MessageCommand) bool {
+	if r == nil {
+		return false
+	}
+	if r.allowsAll {
+		return true
+	}
+	_, ok := r.methods[command]
+	return ok
+}
+
+func builtInRoles() map[string]*Role {
+	readOnlyRole := &Role{name: ReadOnlyRoleName, methods: make(map[appmessage.MessageCommand]struct{})}
+	for _, command := range readOnlyMethods {
+		readOnlyRole.methods[command] = struct{}{}
+	}
+
+	return map[string]*Role{
+		AdminRoleName:    {name: AdminRoleName, allowsAll: true},
+		ReadOnlyRoleName: readOnlyRole,
+	}
+}
+
+// parseRole parses a role in the form <name>:<method>[,<method>...].
+// Method names are case insensitive.
+func parseRole(roleString string) (*Role, error) {
+	name, methodList, ok := strings.Cut(roleString, ":")
+	if !ok || name == "" || methodList == "" {
+		return nil, errors.Errorf("invalid RPC role '%s': expected <name>:<method>[,<method>...]", roleString)
+	}
+
+	role := &Role{name: name, methods: make(map[appmessage.MessageCommand]struct{})}
+	for _, methodName := range strings.Split(methodList, ",") {
+		methodName = strings.TrimSpace(methodName)
+		command, ok := methods[strings.ToLower(methodName)]
+		if !ok {
+			return nil, errors.Errorf("invalid RPC role '%s': unknown method '%s'", name, methodName)
+		}
+		role.methods[command] = struct{}{}
+	}
+	return role, nil
+}
@@ -0,0 +1,105 @@
+package rpccontext
+
+import (
+	"sync"
+	"time"
+
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/domain/consensus/utils/consensushashing"
+)
+
+const (
+	// blockTemplateLifetime is how long a block template handed out to a
+	// miner may be referred to by its ID
+	blockTemplateLifetime = 2 * time.Minute
+
+	// maxBlockTemplates is the maximum amount of block templates kept at
+	// the same time. The oldest templates are dropped first.
+	maxBlockTemplates = 512
+)
+
+type blockTemplateEntry struct {
+	block   *externalapi.DomainBlock
+	addedAt time.Time
+}
+
+// BlockTemplates keeps the block templates recently handed out to miners,
+// so that they can be long-polled and submitted by their IDs
+type BlockTemplates struct {
+	mtx       sync.Mutex
+	templates map[string]*blockTemplateEntry
+	order     []string
+	changed   chan struct{}
+}
+
+// NewBlockTemplates creates a new, empty BlockTemplates
+func NewBlockTemplates() *BlockTemplates {
+	return &BlockTemplates{
+		templates: make(map[string]*blockTemplateEntry),
+		changed:   make(chan struct{}),
+	}
+}
+
+// Add stores the given block template and returns its ID
+func (bt *BlockTemplates) Add(block *externalapi.DomainBlock) string {
+	bt.mtx.Lock()
+	defer bt.mtx.Unlock()
+
+	id := consensushashing.BlockHash(block).String()
+	if _, ok := bt.templates[id]; ok {
+		return id
+	}
+
+	bt.removeExpired(time.Now())
+	if len(bt.order) >= maxBlockTemplates {
+		delete(bt.templates, bt.order[0])
+		bt.order = bt.order[1:]
+	}
+	bt.templates[id] = &blockTemplateEntry{block: block.Clone(), addedAt: time.Now()}
+	bt.order = append(bt.order, id)
+	return id
+}
+
+// Get returns a clone of the block template of the given ID, and whether
+// such a template is still known
+func (bt *BlockTemplates) Get(id string) (*externalapi.DomainBlock, bool) {
+	bt.mtx.Lock()
+	defer bt.mtx.Unlock()
+
+	bt.removeExpired(time.Now())
+	entry, ok := bt.templates[id]
+	if !ok {
+		return nil, false
+	}
+	return entry.block.Clone(), true
+}
+
+// Changed returns a channel that's closed the next time a new block
+// template is available
+func (bt *BlockTemplates) Changed() <-chan struct{} {
+	bt.mtx.Lock()
+	defer bt.mtx.Unlock()
+
+	return bt.changed
+}
+
+// NotifyNewBlockTemplate wakes up everyone waiting for a new block template
+func (bt *BlockTemplates) NotifyNewBlockTemplate() {
+	bt.mtx.Lock()
+	defer bt.mtx.Unlock()
+
+	close(bt.changed)
+	bt.changed = make(chan struct{})
+}
+
+func (bt *BlockTemplates) removeExpired(now time.Time) {
+	expired := 0
+	for _, id := range bt.order {
+		if now.Sub(bt.templates[id].addedAt) < blockTemplateLifetime {
+			break
+		}
+		delete(bt.templates, id)
+		expired++
+	}
+	bt.order = bt.order[expired:]
+}
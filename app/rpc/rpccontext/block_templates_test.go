@@ -0,0 +1,107 @@
+package rpccontext
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/domain/consensus/utils/blockheader"
+)
+
+func blockTemplateForTest(nonce uint64) *externalapi.DomainBlock {
+	header := blockheader.NewImmutableBlockHeader(
+		0,
+		[]externalapi.BlockLevelParents{[]*externalapi.DomainHash{externalapi.NewDomainHashFromByteArray(&[32]byte{1})}},
+		&externalapi.DomainHash{},
+		&externalapi.DomainHash{},
+		&externalapi.DomainHash{},
+		1234,
+		0x207fffff,
+		nonce,
+		1,
+		1,
+		big.NewInt(1),
+		&externalapi.DomainHash{},
+	)
+	return &externalapi.DomainBlock{Header: header, Transactions: []*externalapi.DomainTransaction{}}
+}
+
+func TestBlockTemplates(t *testing.T) {
+	blockTemplates := NewBlockTemplates()
+
+	template := blockTemplateForTest(0)
+	id := blockTemplates.Add(template)
+	if sameID := blockTemplates.Add(template); sameID != id {
+		t.Fatalf("Adding the same template twice returned different IDs %s and %s", id, sameID)
+	}
+	if len(blockTemplates.order) != 1 {
+		t.Fatalf("Expected a template added twice to be stored once, but got %d templates", len(blockTemplates.order))
+	}
+
+	storedTemplate, ok := blockTemplates.Get(id)
+	if !ok {
+		t.Fatalf("Template %s wasn't found", id)
+	}
+	if !storedTemplate.Equal(template) {
+		t.Fatalf("Got a different template than the one added")
+	}
+	mutableHeader := storedTemplate.Header.ToMutable()
+	mutableHeader.SetNonce(1)
+	storedTemplate.Header = mutableHeader.ToImmutable()
+	storedTemplate, _ = blockTemplates.Get(id)
+	if !storedTemplate.Equal(template) {
+		t.Fatalf("Modifying a returned template modified the stored one")
+	}
+
+	if _, ok := blockTemplates.Get("unknown"); ok {
+		t.Fatalf("An unknown template was found")
+	}
+
+	// The oldest templates are dropped once there are too many
+	for nonce := uint64(1); nonce <= maxBlockTemplates; nonce++ {
+		blockTemplates.Add(blockTemplateForTest(nonce))
+	}
+	if _, ok := blockTemplates.Get(id); ok {
+		t.Fatalf("The oldest template wasn't dropped")
+	}
+	if len(blockTemplates.templates) != maxBlockTemplates || len(blockTemplates.order) != maxBlockTemplates {
+		t.Fatalf("Expected %d templates but got %d", maxBlockTemplates, len(blockTemplates.templates))
+	}
+
+	// Expired templates are dropped
+	newestID := blockTemplates.order[len(blockTemplates.order)-1]
+	for _, entry := range blockTemplates.templates {
+		entry.addedAt = time.Now().Add(-blockTemplateLifetime)
+	}
+	if _, ok := blockTemplates.Get(newestID); ok {
+		t.Fatalf("An expired template was found")
+	}
+	if len(blockTemplates.templates) != 0 || len(blockTemplates.order) != 0 {
+		t.Fatalf("Expected all templates to expire but %d are left", len(blockTemplates.templates))
+	}
+}
+
+func TestBlockTemplatesChanged(t *testing.T) {
+	blockTemplates := NewBlockTemplates()
+
+	changed := blockTemplates.Changed()
+	select {
+	case <-changed:
+		t.Fatalf("Changed was closed before a new block template was available")
+	default:
+	}
+
+	blockTemplates.NotifyNewBlockTemplate()
+	select {
+	case <-changed:
+	default:
+		t.Fatalf("Changed wasn't closed when a new block template was available")
+	}
+
+	select {
+	case <-blockTemplates.Changed():
+		t.Fatalf("Changed wasn't replaced after a new block template was available")
+	default:
+	}
+}
@@ -3,8 +3,11 @@ package rpccontext
 import (
 	"github.com/kobradag/kobrad/app/protocol"
 	"github.com/kobradag/kobrad/domain"
+	"github.com/kobradag/kobrad/domain/addressindex"
+	"github.com/kobradag/kobrad/domain/txindex"
 	"github.com/kobradag/kobrad/domain/utxoindex"
 	"github.com/kobradag/kobrad/infrastructure/config"
+	"github.com/kobradag/kobrad/infrastructure/db/database"
 	"github.com/kobradag/kobrad/infrastructure/network/addressmanager"
 	"github.com/kobradag/kobrad/infrastructure/network/connmanager"
 	"github.com/kobradag/kobrad/infrastructure/network/netadapter"
@@ -15,36 +18,47 @@ type Context struct {
 	Config            *config.Config
 	NetAdapter        *netadapter.NetAdapter
 	Domain            domain.Domain
+	Database          database.Database
 	ProtocolManager   *protocol.Manager
 	ConnectionManager *connmanager.ConnectionManager
 	AddressManager    *addressmanager.AddressManager
 	UTXOIndex         *utxoindex.UTXOIndex
+	TXIndex           *txindex.TXIndex
+	AddressIndex      *addressindex.AddressIndex
 	ShutDownChan      chan<- struct{}
 
 	NotificationManager *NotificationManager
+	BlockTemplates      *BlockTemplates
 }
 
 // NewContext creates a new RPC context
 func NewContext(cfg *config.Config,
 	domain domain.Domain,
+	db database.Database,
 	netAdapter *netadapter.NetAdapter,
 	protocolManager *protocol.Manager,
 	connectionManager *connmanager.ConnectionManager,
 	addressManager *addressmanager.AddressManager,
 	utxoIndex *utxoindex.UTXOIndex,
+	txIndex *txindex.TXIndex,
+	addressIndex *addressindex.AddressIndex,
 	shutDownChan chan<- struct{}) *Context {
 
 	context := &Context{
 		Config:            cfg,
 		NetAdapter:        netAdapter,
 		Domain:            domain,
+		Database:          db,
 		ProtocolManager:   protocolManager,
 		ConnectionManager: connectionManager,
 		AddressManager:    addressManager,
 		UTXOIndex:         utxoIndex,
+		TXIndex:           txIndex,
+		AddressIndex:      addressIndex,
 		ShutDownChan:      shutDownChan,
 	}
 	context.NotificationManager = NewNotificationManager(cfg.ActiveNetParams)
+	context.BlockTemplates = NewBlockTemplates()
 
 	return context
 }
@@ -0,0 +1,51 @@
+package rpchandlers
+
+import (
+	"path/filepath"
+
+	"github.com/kobradag/kobrad/app/appmessage"
+	"github.com/kobradag/kobrad/app/backup"
+	"github.com/kobradag/kobrad/app/rpc/rpccontext"
+	"github.com/kobradag/kobrad/infrastructure/network/netadapter/router"
+)
+
+// backupCacheSizeMiB is the size of the cache of the database that a backup
+// is written to, which is only written to
+const backupCacheSizeMiB = 16
+
+// HandleBackup handles the respectively named RPC command
+func HandleBackup(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	if context.Config.SafeRPC {
+		log.Warn("Backup RPC command called while node in safe RPC mode -- ignoring.")
+		errorMessage := &appmessage.BackupResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Backup RPC command called while node in safe RPC mode")
+		return errorMessage, nil
+	}
+
+	backupRequest := request.(*appmessage.BackupRequestMessage)
+	if !filepath.IsAbs(backupRequest.TargetDirectory) {
+		errorMessage := &appmessage.BackupResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("The target directory must be an absolute path, got '%s'",
+			backupRequest.TargetDirectory)
+		return errorMessage, nil
+	}
+
+	options := context.Config.DatabaseOptions()
+	options.LevelDB.CacheSizeMiB = backupCacheSizeMiB
+	stats, err := backup.Backup(context.Database, backupRequest.TargetDirectory, context.Config.DbType, options)
+	if err != nil {
+		errorMessage := &appmessage.BackupResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not back up the database: %s", err)
+		return errorMessage, nil
+	}
+
+	sections := make([]*appmessage.BackupSection, len(stats))
+	for i, sectionStats := range stats {
+		sections[i] = &appmessage.BackupSection{
+			Name:     sectionStats.Name,
+			KeyCount: sectionStats.KeyCount,
+			Size:     sectionStats.Size,
+		}
+	}
+	return appmessage.NewBackupResponseMessage(sections), nil
+}
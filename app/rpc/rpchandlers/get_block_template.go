@@ -1,36 +1,64 @@
 package rpchandlers
 
 import (
+	"encoding/hex"
+	"math"
+	"time"
+
 	"github.com/kobradag/kobrad/app/appmessage"
 	"github.com/kobradag/kobrad/app/rpc/rpccontext"
 	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/domain/consensus/ruleerrors"
+	"github.com/kobradag/kobrad/domain/consensus/utils/constants"
 	"github.com/kobradag/kobrad/domain/consensus/utils/transactionhelper"
 	"github.com/kobradag/kobrad/domain/consensus/utils/txscript"
 	"github.com/kobradag/kobrad/infrastructure/network/netadapter/router"
 	"github.com/kobradag/kobrad/util"
 	"github.com/kobradag/kobrad/version"
+	"github.com/pkg/errors"
 )
 
 // HandleGetBlockTemplate handles the respectively named RPC command
 func HandleGetBlockTemplate(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
 	getBlockTemplateRequest := request.(*appmessage.GetBlockTemplateRequestMessage)
 
-	payAddress, err := util.DecodeAddress(getBlockTemplateRequest.PayAddress, context.Config.ActiveNetParams.Prefix)
-	if err != nil {
-		errorMessage := &appmessage.GetBlockTemplateResponseMessage{}
-		errorMessage.Error = appmessage.RPCErrorf("Could not decode address: %s", err)
-		return errorMessage, nil
-	}
+	var coinbaseData *externalapi.DomainCoinbaseData
+	if len(getBlockTemplateRequest.Payees) > 0 {
+		if getBlockTemplateRequest.PayAddress != "" {
+			errorMessage := &appmessage.GetBlockTemplateResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("payAddress and payees can't be given together")
+			return errorMessage, nil
+		}
+		var err error
+		coinbaseData, err = coinbaseDataFromPayees(getBlockTemplateRequest.Payees)
+		if err != nil {
+			errorMessage := &appmessage.GetBlockTemplateResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("Invalid payees: %s", err)
+			return errorMessage, nil
+		}
+	} else {
+		payAddress, err := util.DecodeAddress(getBlockTemplateRequest.PayAddress, context.Config.ActiveNetParams.Prefix)
+		if err != nil {
+			errorMessage := &appmessage.GetBlockTemplateResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("Could not decode address: %s", err)
+			return errorMessage, nil
+		}
 
-	scriptPublicKey, err := txscript.PayToAddrScript(payAddress)
-	if err != nil {
-		return nil, err
+		scriptPublicKey, err := txscript.PayToAddrScript(payAddress)
+		if err != nil {
+			return nil, err
+		}
+		coinbaseData = &externalapi.DomainCoinbaseData{ScriptPublicKey: scriptPublicKey}
 	}
-
-	coinbaseData := &externalapi.DomainCoinbaseData{ScriptPublicKey: scriptPublicKey, ExtraData: []byte(version.Version() + "/" + getBlockTemplateRequest.ExtraData)}
+	coinbaseData.ExtraData = []byte(version.Version() + "/" + getBlockTemplateRequest.ExtraData)
 
 	templateBlock, isNearlySynced, err := context.Domain.MiningManager().GetBlockTemplate(coinbaseData)
 	if err != nil {
+		if errors.Is(err, ruleerrors.ErrBadCoinbasePayees) {
+			errorMessage := &appmessage.GetBlockTemplateResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("Invalid payees: %s", err)
+			return errorMessage, nil
+		}
 		return nil, err
 	}
 
@@ -40,7 +68,119 @@ func HandleGetBlockTemplate(context *rpccontext.Context, _ *router.Router, reque
 		return errorMessage, nil
 	}
 
+	if getBlockTemplateRequest.LongPollID != "" {
+		templateBlock, isNearlySynced, err = longPollBlockTemplate(context, getBlockTemplateRequest.LongPollID,
+			coinbaseData, templateBlock, isNearlySynced)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	templateID := context.BlockTemplates.Add(templateBlock)
 	rpcBlock := appmessage.DomainBlockToRPCBlock(templateBlock)
 
-	return appmessage.NewGetBlockTemplateResponseMessage(rpcBlock, context.ProtocolManager.Context().HasPeers() && isNearlySynced), nil
+	return appmessage.NewGetBlockTemplateResponseMessage(rpcBlock,
+		context.ProtocolManager.Context().HasPeers() && isNearlySynced, templateID), nil
+}
+
+const (
+	// longPollTimeout is the maximum duration a long-polling request waits
+	// for a materially different block template
+	longPollTimeout = time.Minute
+
+	// longPollRefreshInterval is how often a long-polling request rebuilds its
+	// block template to see whether the fees it pays have risen
+	longPollRefreshInterval = time.Second
+
+	// longPollFeeIncreasePercent is by how much the fees of a block template
+	// have to rise for it to be considered materially different
+	longPollFeeIncreasePercent = 10
+)
+
+// longPollBlockTemplate waits until templateBlock is materially different
+// from the block template of the given ID, rebuilding it whenever a new block
+// template may be available. If the ID is unknown, templateBlock is returned
+// as is.
+func longPollBlockTemplate(context *rpccontext.Context, longPollID string, coinbaseData *externalapi.DomainCoinbaseData,
+	templateBlock *externalapi.DomainBlock, isNearlySynced bool) (*externalapi.DomainBlock, bool, error) {
+
+	previousTemplateBlock, ok := context.BlockTemplates.Get(longPollID)
+	if !ok {
+		return templateBlock, isNearlySynced, nil
+	}
+
+	timeout := time.After(longPollTimeout)
+	ticker := time.NewTicker(longPollRefreshInterval)
+	defer ticker.Stop()
+
+	for !isBlockTemplateMateriallyDifferent(previousTemplateBlock, templateBlock) {
+		select {
+		case <-context.BlockTemplates.Changed():
+		case <-ticker.C:
+		case <-timeout:
+			return templateBlock, isNearlySynced, nil
+		}
+
+		var err error
+		templateBlock, isNearlySynced, err = context.Domain.MiningManager().GetBlockTemplate(coinbaseData)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+	return templateBlock, isNearlySynced, nil
+}
+
+// isBlockTemplateMateriallyDifferent returns whether miners should switch from
+// previous to current: either its parents changed, or it pays significantly
+// more fees
+func isBlockTemplateMateriallyDifferent(previous, current *externalapi.DomainBlock) bool {
+	if !externalapi.HashesEqual(previous.Header.DirectParents(), current.Header.DirectParents()) {
+		return true
+	}
+	previousFees := blockTemplateFees(previous)
+	currentFees := blockTemplateFees(current)
+	return currentFees > previousFees && currentFees*100 >= previousFees*(100+longPollFeeIncreasePercent)
+}
+
+func blockTemplateFees(templateBlock *externalapi.DomainBlock) uint64 {
+	fees := uint64(0)
+	for _, transaction := range templateBlock.Transactions[transactionhelper.CoinbaseTransactionIndex+1:] {
+		fees += transaction.Fee
+	}
+	return fees
+}
+
+// coinbaseDataFromPayees converts the given payees to coinbase data whose
+// script public key is the one of the first payee
+func coinbaseDataFromPayees(rpcPayees []*appmessage.RPCCoinbasePayee) (*externalapi.DomainCoinbaseData, error) {
+	if len(rpcPayees) > constants.MaxCoinbasePayees {
+		return nil, errors.Errorf("at most %d payees are allowed", constants.MaxCoinbasePayees)
+	}
+
+	payees := make([]*externalapi.DomainCoinbasePayee, len(rpcPayees))
+	for i, rpcPayee := range rpcPayees {
+		if rpcPayee.ScriptPublicKey == nil {
+			return nil, errors.Errorf("payee %d has no script public key", i)
+		}
+		if rpcPayee.Weight == 0 || rpcPayee.Weight > math.MaxUint16 {
+			return nil, errors.Errorf("the weight of payee %d must be between 1 and %d", i, math.MaxUint16)
+		}
+		script, err := hex.DecodeString(rpcPayee.ScriptPublicKey.Script)
+		if err != nil {
+			return nil, errors.Wrapf(err, "the script public key of payee %d isn't valid hex", i)
+		}
+		payees[i] = &externalapi.DomainCoinbasePayee{
+			ScriptPublicKey: &externalapi.ScriptPublicKey{Script: script, Version: rpcPayee.ScriptPublicKey.Version},
+			Weight:          uint16(rpcPayee.Weight),
+		}
+	}
+
+	if len(payees) == 1 {
+		return &externalapi.DomainCoinbaseData{ScriptPublicKey: payees[0].ScriptPublicKey}, nil
+	}
+	return &externalapi.DomainCoinbaseData{
+		ScriptPublicKey:  payees[0].ScriptPublicKey,
+		Weight:           payees[0].Weight,
+		AdditionalPayees: payees[1:],
+	}, nil
 }
@@ -0,0 +1,53 @@
+package rpchandlers
+
+import (
+	"github.com/kobradag/kobrad/app/appmessage"
+	"github.com/kobradag/kobrad/app/rpc/rpccontext"
+	"github.com/kobradag/kobrad/infrastructure/db/database"
+	"github.com/kobradag/kobrad/infrastructure/network/netadapter/router"
+)
+
+// HandleGetDatabaseStats handles the respectively named RPC command
+func HandleGetDatabaseStats(context *rpccontext.Context, _ *router.Router, _ appmessage.Message) (appmessage.Message, error) {
+	statsReporter, ok := context.Database.(database.StatsReporter)
+	if !ok {
+		errorMessage := &appmessage.GetDatabaseStatsResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("The %s database backend doesn't report statistics", context.Config.DbType)
+		return errorMessage, nil
+	}
+
+	stats, err := statsReporter.Stats()
+	if err != nil {
+		return nil, err
+	}
+
+	levels := make([]*appmessage.DatabaseLevelStats, len(stats.Levels))
+	for i, level := range stats.Levels {
+		levels[i] = &appmessage.DatabaseLevelStats{
+			Level:                  uint32(level.Level),
+			TableCount:             int64(level.TableCount),
+			Size:                   level.Size,
+			ReadBytes:              level.ReadBytes,
+			WriteBytes:             level.WriteBytes,
+			CompactionMilliseconds: uint64(level.CompactionDuration.Milliseconds()),
+		}
+	}
+
+	return &appmessage.GetDatabaseStatsResponseMessage{
+		DatabaseType:             context.Config.DbType,
+		Levels:                   levels,
+		MemCompactionCount:       stats.MemCompactionCount,
+		Level0CompactionCount:    stats.Level0CompactionCount,
+		NonLevel0CompactionCount: stats.NonLevel0CompactionCount,
+		SeekCompactionCount:      stats.SeekCompactionCount,
+		WriteDelayCount:          stats.WriteDelayCount,
+		WriteDelayMilliseconds:   uint64(stats.WriteDelayDuration.Milliseconds()),
+		IsWritePaused:            stats.IsWritePaused,
+		ReadBytes:                stats.ReadBytes,
+		WriteBytes:               stats.WriteBytes,
+		BlockCacheSize:           int64(stats.BlockCacheSize),
+		OpenedTableCount:         int64(stats.OpenedTableCount),
+		AliveSnapshotCount:       stats.AliveSnapshotCount,
+		AliveIteratorCount:       stats.AliveIteratorCount,
+	}, nil
+}
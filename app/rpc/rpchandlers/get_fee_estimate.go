@@ -0,0 +1,27 @@
+package rpchandlers
+
+import (
+	"github.com/kobradag/kobrad/app/appmessage"
+	"github.com/kobradag/kobrad/app/rpc/rpccontext"
+	miningmanagermodel "github.com/kobradag/kobrad/domain/miningmanager/model"
+	"github.com/kobradag/kobrad/infrastructure/network/netadapter/router"
+)
+
+// HandleGetFeeEstimate handles the respectively named RPC command
+func HandleGetFeeEstimate(context *rpccontext.Context, _ *router.Router, _ appmessage.Message) (appmessage.Message, error) {
+	feeEstimate := context.Domain.MiningManager().GetFeeEstimate()
+
+	secondsPerBlock := context.Config.ActiveNetParams.TargetTimePerBlock.Seconds()
+	toRPCFeeRateBucket := func(bucket miningmanagermodel.FeeRateBucket) appmessage.RPCFeeRateBucket {
+		return appmessage.RPCFeeRateBucket{
+			FeeRate:          bucket.FeeRate,
+			EstimatedSeconds: float64(bucket.EstimatedBlocks) * secondsPerBlock,
+		}
+	}
+
+	return appmessage.NewGetFeeEstimateResponseMessage(&appmessage.RPCFeeEstimate{
+		PriorityBucket: toRPCFeeRateBucket(feeEstimate.PriorityBucket),
+		NormalBucket:   toRPCFeeRateBucket(feeEstimate.NormalBucket),
+		LowBucket:      toRPCFeeRateBucket(feeEstimate.LowBucket),
+	}), nil
+}
@@ -0,0 +1,82 @@
+package rpchandlers
+
+import (
+	"github.com/kobradag/kobrad/app/appmessage"
+	"github.com/kobradag/kobrad/app/rpc/rpccontext"
+	"github.com/kobradag/kobrad/domain/consensus/utils/consensushashing"
+	"github.com/kobradag/kobrad/domain/consensus/utils/transactionid"
+	"github.com/kobradag/kobrad/infrastructure/network/netadapter/router"
+)
+
+// HandleGetTransaction handles the respectively named RPC command
+func HandleGetTransaction(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	if !context.Config.TXIndex {
+		errorMessage := &appmessage.GetTransactionResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Method unavailable when kobrad is run without --txindex")
+		return errorMessage, nil
+	}
+
+	getTransactionRequest := request.(*appmessage.GetTransactionRequestMessage)
+
+	transactionID, err := transactionid.FromString(getTransactionRequest.TxID)
+	if err != nil {
+		errorMessage := &appmessage.GetTransactionResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Transaction ID could not be parsed: %s", err)
+		return errorMessage, nil
+	}
+
+	txAcceptanceData, found, err := context.TXIndex.TXAcceptanceData(transactionID)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		errorMessage := &appmessage.GetTransactionResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Transaction %s was not found in the transaction index", transactionID)
+		return errorMessage, nil
+	}
+
+	block, found, err := context.Domain.Consensus().GetBlock(txAcceptanceData.IncludingBlockHash)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		errorMessage := &appmessage.GetTransactionResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Block %s including transaction %s could not be retrieved, "+
+			"it might have been pruned", txAcceptanceData.IncludingBlockHash, transactionID)
+		return errorMessage, nil
+	}
+
+	var rpcTransaction *appmessage.RPCTransaction
+	for _, transaction := range block.Transactions {
+		if consensushashing.TransactionID(transaction).Equal(transactionID) {
+			rpcTransaction = appmessage.DomainTransactionToRPCTransaction(transaction)
+			break
+		}
+	}
+	if rpcTransaction == nil {
+		errorMessage := &appmessage.GetTransactionResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Transaction %s was not found in block %s",
+			transactionID, txAcceptanceData.IncludingBlockHash)
+		return errorMessage, nil
+	}
+	err = context.PopulateTransactionWithVerboseData(rpcTransaction, block.Header)
+	if err != nil {
+		return nil, err
+	}
+
+	virtualInfo, err := context.Domain.Consensus().GetVirtualInfo()
+	if err != nil {
+		return nil, err
+	}
+	acceptingBlockInfo, err := context.Domain.Consensus().GetBlockInfo(txAcceptanceData.AcceptingBlockHash)
+	if err != nil {
+		return nil, err
+	}
+	var confirmations uint64
+	if virtualInfo.BlueScore > acceptingBlockInfo.BlueScore {
+		confirmations = virtualInfo.BlueScore - acceptingBlockInfo.BlueScore
+	}
+
+	return appmessage.NewGetTransactionResponseMessage(rpcTransaction, txAcceptanceData.IncludingBlockHash.String(),
+		txAcceptanceData.AcceptingBlockHash.String(), confirmations), nil
+}
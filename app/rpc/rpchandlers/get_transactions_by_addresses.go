@@ -0,0 +1,92 @@
+package rpchandlers
+
+import (
+	"github.com/kobradag/kobrad/app/appmessage"
+	"github.com/kobradag/kobrad/app/rpc/rpccontext"
+	"github.com/kobradag/kobrad/domain/addressindex"
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/domain/consensus/utils/txscript"
+	"github.com/kobradag/kobrad/infrastructure/network/netadapter/router"
+	"github.com/kobradag/kobrad/util"
+)
+
+const (
+	defaultTransactionsByAddressesLimit = 100
+	maxTransactionsByAddressesLimit     = 1000
+)
+
+// HandleGetTransactionsByAddresses handles the respectively named RPC command
+func HandleGetTransactionsByAddresses(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	if !context.Config.AddressIndex {
+		errorMessage := &appmessage.GetTransactionsByAddressesResponseMessage{}
+		errorMessage.Error = &appmessage.RPCError{Message: appmessage.AddressIndexUnavailableMessage}
+		return errorMessage, nil
+	}
+
+	getTransactionsByAddressesRequest := request.(*appmessage.GetTransactionsByAddressesRequestMessage)
+
+	limit := int(getTransactionsByAddressesRequest.Limit)
+	if limit == 0 {
+		limit = defaultTransactionsByAddressesLimit
+	}
+	if limit > maxTransactionsByAddressesLimit {
+		errorMessage := &appmessage.GetTransactionsByAddressesResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Limit %d is greater than the maximum of %d",
+			limit, maxTransactionsByAddressesLimit)
+		return errorMessage, nil
+	}
+
+	var start *addressindex.HistoryPosition
+	if getTransactionsByAddressesRequest.Cursor != "" {
+		var err error
+		start, err = addressindex.NewHistoryPositionFromString(getTransactionsByAddressesRequest.Cursor)
+		if err != nil {
+			errorMessage := &appmessage.GetTransactionsByAddressesResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("Could not parse cursor: %s", err)
+			return errorMessage, nil
+		}
+	}
+
+	scriptPublicKeys := make([]*externalapi.ScriptPublicKey, len(getTransactionsByAddressesRequest.Addresses))
+	addresses := make(map[string]string, len(getTransactionsByAddressesRequest.Addresses))
+	for i, addressString := range getTransactionsByAddressesRequest.Addresses {
+		address, err := util.DecodeAddress(addressString, context.Config.ActiveNetParams.Prefix)
+		if err != nil {
+			errorMessage := &appmessage.GetTransactionsByAddressesResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("Could not decode address '%s': %s", addressString, err)
+			return errorMessage, nil
+		}
+		scriptPublicKey, err := txscript.PayToAddrScript(address)
+		if err != nil {
+			errorMessage := &appmessage.GetTransactionsByAddressesResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("Could not create a scriptPublicKey for address '%s': %s", addressString, err)
+			return errorMessage, nil
+		}
+		scriptPublicKeys[i] = scriptPublicKey
+		addresses[scriptPublicKey.String()] = addressString
+	}
+
+	historyEntries, next, err := context.AddressIndex.History(scriptPublicKeys, start, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*appmessage.TransactionsByAddressesEntry, len(historyEntries))
+	for i, historyEntry := range historyEntries {
+		entries[i] = &appmessage.TransactionsByAddressesEntry{
+			Address:                addresses[historyEntry.ScriptPublicKey.String()],
+			TransactionID:          historyEntry.TransactionID.String(),
+			IncludingBlockHash:     historyEntry.IncludingBlockHash.String(),
+			AcceptingBlockHash:     historyEntry.AcceptingBlockHash.String(),
+			AcceptingBlockDAAScore: historyEntry.AcceptingBlockDAAScore,
+			Received:               historyEntry.Received,
+			Sent:                   historyEntry.Sent,
+		}
+	}
+	var nextCursor string
+	if next != nil {
+		nextCursor = next.String()
+	}
+
+	return appmessage.NewGetTransactionsByAddressesResponseMessage(entries, nextCursor), nil
+}
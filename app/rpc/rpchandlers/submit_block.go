@@ -6,6 +6,7 @@ import (
 	"github.com/kobradag/kobrad/app/appmessage"
 	"github.com/kobradag/kobrad/app/protocol/protocolerrors"
 	"github.com/kobradag/kobrad/app/rpc/rpccontext"
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
 	"github.com/kobradag/kobrad/domain/consensus/ruleerrors"
 	"github.com/kobradag/kobrad/domain/consensus/utils/consensushashing"
 	"github.com/kobradag/kobrad/infrastructure/network/netadapter/router"
@@ -35,12 +36,30 @@ func HandleSubmitBlock(context *rpccontext.Context, _ *router.Router, request ap
 */
 	}
 
-	domainBlock, err := appmessage.RPCBlockToDomainBlock(submitBlockRequest.Block)
-	if err != nil {
-		return &appmessage.SubmitBlockResponseMessage{
-			Error:        appmessage.RPCErrorf("Could not parse block: %s", err),
-			RejectReason: appmessage.RejectReasonBlockInvalid,
-		}, nil
+	var domainBlock *externalapi.DomainBlock
+	if submitBlockRequest.TemplateID != "" {
+		var ok bool
+		domainBlock, ok = context.BlockTemplates.Get(submitBlockRequest.TemplateID)
+		if !ok {
+			return &appmessage.SubmitBlockResponseMessage{
+				Error:        appmessage.RPCErrorf("Block template %s is unknown or expired", submitBlockRequest.TemplateID),
+				RejectReason: appmessage.RejectReasonBlockInvalid,
+			}, nil
+		}
+		mutableHeader := domainBlock.Header.ToMutable()
+		mutableHeader.SetNonce(submitBlockRequest.Nonce)
+		if submitBlockRequest.Timestamp != 0 {
+			mutableHeader.SetTimeInMilliseconds(submitBlockRequest.Timestamp)
+		}
+		domainBlock.Header = mutableHeader.ToImmutable()
+	} else {
+		domainBlock, err = appmessage.RPCBlockToDomainBlock(submitBlockRequest.Block)
+		if err != nil {
+			return &appmessage.SubmitBlockResponseMessage{
+				Error:        appmessage.RPCErrorf("Could not parse block: %s", err),
+				RejectReason: appmessage.RejectReasonBlockInvalid,
+			}, nil
+		}
 	}
 
 	if !submitBlockRequest.AllowNonDAABlocks {
@@ -67,7 +86,7 @@ func HandleSubmitBlock(context *rpccontext.Context, _ *router.Router, request ap
 			return nil, err
 		}
 
-		jsonBytes, _ := json.MarshalIndent(submitBlockRequest.Block.Header, "", "    ")
+		jsonBytes, _ := json.MarshalIndent(appmessage.DomainBlockToRPCBlock(domainBlock).Header, "", "    ")
 		if jsonBytes != nil {
 			log.Warnf("The RPC submitted block triggered a rule/protocol error (%s), printing "+
 				"the full header for debug purposes: \n%s", err, string(jsonBytes))
@@ -0,0 +1,128 @@
+package utxosnapshot
+
+import (
+	"os"
+
+	"github.com/kobradag/kobrad/app/dbversion"
+	"github.com/kobradag/kobrad/domain"
+	"github.com/kobradag/kobrad/domain/consensus"
+	"github.com/kobradag/kobrad/domain/miningmanager/mempool"
+	"github.com/kobradag/kobrad/infrastructure/db/database"
+	"github.com/kobradag/kobrad/infrastructure/db/database/backends"
+	"github.com/pkg/errors"
+)
+
+// importDirectorySuffix is appended to the path of the new database for the
+// directory that the snapshot is imported into. The directory is renamed
+// once the import is done, so that an interrupted import never leaves an
+// incomplete database in place.
+const importDirectorySuffix = ".import"
+
+// ExportFromDatabase exports a snapshot of the database of a stopped node at
+// dbPath to a new file at snapshotPath
+func ExportFromDatabase(dbPath string, options *backends.Options, consensusConfig *consensus.Config,
+	snapshotPath string) (*Header, *Stats, error) {
+
+	db, err := backends.OpenExisting(dbPath, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer db.Close()
+
+	err = dbversion.Check(dbPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var header *Header
+	var stats *Stats
+	err = withDomain(consensusConfig, db, func(domain domain.Domain) error {
+		header, stats, err = Export(domain.Consensus(), &consensusConfig.Params, snapshotPath)
+		return err
+	})
+	return header, stats, err
+}
+
+// ImportIntoNewDatabase creates a new database of the given type at dbPath,
+// and bootstraps it from the snapshot file at snapshotPath. It fails if
+// there's already a database at dbPath.
+func ImportIntoNewDatabase(snapshotPath string, dbPath string, dbType string, options *backends.Options,
+	consensusConfig *consensus.Config) (*Header, *Stats, error) {
+
+	_, err := os.Stat(dbPath)
+	if err == nil {
+		return nil, nil, errors.Errorf("there's already a database at %s", dbPath)
+	}
+	if !os.IsNotExist(err) {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	importPath := dbPath + importDirectorySuffix
+	err = os.RemoveAll(importPath)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	header, stats, err := importInto(snapshotPath, importPath, dbType, options, consensusConfig)
+	if err == nil {
+		err = dbversion.Write(importPath)
+	}
+	if err == nil {
+		err = errors.WithStack(os.Rename(importPath, dbPath))
+	}
+	if err != nil {
+		removeErr := os.RemoveAll(importPath)
+		if removeErr != nil {
+			log.Warnf("Failed removing the incomplete import at %s: %s", importPath, removeErr)
+		}
+		return nil, nil, err
+	}
+	return header, stats, nil
+}
+
+func importInto(snapshotPath string, importPath string, dbType string, options *backends.Options,
+	consensusConfig *consensus.Config) (*Header, *Stats, error) {
+
+	db, err := backends.Open(dbType, importPath, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var header *Header
+	var stats *Stats
+	err = withDomain(consensusConfig, db, func(domain domain.Domain) error {
+		header, stats, err = Import(domain, &consensusConfig.Params, snapshotPath)
+		return err
+	})
+	closeErr := db.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+	if closeErr != nil {
+		return nil, nil, closeErr
+	}
+	return header, stats, nil
+}
+
+// withDomain calls f with a domain over the given database. Nothing listens
+// to the consensus events of the domain, so they're discarded.
+func withDomain(consensusConfig *consensus.Config, db database.Database, f func(domain domain.Domain) error) error {
+	domainInstance, err := domain.New(consensusConfig, mempool.DefaultConfig(&consensusConfig.Params), db)
+	if err != nil {
+		return err
+	}
+
+	consensusEventsChannel := domainInstance.ConsensusEventsChannel()
+	done := make(chan struct{})
+	spawn("withDomain-discardConsensusEvents", func() {
+		defer close(done)
+		for range consensusEventsChannel {
+		}
+	})
+	defer func() {
+		close(consensusEventsChannel)
+		<-done
+	}()
+
+	return f(domainInstance)
+}
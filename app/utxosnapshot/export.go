@@ -0,0 +1,352 @@
+package utxosnapshot
+
+import (
+	"os"
+
+	"github.com/kobradag/kobrad/app/appmessage"
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/domain/dagconfig"
+	"github.com/kobradag/kobrad/util/mstime"
+	"github.com/pkg/errors"
+)
+
+// exportFileSuffix is appended to the path of the snapshot file for the file
+// that the snapshot is written into. The file is renamed once the snapshot
+// is complete.
+const exportFileSuffix = ".tmp"
+
+// maxHeadersPerMessage is the number of headers that are written in a
+// single message. It must be at least MergeSetSizeLimit + 1, since it's
+// passed to GetHashesBetween.
+const maxHeadersPerMessage = 1 << 10
+
+// utxoChunkSize is the number of UTXOs that are written in a single message
+const utxoChunkSize = 1000
+
+// Stats counts the data of a snapshot
+type Stats struct {
+	AnticoneBlockCount uint64
+	HeaderCount        uint64
+	UTXOCount          uint64
+	BlockCount         uint64
+}
+
+// Export writes a snapshot of the current pruning point of the given
+// consensus to a new file at snapshotPath. The consensus must not change
+// while it's exported, so it should belong to a node that isn't running.
+func Export(consensus externalapi.Consensus, params *dagconfig.Params, snapshotPath string) (*Header, *Stats, error) {
+	_, err := os.Stat(snapshotPath)
+	if err == nil {
+		return nil, nil, errors.Errorf("there's already a file at %s", snapshotPath)
+	}
+	if !os.IsNotExist(err) {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	exportPath := snapshotPath + exportFileSuffix
+	file, err := os.OpenFile(exportPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	header, stats, err := export(consensus, params, newWriter(file))
+	closeErr := file.Close()
+	if err == nil && closeErr != nil {
+		err = errors.WithStack(closeErr)
+	}
+	if err == nil {
+		err = errors.WithStack(os.Rename(exportPath, snapshotPath))
+	}
+	if err != nil {
+		removeErr := os.Remove(exportPath)
+		if removeErr != nil && !os.IsNotExist(removeErr) {
+			log.Warnf("Failed removing the incomplete snapshot at %s: %s", exportPath, removeErr)
+		}
+		return nil, nil, err
+	}
+	return header, stats, nil
+}
+
+func export(consensus externalapi.Consensus, params *dagconfig.Params, w *writer) (*Header, *Stats, error) {
+	pruningPoint, err := consensus.PruningPoint()
+	if err != nil {
+		return nil, nil, err
+	}
+	if pruningPoint.Equal(params.GenesisHash) {
+		return nil, nil, errors.New("the pruning point is still the genesis, so there's no snapshot to export")
+	}
+	pruningPointHeader, err := consensus.GetBlockHeader(pruningPoint)
+	if err != nil {
+		return nil, nil, err
+	}
+	headersSelectedTip, err := consensus.GetHeadersSelectedTip()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	header := &Header{
+		NetworkName:            params.Name,
+		PruningPointHash:       pruningPoint,
+		UTXOCommitment:         pruningPointHeader.UTXOCommitment(),
+		HeadersSelectedTipHash: headersSelectedTip,
+		CreatedAt:              mstime.Now(),
+	}
+	err = w.writeHeader(header)
+	if err != nil {
+		return nil, nil, err
+	}
+	log.Infof("Exporting a snapshot of pruning point %s", pruningPoint)
+
+	err = exportPruningPointProof(consensus, w)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = exportPruningPoints(consensus, w)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stats := &Stats{}
+	pruningPointAndItsAnticone, err := exportPruningPointAndItsAnticone(consensus, params, w, stats)
+	if err != nil {
+		return nil, nil, err
+	}
+	futureHashes, err := exportPruningPointFutureHeaders(consensus, pruningPoint, headersSelectedTip,
+		pruningPointAndItsAnticone, w, stats)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = exportPruningPointUTXOSet(consensus, pruningPoint, w, stats)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = exportPruningPointFutureBlocks(consensus, futureHashes, w, stats)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = w.finish()
+	if err != nil {
+		return nil, nil, err
+	}
+	log.Infof("Exported %d pruning point anticone blocks, %d headers, %d UTXOs and %d blocks",
+		stats.AnticoneBlockCount, stats.HeaderCount, stats.UTXOCount, stats.BlockCount)
+	return header, stats, nil
+}
+
+func exportPruningPointProof(consensus externalapi.Consensus, w *writer) error {
+	pruningPointProof, err := consensus.BuildPruningPointProof()
+	if err != nil {
+		return err
+	}
+	err = w.writeMessage(appmessage.DomainPruningPointProofToMsgPruningPointProof(pruningPointProof))
+	if err != nil {
+		return err
+	}
+	return w.endSection()
+}
+
+func exportPruningPoints(consensus externalapi.Consensus, w *writer) error {
+	pruningPointHeaders, err := consensus.PruningPointHeaders()
+	if err != nil {
+		return err
+	}
+
+	msgPruningPointHeaders := make([]*appmessage.MsgBlockHeader, len(pruningPointHeaders))
+	for i, header := range pruningPointHeaders {
+		msgPruningPointHeaders[i] = appmessage.DomainBlockHeaderToBlockHeader(header)
+	}
+	err = w.writeMessage(appmessage.NewMsgPruningPoints(msgPruningPointHeaders))
+	if err != nil {
+		return err
+	}
+	return w.endSection()
+}
+
+// exportPruningPointAndItsAnticone writes the trusted data of the pruning
+// point and its anticone, followed by the blocks themselves, and returns the
+// hashes of the blocks
+func exportPruningPointAndItsAnticone(consensus externalapi.Consensus, params *dagconfig.Params, w *writer,
+	stats *Stats) (map[externalapi.DomainHash]struct{}, error) {
+
+	pointAndItsAnticone, err := consensus.PruningPointAndItsAnticone()
+	if err != nil {
+		return nil, err
+	}
+
+	windowSize := params.DifficultyAdjustmentWindowSize
+	daaWindowBlocks := make([]*externalapi.TrustedDataDataDAAHeader, 0, windowSize)
+	daaWindowHashesToIndex := make(map[externalapi.DomainHash]int, windowSize)
+	trustedDataDAABlockIndexes := make(map[externalapi.DomainHash][]uint64)
+
+	ghostdagData := make([]*externalapi.BlockGHOSTDAGDataHashPair, 0)
+	ghostdagDataHashToIndex := make(map[externalapi.DomainHash]int)
+	trustedDataGHOSTDAGDataIndexes := make(map[externalapi.DomainHash][]uint64)
+	for _, blockHash := range pointAndItsAnticone {
+		blockDAAWindowHashes, err := consensus.BlockDAAWindowHashes(blockHash)
+		if err != nil {
+			return nil, err
+		}
+
+		trustedDataDAABlockIndexes[*blockHash] = make([]uint64, 0, windowSize)
+		for i, daaBlockHash := range blockDAAWindowHashes {
+			index, exists := daaWindowHashesToIndex[*daaBlockHash]
+			if !exists {
+				trustedDataDataDAAHeader, err := consensus.TrustedDataDataDAAHeader(blockHash, daaBlockHash, uint64(i))
+				if err != nil {
+					return nil, err
+				}
+				daaWindowBlocks = append(daaWindowBlocks, trustedDataDataDAAHeader)
+				index = len(daaWindowBlocks) - 1
+				daaWindowHashesToIndex[*daaBlockHash] = index
+			}
+
+			trustedDataDAABlockIndexes[*blockHash] = append(trustedDataDAABlockIndexes[*blockHash], uint64(index))
+		}
+
+		ghostdagDataBlockHashes, err := consensus.TrustedBlockAssociatedGHOSTDAGDataBlockHashes(blockHash)
+		if err != nil {
+			return nil, err
+		}
+
+		trustedDataGHOSTDAGDataIndexes[*blockHash] = make([]uint64, 0, params.K)
+		for _, ghostdagDataBlockHash := range ghostdagDataBlockHashes {
+			index, exists := ghostdagDataHashToIndex[*ghostdagDataBlockHash]
+			if !exists {
+				data, err := consensus.TrustedGHOSTDAGData(ghostdagDataBlockHash)
+				if err != nil {
+					return nil, err
+				}
+				ghostdagData = append(ghostdagData, &externalapi.BlockGHOSTDAGDataHashPair{
+					Hash:         ghostdagDataBlockHash,
+					GHOSTDAGData: data,
+				})
+				index = len(ghostdagData) - 1
+				ghostdagDataHashToIndex[*ghostdagDataBlockHash] = index
+			}
+
+			trustedDataGHOSTDAGDataIndexes[*blockHash] = append(trustedDataGHOSTDAGDataIndexes[*blockHash], uint64(index))
+		}
+	}
+
+	err = w.writeMessage(appmessage.DomainTrustedDataToTrustedData(daaWindowBlocks, ghostdagData))
+	if err != nil {
+		return nil, err
+	}
+
+	hashes := make(map[externalapi.DomainHash]struct{}, len(pointAndItsAnticone))
+	for _, blockHash := range pointAndItsAnticone {
+		block, found, err := consensus.GetBlock(blockHash)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return nil, errors.Errorf("pruning point anticone block %s not found", blockHash)
+		}
+
+		err = w.writeMessage(appmessage.DomainBlockWithTrustedDataToBlockWithTrustedDataV4(
+			block, trustedDataDAABlockIndexes[*blockHash], trustedDataGHOSTDAGDataIndexes[*blockHash]))
+		if err != nil {
+			return nil, err
+		}
+		hashes[*blockHash] = struct{}{}
+		stats.AnticoneBlockCount++
+	}
+	return hashes, w.endSection()
+}
+
+// exportPruningPointFutureHeaders writes the headers of the blocks between
+// the pruning point and the headers selected tip, except for the ones in the
+// anticone of the pruning point, and returns their hashes
+func exportPruningPointFutureHeaders(consensus externalapi.Consensus,
+	pruningPoint, headersSelectedTip *externalapi.DomainHash,
+	pruningPointAndItsAnticone map[externalapi.DomainHash]struct{}, w *writer,
+	stats *Stats) ([]*externalapi.DomainHash, error) {
+
+	var futureHashes []*externalapi.DomainHash
+	lowHash := pruningPoint
+	for !lowHash.Equal(headersSelectedTip) {
+		blockHashes, _, err := consensus.GetHashesBetween(lowHash, headersSelectedTip, maxHeadersPerMessage)
+		if err != nil {
+			return nil, err
+		}
+		if len(blockHashes) == 0 {
+			return nil, errors.Errorf("no blocks found between %s and %s", lowHash, headersSelectedTip)
+		}
+
+		blockHeaders := make([]*appmessage.MsgBlockHeader, 0, len(blockHashes))
+		for _, blockHash := range blockHashes {
+			if _, ok := pruningPointAndItsAnticone[*blockHash]; ok {
+				continue
+			}
+			blockHeader, err := consensus.GetBlockHeader(blockHash)
+			if err != nil {
+				return nil, err
+			}
+			blockHeaders = append(blockHeaders, appmessage.DomainBlockHeaderToBlockHeader(blockHeader))
+			futureHashes = append(futureHashes, blockHash)
+		}
+
+		if len(blockHeaders) > 0 {
+			err = w.writeMessage(appmessage.NewBlockHeadersMessage(blockHeaders))
+			if err != nil {
+				return nil, err
+			}
+			stats.HeaderCount += uint64(len(blockHeaders))
+		}
+
+		// The next lowHash is the last element in blockHashes
+		lowHash = blockHashes[len(blockHashes)-1]
+	}
+	return futureHashes, w.endSection()
+}
+
+func exportPruningPointUTXOSet(consensus externalapi.Consensus, pruningPoint *externalapi.DomainHash,
+	w *writer, stats *Stats) error {
+
+	var fromOutpoint *externalapi.DomainOutpoint
+	for {
+		pruningPointUTXOs, err := consensus.GetPruningPointUTXOs(pruningPoint, fromOutpoint, utxoChunkSize)
+		if err != nil {
+			return err
+		}
+
+		if len(pruningPointUTXOs) > 0 {
+			outpointAndUTXOEntryPairs :=
+				appmessage.DomainOutpointAndUTXOEntryPairsToOutpointAndUTXOEntryPairs(pruningPointUTXOs)
+			err = w.writeMessage(appmessage.NewMsgPruningPointUTXOSetChunk(outpointAndUTXOEntryPairs))
+			if err != nil {
+				return err
+			}
+			stats.UTXOCount += uint64(len(pruningPointUTXOs))
+			fromOutpoint = pruningPointUTXOs[len(pruningPointUTXOs)-1].Outpoint
+		}
+
+		if len(pruningPointUTXOs) < utxoChunkSize {
+			return w.endSection()
+		}
+	}
+}
+
+// exportPruningPointFutureBlocks writes the blocks of the given hashes that
+// have bodies. The blocks above the virtual selected parent might not.
+func exportPruningPointFutureBlocks(consensus externalapi.Consensus, futureHashes []*externalapi.DomainHash,
+	w *writer, stats *Stats) error {
+
+	for _, blockHash := range futureHashes {
+		block, found, err := consensus.GetBlock(blockHash)
+		if err != nil {
+			return err
+		}
+		if !found {
+			continue
+		}
+
+		err = w.writeMessage(appmessage.NewMsgIBDBlock(appmessage.DomainBlockToMsgBlock(block)))
+		if err != nil {
+			return err
+		}
+		stats.BlockCount++
+	}
+	return w.endSection()
+}
@@ -0,0 +1,314 @@
+// Package utxosnapshot exports and imports pruning point snapshot files, from
+// which a new node is bootstrapped instead of from its peers.
+//
+// A snapshot file starts with a header that describes the network, the
+// pruning point and its UTXO commitment. It's followed by sections of
+// length-prefixed P2P messages, the same ones that a syncer sends during IBD
+// with headers proof:
+//
+//  1. The pruning point proof
+//  2. The headers of the pruning points
+//  3. The trusted data, followed by the pruning point and its anticone
+//  4. The headers of the future of the pruning point
+//  5. The UTXO set of the pruning point
+//  6. The blocks of the future of the pruning point
+//
+// Every section ends with an empty frame, and the file ends with the SHA-256
+// of everything before it.
+package utxosnapshot
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"hash"
+	"io"
+
+	"github.com/kobradag/kobrad/app/appmessage"
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/infrastructure/network/netadapter/server/grpcserver/protowire"
+	"github.com/kobradag/kobrad/util/mstime"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// magic identifies kobrad snapshot files
+var magic = [8]byte{'K', 'O', 'B', 'R', 'A', 'S', 'N', 'P'}
+
+// formatVersion is the version of the snapshot file format
+const formatVersion = 1
+
+// maxFrameSize is the size of the largest message that's read from a
+// snapshot file, which protects the reader from corrupted frame lengths
+const maxFrameSize = 256 * 1024 * 1024
+
+// maxNetworkNameLength is the length of the longest network name that's read
+// from a snapshot file
+const maxNetworkNameLength = 256
+
+// Header describes the contents of a snapshot file
+type Header struct {
+	NetworkName            string
+	PruningPointHash       *externalapi.DomainHash
+	UTXOCommitment         *externalapi.DomainHash
+	HeadersSelectedTipHash *externalapi.DomainHash
+	CreatedAt              mstime.Time
+}
+
+// writer writes the header, the messages and the checksum of a snapshot
+// file. Everything that's written before the checksum is hashed into it.
+type writer struct {
+	buffer *bufio.Writer
+	hasher hash.Hash
+	out    io.Writer
+}
+
+func newWriter(w io.Writer) *writer {
+	buffer := bufio.NewWriter(w)
+	hasher := sha256.New()
+	return &writer{
+		buffer: buffer,
+		hasher: hasher,
+		out:    io.MultiWriter(buffer, hasher),
+	}
+}
+
+func (w *writer) writeHeader(header *Header) error {
+	if len(header.NetworkName) > maxNetworkNameLength {
+		return errors.Errorf("network name %s is too long", header.NetworkName)
+	}
+
+	var headerBytes bytes.Buffer
+	headerBytes.Write(magic[:])
+	writeUint32(&headerBytes, formatVersion)
+	writeUint32(&headerBytes, uint32(len(header.NetworkName)))
+	headerBytes.WriteString(header.NetworkName)
+	headerBytes.Write(header.PruningPointHash.ByteSlice())
+	headerBytes.Write(header.UTXOCommitment.ByteSlice())
+	headerBytes.Write(header.HeadersSelectedTipHash.ByteSlice())
+	writeUint64(&headerBytes, uint64(header.CreatedAt.UnixMilliseconds()))
+
+	_, err := w.out.Write(headerBytes.Bytes())
+	return errors.WithStack(err)
+}
+
+// writeMessage writes the given message as a length-prefixed frame
+func (w *writer) writeMessage(message appmessage.Message) error {
+	protoMessage, err := protowire.FromAppMessage(message)
+	if err != nil {
+		return err
+	}
+	messageBytes, err := proto.Marshal(protoMessage)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if len(messageBytes) > maxFrameSize {
+		return errors.Errorf("%s message of %d bytes is larger than the maximum frame size %d",
+			message.Command(), len(messageBytes), maxFrameSize)
+	}
+	return w.writeFrame(messageBytes)
+}
+
+// endSection writes the empty frame that ends a section
+func (w *writer) endSection() error {
+	return w.writeFrame(nil)
+}
+
+func (w *writer) writeFrame(frame []byte) error {
+	var lengthBytes [4]byte
+	binary.LittleEndian.PutUint32(lengthBytes[:], uint32(len(frame)))
+	_, err := w.out.Write(lengthBytes[:])
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	_, err = w.out.Write(frame)
+	return errors.WithStack(err)
+}
+
+// finish writes the checksum of everything that was written so far, and
+// flushes the writer
+func (w *writer) finish() error {
+	_, err := w.buffer.Write(w.hasher.Sum(nil))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(w.buffer.Flush())
+}
+
+// reader reads what writer writes, and verifies the checksum at the end
+type reader struct {
+	buffer *bufio.Reader
+	hasher hash.Hash
+	in     io.Reader
+}
+
+func newReader(r io.Reader) *reader {
+	buffer := bufio.NewReader(r)
+	hasher := sha256.New()
+	return &reader{
+		buffer: buffer,
+		hasher: hasher,
+		in:     io.TeeReader(buffer, hasher),
+	}
+}
+
+func (r *reader) readHeader() (*Header, error) {
+	var fileMagic [8]byte
+	err := r.readFull(fileMagic[:])
+	if err != nil {
+		return nil, err
+	}
+	if fileMagic != magic {
+		return nil, errors.New("not a kobrad snapshot file")
+	}
+
+	version, err := r.readUint32()
+	if err != nil {
+		return nil, err
+	}
+	if version != formatVersion {
+		return nil, errors.Errorf("unsupported snapshot format version %d, expected version %d",
+			version, formatVersion)
+	}
+
+	networkNameLength, err := r.readUint32()
+	if err != nil {
+		return nil, err
+	}
+	if networkNameLength > maxNetworkNameLength {
+		return nil, errors.Errorf("network name length %d is too long", networkNameLength)
+	}
+	networkName := make([]byte, networkNameLength)
+	err = r.readFull(networkName)
+	if err != nil {
+		return nil, err
+	}
+
+	pruningPointHash, err := r.readHash()
+	if err != nil {
+		return nil, err
+	}
+	utxoCommitment, err := r.readHash()
+	if err != nil {
+		return nil, err
+	}
+	headersSelectedTipHash, err := r.readHash()
+	if err != nil {
+		return nil, err
+	}
+	createdAt, err := r.readUint64()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Header{
+		NetworkName:            string(networkName),
+		PruningPointHash:       pruningPointHash,
+		UTXOCommitment:         utxoCommitment,
+		HeadersSelectedTipHash: headersSelectedTipHash,
+		CreatedAt:              mstime.UnixMilliseconds(int64(createdAt)),
+	}, nil
+}
+
+// readMessage reads the next message of the current section. It returns
+// endOfSection = true once the section is over.
+func (r *reader) readMessage() (message appmessage.Message, endOfSection bool, err error) {
+	length, err := r.readUint32()
+	if err != nil {
+		return nil, false, err
+	}
+	if length == 0 {
+		return nil, true, nil
+	}
+	if length > maxFrameSize {
+		return nil, false, errors.Errorf("frame of %d bytes is larger than the maximum frame size %d",
+			length, maxFrameSize)
+	}
+
+	messageBytes := make([]byte, length)
+	err = r.readFull(messageBytes)
+	if err != nil {
+		return nil, false, err
+	}
+	protoMessage := &protowire.KobradMessage{}
+	err = proto.Unmarshal(messageBytes, protoMessage)
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "malformed message in snapshot file")
+	}
+	message, err = protoMessage.ToAppMessage()
+	if err != nil {
+		return nil, false, err
+	}
+	return message, false, nil
+}
+
+// verifyChecksum reads the checksum at the end of the file, and checks it
+// against everything that was read before it
+func (r *reader) verifyChecksum() error {
+	expectedChecksum := r.hasher.Sum(nil)
+	checksum := make([]byte, len(expectedChecksum))
+	_, err := io.ReadFull(r.buffer, checksum)
+	if err != nil {
+		return errors.Wrapf(err, "error reading the checksum of the snapshot file")
+	}
+	if !bytes.Equal(checksum, expectedChecksum) {
+		return errors.New("the checksum of the snapshot file doesn't match its contents")
+	}
+
+	_, err = r.buffer.ReadByte()
+	if err != io.EOF {
+		return errors.New("unexpected data after the checksum of the snapshot file")
+	}
+	return nil
+}
+
+func (r *reader) readFull(buffer []byte) error {
+	_, err := io.ReadFull(r.in, buffer)
+	if err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return errors.New("the snapshot file is truncated")
+		}
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func (r *reader) readHash() (*externalapi.DomainHash, error) {
+	var hashBytes [externalapi.DomainHashSize]byte
+	err := r.readFull(hashBytes[:])
+	if err != nil {
+		return nil, err
+	}
+	return externalapi.NewDomainHashFromByteArray(&hashBytes), nil
+}
+
+func (r *reader) readUint32() (uint32, error) {
+	var valueBytes [4]byte
+	err := r.readFull(valueBytes[:])
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint32(valueBytes[:]), nil
+}
+
+func (r *reader) readUint64() (uint64, error) {
+	var valueBytes [8]byte
+	err := r.readFull(valueBytes[:])
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint64(valueBytes[:]), nil
+}
+
+func writeUint32(buffer *bytes.Buffer, value uint32) {
+	var valueBytes [4]byte
+	binary.LittleEndian.PutUint32(valueBytes[:], value)
+	buffer.Write(valueBytes[:])
+}
+
+func writeUint64(buffer *bytes.Buffer, value uint64) {
+	var valueBytes [8]byte
+	binary.LittleEndian.PutUint64(valueBytes[:], value)
+	buffer.Write(valueBytes[:])
+}
@@ -0,0 +1,161 @@
+package utxosnapshot
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/kobradag/kobrad/app/appmessage"
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/util/mstime"
+	"github.com/pkg/errors"
+)
+
+func writeTestSnapshot(t *testing.T) (*Header, []byte) {
+	header := &Header{
+		NetworkName:            "kobra-simnet",
+		PruningPointHash:       externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{1}),
+		UTXOCommitment:         externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{2}),
+		HeadersSelectedTipHash: externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{3}),
+		CreatedAt:              mstime.UnixMilliseconds(1700000000000),
+	}
+
+	var buffer bytes.Buffer
+	w := newWriter(&buffer)
+	err := w.writeHeader(header)
+	if err != nil {
+		t.Fatalf("writeHeader: %+v", err)
+	}
+	err = w.writeMessage(appmessage.NewMsgPruningPoints(nil))
+	if err != nil {
+		t.Fatalf("writeMessage: %+v", err)
+	}
+	err = w.endSection()
+	if err != nil {
+		t.Fatalf("endSection: %+v", err)
+	}
+	err = w.writeMessage(appmessage.NewMsgPruningPointUTXOSetChunk(nil))
+	if err != nil {
+		t.Fatalf("writeMessage: %+v", err)
+	}
+	err = w.writeMessage(appmessage.NewMsgPruningPointUTXOSetChunk(nil))
+	if err != nil {
+		t.Fatalf("writeMessage: %+v", err)
+	}
+	err = w.endSection()
+	if err != nil {
+		t.Fatalf("endSection: %+v", err)
+	}
+	err = w.finish()
+	if err != nil {
+		t.Fatalf("finish: %+v", err)
+	}
+	return header, buffer.Bytes()
+}
+
+// readTestSnapshot reads everything that writeTestSnapshot writes, and
+// returns the first error
+func readTestSnapshot(snapshot []byte) (*Header, error) {
+	r := newReader(bytes.NewReader(snapshot))
+	header, err := r.readHeader()
+	if err != nil {
+		return nil, err
+	}
+	for _, expectedMessageCount := range []int{1, 2} {
+		messageCount := 0
+		for {
+			_, endOfSection, err := r.readMessage()
+			if err != nil {
+				return nil, err
+			}
+			if endOfSection {
+				break
+			}
+			messageCount++
+		}
+		if messageCount != expectedMessageCount {
+			return nil, errors.Errorf("read %d messages in a section, expected %d", messageCount, expectedMessageCount)
+		}
+	}
+	return header, r.verifyChecksum()
+}
+
+func TestSnapshotFormat(t *testing.T) {
+	expectedHeader, snapshot := writeTestSnapshot(t)
+
+	header, err := readTestSnapshot(snapshot)
+	if err != nil {
+		t.Fatalf("readTestSnapshot: %+v", err)
+	}
+	if header.NetworkName != expectedHeader.NetworkName ||
+		!header.PruningPointHash.Equal(expectedHeader.PruningPointHash) ||
+		!header.UTXOCommitment.Equal(expectedHeader.UTXOCommitment) ||
+		!header.HeadersSelectedTipHash.Equal(expectedHeader.HeadersSelectedTipHash) ||
+		header.CreatedAt != expectedHeader.CreatedAt {
+
+		t.Fatalf("Unexpected header %+v, expected %+v", header, expectedHeader)
+	}
+}
+
+func TestSnapshotFormatErrors(t *testing.T) {
+	_, snapshot := writeTestSnapshot(t)
+
+	tests := []struct {
+		name          string
+		snapshot      func() []byte
+		expectedError string
+	}{
+		{
+			name: "wrong magic",
+			snapshot: func() []byte {
+				corrupted := append([]byte{}, snapshot...)
+				corrupted[0] = 'X'
+				return corrupted
+			},
+			expectedError: "not a kobrad snapshot file",
+		},
+		{
+			name: "unsupported version",
+			snapshot: func() []byte {
+				corrupted := append([]byte{}, snapshot...)
+				corrupted[len(magic)] = formatVersion + 1
+				return corrupted
+			},
+			expectedError: "unsupported snapshot format version",
+		},
+		{
+			name: "corrupted content",
+			snapshot: func() []byte {
+				corrupted := append([]byte{}, snapshot...)
+				// The last byte of the creation time in the header
+				corrupted[len(magic)+4+4+len("kobra-simnet")+3*externalapi.DomainHashSize+7] ^= 0xff
+				return corrupted
+			},
+			expectedError: "checksum of the snapshot file doesn't match",
+		},
+		{
+			name: "truncated",
+			snapshot: func() []byte {
+				return snapshot[:len(snapshot)-40]
+			},
+			expectedError: "truncated",
+		},
+		{
+			name: "trailing data",
+			snapshot: func() []byte {
+				return append(append([]byte{}, snapshot...), 0)
+			},
+			expectedError: "unexpected data after the checksum",
+		},
+	}
+
+	for _, test := range tests {
+		_, err := readTestSnapshot(test.snapshot())
+		if err == nil {
+			t.Fatalf("%s: expected an error", test.name)
+		}
+		if !strings.Contains(err.Error(), test.expectedError) {
+			t.Fatalf("%s: unexpected error: %s", test.name, err)
+		}
+	}
+}
@@ -0,0 +1,423 @@
+package utxosnapshot
+
+import (
+	"io"
+	"os"
+
+	"github.com/kobradag/kobrad/app/appmessage"
+	"github.com/kobradag/kobrad/domain"
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/domain/consensus/ruleerrors"
+	"github.com/kobradag/kobrad/domain/consensus/utils/consensushashing"
+	"github.com/kobradag/kobrad/domain/dagconfig"
+	"github.com/pkg/errors"
+)
+
+// ReadHeader reads the header of the snapshot file at snapshotPath
+func ReadHeader(snapshotPath string) (*Header, error) {
+	file, err := os.Open(snapshotPath)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer file.Close()
+
+	return newReader(file).readHeader()
+}
+
+// Import bootstraps the given domain from the snapshot file at snapshotPath,
+// the same way that IBD with headers proof bootstraps it from a peer. The
+// pruning point proof, the blocks with trusted data, the headers and the
+// blocks are validated, and the UTXO set is checked against the UTXO
+// commitment of the pruning point. The domain must be new, and the snapshot
+// is imported into a staging consensus that's only committed once the
+// whole file was read and its checksum verified.
+func Import(domain domain.Domain, params *dagconfig.Params, snapshotPath string) (*Header, *Stats, error) {
+	file, err := os.Open(snapshotPath)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	defer file.Close()
+
+	return importSnapshot(domain, params, file)
+}
+
+func importSnapshot(domain domain.Domain, params *dagconfig.Params, file io.Reader) (*Header, *Stats, error) {
+	r := newReader(file)
+	header, err := r.readHeader()
+	if err != nil {
+		return nil, nil, err
+	}
+	if header.NetworkName != params.Name {
+		return nil, nil, errors.Errorf("the snapshot is of network %s, while the node is of network %s",
+			header.NetworkName, params.Name)
+	}
+
+	virtualSelectedParent, err := domain.Consensus().GetVirtualSelectedParent()
+	if err != nil {
+		return nil, nil, err
+	}
+	if !virtualSelectedParent.Equal(params.GenesisHash) {
+		return nil, nil, errors.New("a snapshot can only be imported into a new database")
+	}
+
+	log.Infof("Importing a snapshot of pruning point %s", header.PruningPointHash)
+	pruningPointProof, err := readPruningPointProof(domain, header, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = domain.InitStagingConsensusWithoutGenesis()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stats, err := importIntoStagingConsensus(domain, header, pruningPointProof, r)
+	if err != nil {
+		deleteStagingConsensusErr := domain.DeleteStagingConsensus()
+		if deleteStagingConsensusErr != nil {
+			log.Warnf("Failed deleting the staging consensus: %s", deleteStagingConsensusErr)
+		}
+		return nil, nil, err
+	}
+
+	err = domain.CommitStagingConsensus()
+	if err != nil {
+		return nil, nil, err
+	}
+	log.Infof("Imported %d pruning point anticone blocks, %d headers, %d UTXOs and %d blocks",
+		stats.AnticoneBlockCount, stats.HeaderCount, stats.UTXOCount, stats.BlockCount)
+	return header, stats, nil
+}
+
+func importIntoStagingConsensus(domain domain.Domain, header *Header, pruningPointProof *externalapi.PruningPointProof,
+	r *reader) (*Stats, error) {
+
+	stagingConsensus := domain.StagingConsensus()
+	err := stagingConsensus.ApplyPruningPointProof(pruningPointProof)
+	if err != nil {
+		return nil, err
+	}
+
+	err = importPruningPoints(domain, header, r)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &Stats{}
+	err = importPruningPointAndItsAnticone(stagingConsensus, header, r, stats)
+	if err != nil {
+		return nil, err
+	}
+	err = importPruningPointFutureHeaders(stagingConsensus, r, stats)
+	if err != nil {
+		return nil, err
+	}
+
+	isValid, err := stagingConsensus.IsValidPruningPoint(header.PruningPointHash)
+	if err != nil {
+		return nil, err
+	}
+	if !isValid {
+		return nil, errors.Errorf("%s is not a valid pruning point for the headers of the snapshot",
+			header.PruningPointHash)
+	}
+
+	err = importPruningPointUTXOSet(stagingConsensus, header, r, stats)
+	if err != nil {
+		return nil, err
+	}
+	err = importPruningPointFutureBlocks(stagingConsensus, r, stats)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.verifyChecksum()
+	if err != nil {
+		return nil, err
+	}
+
+	if stats.BlockCount > 0 {
+		log.Infof("Resolving the virtual")
+		err = stagingConsensus.ResolveVirtual(nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return stats, nil
+}
+
+func readPruningPointProof(domain domain.Domain, header *Header, r *reader) (*externalapi.PruningPointProof, error) {
+	message, err := readSectionMessage(r, appmessage.CmdPruningPointProof)
+	if err != nil {
+		return nil, err
+	}
+	pruningPointProof := appmessage.MsgPruningPointProofToDomainPruningPointProof(message.(*appmessage.MsgPruningPointProof))
+	if len(pruningPointProof.Headers) == 0 || len(pruningPointProof.Headers[0]) == 0 {
+		return nil, errors.New("the pruning point proof of the snapshot is empty")
+	}
+
+	err = domain.Consensus().ValidatePruningPointProof(pruningPointProof)
+	if err != nil {
+		return nil, errors.Wrapf(err, "the pruning point proof of the snapshot is invalid")
+	}
+
+	proofPruningPoint := consensushashing.HeaderHash(pruningPointProof.Headers[0][len(pruningPointProof.Headers[0])-1])
+	if !proofPruningPoint.Equal(header.PruningPointHash) {
+		return nil, errors.Errorf("the pruning point of the proof is %s instead of %s",
+			proofPruningPoint, header.PruningPointHash)
+	}
+
+	err = readEndOfSection(r)
+	if err != nil {
+		return nil, err
+	}
+	return pruningPointProof, nil
+}
+
+func importPruningPoints(domain domain.Domain, header *Header, r *reader) error {
+	message, err := readSectionMessage(r, appmessage.CmdPruningPoints)
+	if err != nil {
+		return err
+	}
+	pruningPoints := message.(*appmessage.MsgPruningPoints)
+	if len(pruningPoints.Headers) == 0 {
+		return errors.New("the snapshot has no pruning point headers")
+	}
+
+	headers := make([]externalapi.BlockHeader, len(pruningPoints.Headers))
+	for i, header := range pruningPoints.Headers {
+		headers[i] = appmessage.BlockHeaderToDomainBlockHeader(header)
+	}
+
+	arePruningPointsViolatingFinality, err := domain.Consensus().ArePruningPointsViolatingFinality(headers)
+	if err != nil {
+		return err
+	}
+	if arePruningPointsViolatingFinality {
+		return errors.New("the pruning points of the snapshot are violating finality")
+	}
+
+	lastPruningPoint := consensushashing.HeaderHash(headers[len(headers)-1])
+	if !lastPruningPoint.Equal(header.PruningPointHash) {
+		return errors.Errorf("the last pruning point of the snapshot is %s instead of %s",
+			lastPruningPoint, header.PruningPointHash)
+	}
+
+	err = domain.StagingConsensus().ImportPruningPoints(headers)
+	if err != nil {
+		return err
+	}
+	return readEndOfSection(r)
+}
+
+func importPruningPointAndItsAnticone(consensus externalapi.Consensus, header *Header, r *reader, stats *Stats) error {
+	message, err := readSectionMessage(r, appmessage.CmdTrustedData)
+	if err != nil {
+		return err
+	}
+	trustedData := message.(*appmessage.MsgTrustedData)
+
+	for {
+		message, endOfSection, err := r.readMessage()
+		if err != nil {
+			return err
+		}
+		if endOfSection {
+			break
+		}
+		block, ok := message.(*appmessage.MsgBlockWithTrustedDataV4)
+		if !ok {
+			return errors.Errorf("unexpected %s message in the pruning point anticone section of the snapshot",
+				message.Command())
+		}
+
+		blockWithTrustedData, err := toBlockWithTrustedData(block, trustedData)
+		if err != nil {
+			return err
+		}
+		if stats.AnticoneBlockCount == 0 {
+			blockHash := consensushashing.BlockHash(blockWithTrustedData.Block)
+			if !blockHash.Equal(header.PruningPointHash) {
+				return errors.Errorf("the first block with trusted data of the snapshot is %s instead of "+
+					"the pruning point %s", blockHash, header.PruningPointHash)
+			}
+			if !blockWithTrustedData.Block.Header.UTXOCommitment().Equal(header.UTXOCommitment) {
+				return errors.Errorf("the UTXO commitment of the pruning point is %s instead of %s",
+					blockWithTrustedData.Block.Header.UTXOCommitment(), header.UTXOCommitment)
+			}
+		}
+
+		err = consensus.ValidateAndInsertBlockWithTrustedData(blockWithTrustedData, false)
+		if err != nil {
+			return err
+		}
+		stats.AnticoneBlockCount++
+	}
+
+	if stats.AnticoneBlockCount == 0 {
+		return errors.New("the snapshot doesn't contain the pruning point block")
+	}
+	return nil
+}
+
+func toBlockWithTrustedData(block *appmessage.MsgBlockWithTrustedDataV4,
+	data *appmessage.MsgTrustedData) (*externalapi.BlockWithTrustedData, error) {
+
+	blockWithTrustedData := &externalapi.BlockWithTrustedData{
+		Block:        appmessage.MsgBlockToDomainBlock(block.Block),
+		DAAWindow:    make([]*externalapi.TrustedDataDataDAAHeader, 0, len(block.DAAWindowIndices)),
+		GHOSTDAGData: make([]*externalapi.BlockGHOSTDAGDataHashPair, 0, len(block.GHOSTDAGDataIndices)),
+	}
+
+	for _, index := range block.DAAWindowIndices {
+		if index >= uint64(len(data.DAAWindow)) {
+			return nil, errors.Errorf("DAA window index %d is out of range", index)
+		}
+		blockWithTrustedData.DAAWindow = append(blockWithTrustedData.DAAWindow,
+			appmessage.TrustedDataDataDAABlockV4ToTrustedDataDataDAAHeader(data.DAAWindow[index]))
+	}
+
+	for _, index := range block.GHOSTDAGDataIndices {
+		if index >= uint64(len(data.GHOSTDAGData)) {
+			return nil, errors.Errorf("GHOSTDAG data index %d is out of range", index)
+		}
+		blockWithTrustedData.GHOSTDAGData = append(blockWithTrustedData.GHOSTDAGData,
+			appmessage.GHOSTDAGHashPairToDomainGHOSTDAGHashPair(data.GHOSTDAGData[index]))
+	}
+	return blockWithTrustedData, nil
+}
+
+func importPruningPointFutureHeaders(consensus externalapi.Consensus, r *reader, stats *Stats) error {
+	for {
+		message, endOfSection, err := r.readMessage()
+		if err != nil {
+			return err
+		}
+		if endOfSection {
+			break
+		}
+		blockHeadersMessage, ok := message.(*appmessage.BlockHeadersMessage)
+		if !ok {
+			return errors.Errorf("unexpected %s message in the headers section of the snapshot",
+				message.Command())
+		}
+
+		for _, msgBlockHeader := range blockHeadersMessage.BlockHeaders {
+			blockHeader := appmessage.BlockHeaderToDomainBlockHeader(msgBlockHeader)
+			err = consensus.ValidateAndInsertBlock(&externalapi.DomainBlock{Header: blockHeader}, false)
+			if err != nil {
+				if errors.Is(err, ruleerrors.ErrDuplicateBlock) {
+					continue
+				}
+				return errors.Wrapf(err, "invalid header %s", consensushashing.HeaderHash(blockHeader))
+			}
+		}
+		stats.HeaderCount += uint64(len(blockHeadersMessage.BlockHeaders))
+		log.Infof("Imported %d headers", stats.HeaderCount)
+	}
+	return nil
+}
+
+func importPruningPointUTXOSet(consensus externalapi.Consensus, header *Header, r *reader, stats *Stats) (err error) {
+	defer func() {
+		clearErr := consensus.ClearImportedPruningPointData()
+		if err == nil && clearErr != nil {
+			err = clearErr
+		}
+	}()
+
+	for {
+		message, endOfSection, err := r.readMessage()
+		if err != nil {
+			return err
+		}
+		if endOfSection {
+			break
+		}
+		chunk, ok := message.(*appmessage.MsgPruningPointUTXOSetChunk)
+		if !ok {
+			return errors.Errorf("unexpected %s message in the UTXO set section of the snapshot",
+				message.Command())
+		}
+
+		err = consensus.AppendImportedPruningPointUTXOs(
+			appmessage.OutpointAndUTXOEntryPairsToDomainOutpointAndUTXOEntryPairs(chunk.OutpointAndUTXOEntryPairs))
+		if err != nil {
+			return err
+		}
+		stats.UTXOCount += uint64(len(chunk.OutpointAndUTXOEntryPairs))
+		if stats.UTXOCount%(utxoChunkSize*1000) == 0 {
+			log.Infof("Imported %d UTXOs so far", stats.UTXOCount)
+		}
+	}
+
+	log.Infof("Validating the UTXO set of %d UTXOs against the UTXO commitment %s",
+		stats.UTXOCount, header.UTXOCommitment)
+	err = consensus.ValidateAndInsertImportedPruningPoint(header.PruningPointHash)
+	if err != nil {
+		return errors.Wrapf(err, "the UTXO set of the snapshot is invalid")
+	}
+	return nil
+}
+
+func importPruningPointFutureBlocks(consensus externalapi.Consensus, r *reader, stats *Stats) error {
+	for {
+		message, endOfSection, err := r.readMessage()
+		if err != nil {
+			return err
+		}
+		if endOfSection {
+			return nil
+		}
+		msgIBDBlock, ok := message.(*appmessage.MsgIBDBlock)
+		if !ok {
+			return errors.Errorf("unexpected %s message in the blocks section of the snapshot",
+				message.Command())
+		}
+
+		block := appmessage.MsgBlockToDomainBlock(msgIBDBlock.MsgBlock)
+		blockHash := consensushashing.BlockHash(block)
+		if len(block.Transactions) == 0 {
+			return errors.Errorf("block %s of the snapshot has no body", blockHash)
+		}
+		err = consensus.ValidateAndInsertBlock(block, false)
+		if err != nil {
+			if errors.Is(err, ruleerrors.ErrDuplicateBlock) {
+				continue
+			}
+			return errors.Wrapf(err, "invalid block %s", blockHash)
+		}
+		stats.BlockCount++
+		if stats.BlockCount%maxHeadersPerMessage == 0 {
+			log.Infof("Imported %d blocks so far", stats.BlockCount)
+		}
+	}
+}
+
+// readSectionMessage reads the first message of a section, which must be of
+// the given command
+func readSectionMessage(r *reader, command appmessage.MessageCommand) (appmessage.Message, error) {
+	message, endOfSection, err := r.readMessage()
+	if err != nil {
+		return nil, err
+	}
+	if endOfSection {
+		return nil, errors.Errorf("the snapshot is missing a %s message", command)
+	}
+	if message.Command() != command {
+		return nil, errors.Errorf("expected a %s message in the snapshot, got %s", command, message.Command())
+	}
+	return message, nil
+}
+
+// readEndOfSection reads the end of a section that has a single message
+func readEndOfSection(r *reader) error {
+	message, endOfSection, err := r.readMessage()
+	if err != nil {
+		return err
+	}
+	if !endOfSection {
+		return errors.Errorf("unexpected %s message in the snapshot", message.Command())
+	}
+	return nil
+}
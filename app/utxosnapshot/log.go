@@ -0,0 +1,9 @@
+package utxosnapshot
+
+import (
+	"github.com/kobradag/kobrad/infrastructure/logger"
+	"github.com/kobradag/kobrad/util/panics"
+)
+
+var log = logger.RegisterSubSystem("SNAP")
+var spawn = panics.GoroutineWrapperFunc(log)
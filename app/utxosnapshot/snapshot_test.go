@@ -0,0 +1,275 @@
+package utxosnapshot
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kobradag/kobrad/app/appmessage"
+	"github.com/kobradag/kobrad/domain"
+	"github.com/kobradag/kobrad/domain/consensus"
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/domain/consensus/model/testapi"
+	"github.com/kobradag/kobrad/domain/consensus/utils/testutils"
+	"github.com/kobradag/kobrad/infrastructure/db/database/ldb"
+)
+
+// exportTestSnapshot builds a DAG whose pruning point moved past the genesis
+// and exports a snapshot of it into the given directory
+func exportTestSnapshot(t *testing.T, consensusConfig *consensus.Config, dir string) (
+	tc testapi.TestConsensus, snapshotPath string, teardown func()) {
+
+	// Keep the pruning depth small so that the pruning point moves quickly
+	consensusConfig.FinalityDuration = 10 * consensusConfig.TargetTimePerBlock
+	consensusConfig.MergeSetSizeLimit = 5
+
+	tc, teardownConsensus, err := consensus.NewFactory().NewTestConsensus(consensusConfig, "TestSnapshot")
+	if err != nil {
+		t.Fatalf("Error setting up consensus: %+v", err)
+	}
+	tip := consensusConfig.GenesisHash
+	for i := uint64(0); i < consensusConfig.PruningDepth()+2*consensusConfig.FinalityDepth(); i++ {
+		tip, _, err = tc.AddBlock([]*externalapi.DomainHash{tip}, nil, nil)
+		if err != nil {
+			t.Fatalf("AddBlock: %+v", err)
+		}
+	}
+
+	snapshotPath = filepath.Join(dir, "snapshot")
+	_, stats, err := Export(tc, &consensusConfig.Params, snapshotPath)
+	if err != nil {
+		t.Fatalf("Export: %+v", err)
+	}
+	if stats.UTXOCount == 0 || stats.BlockCount == 0 {
+		t.Fatalf("Expected the snapshot to have UTXOs and blocks, but got %+v", stats)
+	}
+	return tc, snapshotPath, func() { teardownConsensus(false) }
+}
+
+// importIntoNewDomain imports the snapshot at snapshotPath into a domain over
+// a new database, and calls f with the domain if the import succeeded
+func importIntoNewDomain(t *testing.T, consensusConfig *consensus.Config, dir string, snapshotPath string,
+	f func(domain domain.Domain)) error {
+
+	dbPath, err := ioutil.TempDir(dir, "import")
+	if err != nil {
+		t.Fatalf("TempDir: %s", err)
+	}
+	db, err := ldb.NewLevelDB(dbPath, 8)
+	if err != nil {
+		t.Fatalf("NewLevelDB: %+v", err)
+	}
+	defer db.Close()
+
+	return withDomain(consensusConfig, db, func(domain domain.Domain) error {
+		_, _, err := Import(domain, &consensusConfig.Params, snapshotPath)
+		if err != nil {
+			virtualSelectedParent, virtualSelectedParentErr := domain.Consensus().GetVirtualSelectedParent()
+			if virtualSelectedParentErr != nil {
+				t.Fatalf("GetVirtualSelectedParent: %+v", virtualSelectedParentErr)
+			}
+			if !virtualSelectedParent.Equal(consensusConfig.GenesisHash) {
+				t.Fatalf("Expected a failed import to leave the domain new")
+			}
+			return err
+		}
+		f(domain)
+		return nil
+	})
+}
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		dir, err := ioutil.TempDir("", "TestSnapshotRoundTrip")
+		if err != nil {
+			t.Fatalf("TempDir: %s", err)
+		}
+		defer os.RemoveAll(dir)
+
+		tc, snapshotPath, teardown := exportTestSnapshot(t, consensusConfig, dir)
+		defer teardown()
+
+		err = importIntoNewDomain(t, consensusConfig, dir, snapshotPath, func(domain domain.Domain) {
+			imported := domain.Consensus()
+
+			expectedPruningPoint, err := tc.PruningPoint()
+			if err != nil {
+				t.Fatalf("PruningPoint: %+v", err)
+			}
+			pruningPoint, err := imported.PruningPoint()
+			if err != nil {
+				t.Fatalf("PruningPoint: %+v", err)
+			}
+			if !pruningPoint.Equal(expectedPruningPoint) {
+				t.Fatalf("Expected the pruning point %s, but got %s", expectedPruningPoint, pruningPoint)
+			}
+
+			expectedVirtualSelectedParent, err := tc.GetVirtualSelectedParent()
+			if err != nil {
+				t.Fatalf("GetVirtualSelectedParent: %+v", err)
+			}
+			virtualSelectedParent, err := imported.GetVirtualSelectedParent()
+			if err != nil {
+				t.Fatalf("GetVirtualSelectedParent: %+v", err)
+			}
+			if !virtualSelectedParent.Equal(expectedVirtualSelectedParent) {
+				t.Fatalf("Expected the virtual selected parent %s, but got %s",
+					expectedVirtualSelectedParent, virtualSelectedParent)
+			}
+
+			expectedUTXOs, err := tc.GetPruningPointUTXOs(expectedPruningPoint, nil, 1_000_000)
+			if err != nil {
+				t.Fatalf("GetPruningPointUTXOs: %+v", err)
+			}
+			utxos, err := imported.GetPruningPointUTXOs(pruningPoint, nil, 1_000_000)
+			if err != nil {
+				t.Fatalf("GetPruningPointUTXOs: %+v", err)
+			}
+			if len(utxos) != len(expectedUTXOs) {
+				t.Fatalf("Expected %d pruning point UTXOs, but got %d", len(expectedUTXOs), len(utxos))
+			}
+			for i, expectedUTXO := range expectedUTXOs {
+				if !utxos[i].Outpoint.Equal(expectedUTXO.Outpoint) || !utxos[i].UTXOEntry.Equal(expectedUTXO.UTXOEntry) {
+					t.Fatalf("Pruning point UTXO %d doesn't match", i)
+				}
+			}
+		})
+		if err != nil {
+			t.Fatalf("Import: %+v", err)
+		}
+	})
+}
+
+// rewriteSnapshot returns the given snapshot with every message replaced by
+// the result of modify, and a valid checksum
+func rewriteSnapshot(t *testing.T, snapshot []byte, modify func(message appmessage.Message) appmessage.Message) []byte {
+	r := newReader(bytes.NewReader(snapshot))
+	header, err := r.readHeader()
+	if err != nil {
+		t.Fatalf("readHeader: %+v", err)
+	}
+
+	var buffer bytes.Buffer
+	w := newWriter(&buffer)
+	err = w.writeHeader(header)
+	if err != nil {
+		t.Fatalf("writeHeader: %+v", err)
+	}
+	// The proof, the pruning points, the pruning point and its anticone,
+	// the headers, the UTXO set and the blocks
+	const sectionCount = 6
+	for i := 0; i < sectionCount; i++ {
+		for {
+			message, endOfSection, err := r.readMessage()
+			if err != nil {
+				t.Fatalf("readMessage: %+v", err)
+			}
+			if endOfSection {
+				break
+			}
+			err = w.writeMessage(modify(message))
+			if err != nil {
+				t.Fatalf("writeMessage: %+v", err)
+			}
+		}
+		err = w.endSection()
+		if err != nil {
+			t.Fatalf("endSection: %+v", err)
+		}
+	}
+	err = r.verifyChecksum()
+	if err != nil {
+		t.Fatalf("verifyChecksum: %+v", err)
+	}
+	err = w.finish()
+	if err != nil {
+		t.Fatalf("finish: %+v", err)
+	}
+	return buffer.Bytes()
+}
+
+func TestSnapshotImportErrors(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		dir, err := ioutil.TempDir("", "TestSnapshotImportErrors")
+		if err != nil {
+			t.Fatalf("TempDir: %s", err)
+		}
+		defer os.RemoveAll(dir)
+
+		_, snapshotPath, teardown := exportTestSnapshot(t, consensusConfig, dir)
+		defer teardown()
+		snapshot, err := ioutil.ReadFile(snapshotPath)
+		if err != nil {
+			t.Fatalf("ReadFile: %s", err)
+		}
+
+		otherNetConfig := *consensusConfig
+		otherNetConfig.Name = "kobra-othernet"
+
+		tests := []struct {
+			name            string
+			snapshot        func() []byte
+			consensusConfig *consensus.Config
+			expectedError   string
+		}{
+			{
+				name:            "wrong network",
+				snapshot:        func() []byte { return snapshot },
+				consensusConfig: &otherNetConfig,
+				expectedError:   "the snapshot is of network " + consensusConfig.Name,
+			},
+			{
+				name:          "truncated",
+				snapshot:      func() []byte { return snapshot[:len(snapshot)/2] },
+				expectedError: "the snapshot file is truncated",
+			},
+			{
+				name: "bad checksum",
+				snapshot: func() []byte {
+					corrupted := append([]byte{}, snapshot...)
+					corrupted[len(corrupted)-1] ^= 0xff
+					return corrupted
+				},
+				expectedError: "checksum of the snapshot file doesn't match",
+			},
+			{
+				name: "UTXO commitment mismatch",
+				snapshot: func() []byte {
+					isModified := false
+					return rewriteSnapshot(t, snapshot, func(message appmessage.Message) appmessage.Message {
+						chunk, ok := message.(*appmessage.MsgPruningPointUTXOSetChunk)
+						if ok && !isModified {
+							chunk.OutpointAndUTXOEntryPairs[0].UTXOEntry.Amount++
+							isModified = true
+						}
+						return message
+					})
+				},
+				expectedError: "the UTXO set of the snapshot is invalid",
+			},
+		}
+
+		for _, test := range tests {
+			corruptedSnapshotPath := filepath.Join(dir, strings.ReplaceAll(test.name, " ", "-"))
+			err := ioutil.WriteFile(corruptedSnapshotPath, test.snapshot(), 0600)
+			if err != nil {
+				t.Fatalf("WriteFile: %s", err)
+			}
+			importConsensusConfig := test.consensusConfig
+			if importConsensusConfig == nil {
+				importConsensusConfig = consensusConfig
+			}
+
+			err = importIntoNewDomain(t, importConsensusConfig, dir, corruptedSnapshotPath, func(domain.Domain) {})
+			if err == nil {
+				t.Fatalf("%s: expected an error", test.name)
+			}
+			if !strings.Contains(err.Error(), test.expectedError) {
+				t.Fatalf("%s: unexpected error: %s", test.name, err)
+			}
+		}
+	})
+}
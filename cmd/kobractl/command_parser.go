@@ -1,6 +1,7 @@
 package main
 
 import (
+	"encoding/json"
 	"reflect"
 	"strconv"
 	"strings"
@@ -152,6 +153,9 @@ func stringToValue(parameterDesc *parameterDescription, valueStr string) (reflec
 
 	case reflect.Slice:
 		sliceType := parameterDesc.typeof.Elem()
+		if sliceType.Kind() == reflect.Ptr && sliceType.Elem().Kind() == reflect.Struct {
+			return stringToMessageSlice(parameterDesc, valueStr)
+		}
 		if sliceType.Kind() != reflect.String {
 			return reflect.Value{},
 				errors.Errorf("Unsupported slice type '%s' for parameter '%s'",
@@ -186,3 +190,23 @@ func stringToValue(parameterDesc *parameterDescription, valueStr string) (reflec
 
 	return reflect.ValueOf(value), nil
 }
+
+// stringToMessageSlice parses a JSON array of protobuf messages
+func stringToMessageSlice(parameterDesc *parameterDescription, valueStr string) (reflect.Value, error) {
+	var rawMessages []json.RawMessage
+	err := json.Unmarshal([]byte(valueStr), &rawMessages)
+	if err != nil {
+		return reflect.Value{}, errors.Wrapf(err, "parameter '%s' must be a JSON array", parameterDesc.name)
+	}
+	messageType := parameterDesc.typeof.Elem().Elem()
+	slice := reflect.MakeSlice(parameterDesc.typeof, 0, len(rawMessages))
+	for _, rawMessage := range rawMessages {
+		pointer := reflect.New(messageType)
+		err := protojson.Unmarshal(rawMessage, pointer.Interface().(proto.Message))
+		if err != nil {
+			return reflect.Value{}, errors.WithStack(err)
+		}
+		slice = reflect.Append(slice, pointer)
+	}
+	return slice, nil
+}
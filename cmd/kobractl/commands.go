@@ -38,9 +38,15 @@ var commandTypes = []reflect.Type{
 	reflect.TypeOf(protowire.KobradMessage_GetUtxosByAddressesRequest{}),
 	reflect.TypeOf(protowire.KobradMessage_GetBalanceByAddressRequest{}),
 	reflect.TypeOf(protowire.KobradMessage_GetCoinSupplyRequest{}),
+	reflect.TypeOf(protowire.KobradMessage_GetTransactionRequest{}),
+	reflect.TypeOf(protowire.KobradMessage_GetTransactionsByAddressesRequest{}),
+	reflect.TypeOf(protowire.KobradMessage_GetFeeEstimateRequest{}),
+	reflect.TypeOf(protowire.KobradMessage_SubmitTransactionReplacementRequest{}),
+	reflect.TypeOf(protowire.KobradMessage_GetDatabaseStatsRequest{}),
 
 	reflect.TypeOf(protowire.KobradMessage_BanRequest{}),
 	reflect.TypeOf(protowire.KobradMessage_UnbanRequest{}),
+	reflect.TypeOf(protowire.KobradMessage_BackupRequest{}),
 }
 
 type commandDescription struct {
@@ -18,6 +18,7 @@ type configFlags struct {
 	ListCommands                       bool   `short:"l" long:"list-commands" description:"List all commands and exit"`
 	AllowConnectionToDifferentVersions bool   `short:"a" long:"allow-connection-to-different-versions" description:"Allow connections to versions different than kobractl's version'"`
 	CommandAndParameters               []string
+	config.RPCAuthFlags
 	config.NetworkFlags
 }
 
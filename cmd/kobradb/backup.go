@@ -0,0 +1,43 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/kobradag/kobrad/infrastructure/network/rpcclient"
+	"github.com/pkg/errors"
+)
+
+func backup(conf *backupConfig) error {
+	// The node resolves the target on its own machine, so a relative path
+	// would be resolved against the working directory of the node
+	target, err := filepath.Abs(conf.Target)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	rpcAddress, err := conf.NetParams().NormalizeRPCServerAddress(conf.RPCServer)
+	if err != nil {
+		return err
+	}
+	client, err := rpcclient.NewRPCClientWithAuthorization(rpcAddress, conf.RPCAuthorization())
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	client.SetTimeout(time.Duration(conf.Timeout) * time.Second)
+
+	fmt.Printf("Backing up the database of the node at %s to %s\n", rpcAddress, target)
+	start := time.Now()
+	response, err := client.Backup(target)
+	if err != nil {
+		return err
+	}
+
+	for _, section := range response.Sections {
+		fmt.Printf("%-16s %12d keys %12s\n", section.Name, section.KeyCount, formatSize(int64(section.Size)))
+	}
+	fmt.Printf("Backed up the database in %s\n", time.Since(start).Round(time.Second))
+	return nil
+}
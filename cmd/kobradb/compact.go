@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"time"
+
+	"github.com/kobradag/kobrad/infrastructure/db/database/backends"
+	"github.com/pkg/errors"
+)
+
+func compact(conf *compactConfig) error {
+	path := conf.databasePath()
+	options := backends.DefaultOptions(conf.CacheSizeMiB)
+	options.LevelDB.Compression = conf.LdbCompression
+	options.LevelDB.CompactionTableSizeMiB = conf.LdbCompactionTableSizeMiB
+
+	db, err := backends.OpenExisting(path, options)
+	if err != nil {
+		return err
+	}
+
+	sizeBefore, err := directorySize(path)
+	if err != nil {
+		db.Close()
+		return err
+	}
+	fmt.Printf("Compacting the database at %s (%s)\n", path, formatSize(sizeBefore))
+
+	start := time.Now()
+	err = db.Compact()
+	if err != nil {
+		db.Close()
+		return err
+	}
+	err = db.Close()
+	if err != nil {
+		return err
+	}
+
+	sizeAfter, err := directorySize(path)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Compacted the database in %s, from %s to %s\n",
+		time.Since(start).Round(time.Second), formatSize(sizeBefore), formatSize(sizeAfter))
+	return nil
+}
+
+// directorySize returns the total size of the files in the given directory
+func directorySize(path string) (int64, error) {
+	size := int64(0)
+	err := filepath.WalkDir(path, func(_ string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		size += info.Size()
+		return nil
+	})
+	return size, errors.WithStack(err)
+}
+
+func formatSize(size int64) string {
+	return fmt.Sprintf("%.1f MiB", float64(size)/(1024*1024))
+}
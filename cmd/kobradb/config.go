@@ -0,0 +1,217 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/jessevdk/go-flags"
+	"github.com/kobradag/kobrad/infrastructure/config"
+	"github.com/kobradag/kobrad/infrastructure/db/database/backends"
+	"github.com/kobradag/kobrad/infrastructure/db/database/ldb"
+	"github.com/pkg/errors"
+)
+
+const (
+	compactSubCmd = "compact"
+	backupSubCmd  = "backup"
+	restoreSubCmd = "restore"
+	verifySubCmd  = "verify"
+
+	exportSnapshotSubCmd  = "export-snapshot"
+	importSnapshotSubCmd  = "import-snapshot"
+	inspectSnapshotSubCmd = "inspect-snapshot"
+)
+
+const (
+	// defaultDataDirname is the name of the directory of the database
+	// within the network directory of the kobrad appdir
+	defaultDataDirname = "datadir2"
+
+	defaultCacheSizeMiB = 256
+
+	defaultRPCServer = "localhost"
+
+	// defaultBackupTimeoutSeconds is long, since the node answers the
+	// Backup request only once the whole database is copied
+	defaultBackupTimeoutSeconds = 60 * 60
+)
+
+type configFlags struct {
+}
+
+// databaseFlags locate the database of a kobrad node, which must not be
+// running while kobradb works on its database
+type databaseFlags struct {
+	AppDir string `short:"b" long:"appdir" description:"The appdir of the kobrad node"`
+	config.NetworkFlags
+}
+
+func (flags *databaseFlags) databasePath() string {
+	return filepath.Join(flags.AppDir, flags.NetParams().Name, defaultDataDirname)
+}
+
+type compactConfig struct {
+	CacheSizeMiB              int    `long:"dbcachesize" description:"Size of the database cache in MiB"`
+	LdbCompression            string `long:"ldbcompression" description:"Compression of the leveldb tables that the compaction writes {none, snappy}"`
+	LdbCompactionTableSizeMiB int    `long:"ldbcompactiontablesize" description:"Size in MiB of the tables that the compaction writes (default: 2)"`
+	databaseFlags
+}
+
+type backupConfig struct {
+	RPCServer string `long:"rpcserver" short:"s" description:"RPC server of the node to back up"`
+	Target    string `long:"target" short:"t" description:"Directory to write the backup to. It must not exist, or be empty" required:"true"`
+	Timeout   uint32 `long:"wait-timeout" short:"w" description:"Waiting timeout for the backup, seconds (default: 3600 s)"`
+	config.RPCAuthFlags
+	config.NetworkFlags
+}
+
+type restoreConfig struct {
+	Backup       string `long:"backup" description:"Directory of the backup to restore" required:"true"`
+	DbType       string `long:"dbtype" description:"Database backend to restore into (default: the backend of the backup)"`
+	Force        bool   `long:"force" description:"Remove the existing database of the node before restoring"`
+	CacheSizeMiB int    `long:"dbcachesize" description:"Size of the database cache in MiB"`
+	databaseFlags
+}
+
+type verifyConfig struct {
+	CacheSizeMiB int `long:"dbcachesize" description:"Size of the database cache in MiB"`
+	databaseFlags
+}
+
+type exportSnapshotConfig struct {
+	Output       string `long:"output" short:"o" description:"File to write the snapshot to. It must not exist" required:"true"`
+	CacheSizeMiB int    `long:"dbcachesize" description:"Size of the database cache in MiB"`
+	databaseFlags
+}
+
+type importSnapshotConfig struct {
+	Snapshot     string `long:"snapshot" description:"Snapshot file to import" required:"true"`
+	DbType       string `long:"dbtype" description:"Database backend to import into"`
+	Force        bool   `long:"force" description:"Remove the existing database of the node before importing"`
+	CacheSizeMiB int    `long:"dbcachesize" description:"Size of the database cache in MiB"`
+	databaseFlags
+}
+
+type inspectSnapshotConfig struct {
+	Snapshot string `long:"snapshot" description:"Snapshot file to inspect" required:"true"`
+}
+
+func parseCommandLine() (subCommand string, config interface{}) {
+	cfg := &configFlags{}
+	parser := flags.NewParser(cfg, flags.PrintErrors|flags.HelpFlag)
+
+	compactConf := &compactConfig{
+		CacheSizeMiB:   defaultCacheSizeMiB,
+		LdbCompression: ldb.CompressionNone,
+		databaseFlags:  defaultDatabaseFlags(),
+	}
+	parser.AddCommand(compactSubCmd, "Compacts the database of a stopped node",
+		"Compacts the whole database of a node that isn't running, which removes the data that was overwritten "+
+			"or deleted. With the ldb backend, the tables that the compaction rewrites are written with the given compression", compactConf)
+
+	backupConf := &backupConfig{
+		RPCServer: defaultRPCServer,
+		Timeout:   defaultBackupTimeoutSeconds,
+	}
+	parser.AddCommand(backupSubCmd, "Backs up the database of a running node",
+		"Asks a running node to write a consistent backup of its database to the given directory on the machine of "+
+			"the node. The node keeps running while the backup is written", backupConf)
+
+	restoreConf := &restoreConfig{
+		CacheSizeMiB:  defaultCacheSizeMiB,
+		databaseFlags: defaultDatabaseFlags(),
+	}
+	parser.AddCommand(restoreSubCmd, "Restores a backup into the database of a stopped node",
+		"Restores a backup that was written by the backup command into the database of a node that isn't running", restoreConf)
+
+	verifyConf := &verifyConfig{
+		CacheSizeMiB:  defaultCacheSizeMiB,
+		databaseFlags: defaultDatabaseFlags(),
+	}
+	parser.AddCommand(verifySubCmd, "Verifies the integrity of the database of a stopped node",
+		"Checks that the block statuses, GHOSTDAG data, reachability data, UTXO diffs and multisets of all the blocks "+
+			"are present and reference each other consistently, and recomputes the MuHash of the virtual and of the "+
+			"pruning point UTXO sets against their commitments. Exits with an error if it finds any problem", verifyConf)
+
+	exportSnapshotConf := &exportSnapshotConfig{
+		CacheSizeMiB:  defaultCacheSizeMiB,
+		databaseFlags: defaultDatabaseFlags(),
+	}
+	parser.AddCommand(exportSnapshotSubCmd, "Exports a pruning point snapshot of the database of a stopped node",
+		"Exports the pruning point proof, the pruning point and its anticone, the headers and blocks of its future "+
+			"and its UTXO set to a snapshot file, from which new nodes are bootstrapped with import-snapshot", exportSnapshotConf)
+
+	importSnapshotConf := &importSnapshotConfig{
+		DbType:        backends.DefaultType,
+		CacheSizeMiB:  defaultCacheSizeMiB,
+		databaseFlags: defaultDatabaseFlags(),
+	}
+	parser.AddCommand(importSnapshotSubCmd, "Bootstraps the database of a new node from a pruning point snapshot",
+		"Creates the database of a node that isn't running from a snapshot file that was written by export-snapshot. "+
+			"The snapshot is validated like the data of IBD with headers proof, including the UTXO set against the "+
+			"UTXO commitment of the pruning point", importSnapshotConf)
+
+	inspectSnapshotConf := &inspectSnapshotConfig{}
+	parser.AddCommand(inspectSnapshotSubCmd, "Prints the header of a pruning point snapshot",
+		"Prints the network, the pruning point and the UTXO commitment of a snapshot file", inspectSnapshotConf)
+
+	_, err := parser.Parse()
+	if err != nil {
+		var flagsErr *flags.Error
+		if ok := errors.As(err, &flagsErr); ok && flagsErr.Type == flags.ErrHelp {
+			os.Exit(0)
+		} else {
+			os.Exit(1)
+		}
+		return "", nil
+	}
+
+	switch parser.Command.Active.Name {
+	case compactSubCmd:
+		err := compactConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = compactConf
+	case backupSubCmd:
+		err := backupConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = backupConf
+	case restoreSubCmd:
+		err := restoreConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = restoreConf
+	case verifySubCmd:
+		err := verifyConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = verifyConf
+	case exportSnapshotSubCmd:
+		err := exportSnapshotConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = exportSnapshotConf
+	case importSnapshotSubCmd:
+		err := importSnapshotConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = importSnapshotConf
+	case inspectSnapshotSubCmd:
+		config = inspectSnapshotConf
+	}
+
+	return parser.Command.Active.Name, config
+}
+
+func defaultDatabaseFlags() databaseFlags {
+	return databaseFlags{
+		AppDir: config.DefaultAppDir,
+	}
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+func main() {
+	subCmd, config := parseCommandLine()
+	var err error
+	switch subCmd {
+	case compactSubCmd:
+		err = compact(config.(*compactConfig))
+	case backupSubCmd:
+		err = backup(config.(*backupConfig))
+	case restoreSubCmd:
+		err = restore(config.(*restoreConfig))
+	case verifySubCmd:
+		err = verify(config.(*verifyConfig))
+	case exportSnapshotSubCmd:
+		err = exportSnapshot(config.(*exportSnapshotConfig))
+	case importSnapshotSubCmd:
+		err = importSnapshot(config.(*importSnapshotConfig))
+	case inspectSnapshotSubCmd:
+		err = inspectSnapshot(config.(*inspectSnapshotConfig))
+	default:
+		err = errors.Errorf("Unknown sub-command '%s'\n", subCmd)
+	}
+
+	if err != nil {
+		printErrorAndExit(err)
+	}
+}
+
+func printErrorAndExit(err error) {
+	fmt.Fprintf(os.Stderr, "%s\n", err)
+	os.Exit(1)
+}
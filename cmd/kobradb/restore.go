@@ -0,0 +1,31 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	appbackup "github.com/kobradag/kobrad/app/backup"
+	"github.com/kobradag/kobrad/infrastructure/db/database/backends"
+	"github.com/pkg/errors"
+)
+
+func restore(conf *restoreConfig) error {
+	path := conf.databasePath()
+	if conf.Force {
+		fmt.Printf("Removing the existing database at %s\n", path)
+		err := os.RemoveAll(path)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	fmt.Printf("Restoring the backup at %s to %s\n", conf.Backup, path)
+	start := time.Now()
+	keyCount, err := appbackup.Restore(conf.Backup, path, conf.DbType, backends.DefaultOptions(conf.CacheSizeMiB))
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Restored %d keys in %s\n", keyCount, time.Since(start).Round(time.Second))
+	return nil
+}
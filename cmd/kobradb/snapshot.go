@@ -0,0 +1,83 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/kobradag/kobrad/app/utxosnapshot"
+	"github.com/kobradag/kobrad/domain/consensus"
+	"github.com/kobradag/kobrad/infrastructure/db/database/backends"
+	"github.com/kobradag/kobrad/infrastructure/logger"
+	"github.com/pkg/errors"
+)
+
+func exportSnapshot(conf *exportSnapshotConfig) error {
+	logger.InitLogStdout(logger.LevelInfo)
+
+	path := conf.databasePath()
+	fmt.Printf("Exporting a snapshot of the database at %s to %s\n", path, conf.Output)
+	start := time.Now()
+	consensusConfig := &consensus.Config{Params: *conf.NetParams()}
+	header, stats, err := utxosnapshot.ExportFromDatabase(path, backends.DefaultOptions(conf.CacheSizeMiB),
+		consensusConfig, conf.Output)
+	if err != nil {
+		return err
+	}
+
+	printSnapshotHeader(header)
+	printSnapshotStats(stats)
+	fmt.Printf("Exported the snapshot in %s\n", time.Since(start).Round(time.Second))
+	return nil
+}
+
+func importSnapshot(conf *importSnapshotConfig) error {
+	logger.InitLogStdout(logger.LevelInfo)
+
+	path := conf.databasePath()
+	if conf.Force {
+		fmt.Printf("Removing the existing database at %s\n", path)
+		err := os.RemoveAll(path)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	fmt.Printf("Importing the snapshot at %s to %s\n", conf.Snapshot, path)
+	start := time.Now()
+	consensusConfig := &consensus.Config{Params: *conf.NetParams()}
+	header, stats, err := utxosnapshot.ImportIntoNewDatabase(conf.Snapshot, path, conf.DbType,
+		backends.DefaultOptions(conf.CacheSizeMiB), consensusConfig)
+	if err != nil {
+		return err
+	}
+
+	printSnapshotHeader(header)
+	printSnapshotStats(stats)
+	fmt.Printf("Imported the snapshot in %s\n", time.Since(start).Round(time.Second))
+	return nil
+}
+
+func inspectSnapshot(conf *inspectSnapshotConfig) error {
+	header, err := utxosnapshot.ReadHeader(conf.Snapshot)
+	if err != nil {
+		return err
+	}
+	printSnapshotHeader(header)
+	return nil
+}
+
+func printSnapshotHeader(header *utxosnapshot.Header) {
+	fmt.Printf("Network:              %s\n", header.NetworkName)
+	fmt.Printf("Pruning point:        %s\n", header.PruningPointHash)
+	fmt.Printf("UTXO commitment:      %s\n", header.UTXOCommitment)
+	fmt.Printf("Headers selected tip: %s\n", header.HeadersSelectedTipHash)
+	fmt.Printf("Created at:           %s\n", header.CreatedAt)
+}
+
+func printSnapshotStats(stats *utxosnapshot.Stats) {
+	fmt.Printf("Pruning point and anticone blocks: %d\n", stats.AnticoneBlockCount)
+	fmt.Printf("Headers:                           %d\n", stats.HeaderCount)
+	fmt.Printf("UTXOs:                             %d\n", stats.UTXOCount)
+	fmt.Printf("Blocks:                            %d\n", stats.BlockCount)
+}
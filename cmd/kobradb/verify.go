@@ -0,0 +1,87 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kobradag/kobrad/app/dbversion"
+	"github.com/kobradag/kobrad/domain/consensus"
+	"github.com/kobradag/kobrad/domain/consensus/model"
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/domain/prefixmanager"
+	"github.com/kobradag/kobrad/infrastructure/db/database/backends"
+	"github.com/kobradag/kobrad/infrastructure/logger"
+	"github.com/pkg/errors"
+)
+
+func verify(conf *verifyConfig) error {
+	logger.InitLogStdout(logger.LevelInfo)
+
+	path := conf.databasePath()
+	db, err := backends.OpenExisting(path, backends.DefaultOptions(conf.CacheSizeMiB))
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	err = dbversion.Check(path)
+	if err != nil {
+		return err
+	}
+
+	activePrefix, exists, err := prefixmanager.ActivePrefix(db)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return errors.Errorf("the database at %s has no consensus", path)
+	}
+	_, hasInactivePrefix, err := prefixmanager.InactivePrefix(db)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Verifying the database at %s\n", path)
+	start := time.Now()
+	consensusConfig := &consensus.Config{Params: *conf.NetParams()}
+	report, err := consensus.VerifyIntegrity(consensusConfig, db, activePrefix)
+	if err != nil {
+		return err
+	}
+	if hasInactivePrefix {
+		report.Warnings = append(report.Warnings, "The database holds the staging consensus of an interrupted "+
+			"IBD with headers proof. The node deletes it when it starts.")
+	}
+
+	printIntegrityReport(report)
+	fmt.Printf("Verified the database in %s\n", time.Since(start).Round(time.Second))
+	if len(report.Problems) > 0 {
+		return errors.Errorf("found %d problems in the database", len(report.Problems))
+	}
+	fmt.Printf("No problems found\n")
+	return nil
+}
+
+func printIntegrityReport(report *model.IntegrityReport) {
+	fmt.Printf("Blocks:                 %d\n", report.BlockCount)
+	for _, status := range []externalapi.BlockStatus{externalapi.StatusUTXOValid, externalapi.StatusUTXOPendingVerification,
+		externalapi.StatusDisqualifiedFromChain, externalapi.StatusHeaderOnly, externalapi.StatusInvalid} {
+
+		fmt.Printf("  %-22s%d\n", status.String()+":", report.BlockCountByStatus[status])
+	}
+	fmt.Printf("Virtual UTXOs:          %d\n", report.VirtualUTXOCount)
+	if report.VirtualUTXOCommitment != nil {
+		fmt.Printf("Virtual UTXO MuHash:    %s\n", report.VirtualUTXOCommitment)
+	}
+	if report.PruningPoint != nil {
+		fmt.Printf("Pruning point:          %s\n", report.PruningPoint)
+		fmt.Printf("Pruning point UTXOs:    %d\n", report.PruningPointUTXOCount)
+	}
+
+	for _, warning := range report.Warnings {
+		fmt.Printf("Warning: %s\n", warning)
+	}
+	for _, problem := range report.Problems {
+		fmt.Printf("Problem: %s\n", problem)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"runtime"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/kobradag/kobrad/infrastructure/config"
 
@@ -21,6 +22,14 @@ const (
 	defaultLogFilename          = "kobraminer.log"
 	defaultErrLogFilename       = "kobraminer_err.log"
 	defaultTargetBlockRateRatio = 2.0
+
+	defaultStratumDifficulty      = 1.0
+	defaultStratumMinDifficulty   = 0.01
+	defaultStratumSharesPerMinute = 20.0
+
+	defaultPoolWindowFactor    = 2.0
+	defaultPoolPayoutThreshold = 100000000
+	defaultPoolPayoutInterval  = 10 * time.Minute
 )
 
 var (
@@ -32,20 +41,41 @@ var (
 )
 
 type configFlags struct {
-	ShowVersion           bool     `short:"V" long:"version" description:"Display version information and exit"`
-	RPCServer             string   `short:"s" long:"rpcserver" description:"RPC server to connect to"`
-	MiningAddr            string   `long:"miningaddr" description:"Address to mine to"`
-	NumberOfBlocks        uint64   `short:"n" long:"numblocks" description:"Number of blocks to mine. If omitted, will mine until the process is interrupted."`
-	Threads               *int     `short:"t" long:"threads" description:"Number of threads to use for CPU miner."`
-	MineWhenNotSynced     bool     `long:"mine-when-not-synced" description:"Mine even if the node is not synced with the rest of the network."`
-	Profile               string   `long:"profile" description:"Enable HTTP profiling on given port -- NOTE port must be between 1024 and 65536"`
-	TargetBlocksPerSecond *float64 `long:"target-blocks-per-second" description:"Sets a maximum block rate. 0 means no limit (The default one is 2 * target network block rate)"`
+	ShowVersion            bool          `short:"V" long:"version" description:"Display version information and exit"`
+	RPCServer              string        `short:"s" long:"rpcserver" description:"RPC server to connect to"`
+	MiningAddr             string        `long:"miningaddr" description:"Address to mine to"`
+	NumberOfBlocks         uint64        `short:"n" long:"numblocks" description:"Number of blocks to mine. If omitted, will mine until the process is interrupted."`
+	Threads                *int          `short:"t" long:"threads" description:"Number of threads to use for CPU miner."`
+	MineWhenNotSynced      bool          `long:"mine-when-not-synced" description:"Mine even if the node is not synced with the rest of the network."`
+	Profile                string        `long:"profile" description:"Enable HTTP profiling on given port -- NOTE port must be between 1024 and 65536"`
+	TargetBlocksPerSecond  *float64      `long:"target-blocks-per-second" description:"Sets a maximum block rate. 0 means no limit (The default one is 2 * target network block rate)"`
+	StratumListen          string        `long:"stratumlisten" description:"Serve block templates to external miners over stratum on the given interface/port instead of mining with the CPU (e.g. :5555)"`
+	StratumDifficulty      float64       `long:"stratumdifficulty" description:"Initial difficulty of stratum shares. A share of difficulty 1 takes 2^32 hashes on average"`
+	StratumMinDifficulty   float64       `long:"stratummindifficulty" description:"Lowest difficulty of stratum shares that variable difficulty may set"`
+	StratumSharesPerMinute float64       `long:"stratumsharesperminute" description:"Rate of shares per miner that variable difficulty aims for. 0 disables variable difficulty"`
+	Pool                   bool          `long:"pool" description:"Keep PPLNS accounting of the shares and blocks of the members of a pool, and pay their rewards from the mining address"`
+	PoolDir                string        `long:"pooldir" description:"Directory to store the pool accounting in"`
+	PoolKeysFile           string        `long:"poolkeysfile" description:"The kobrawallet keys file of the mining address, which the pool pays its members from (default: the default kobrawallet keys file)"`
+	PoolPassword           string        `long:"poolpassword" description:"Password of the pool keys file. If omitted, it's prompted for"`
+	PoolWorkerAddress      string        `long:"poolworkeraddress" description:"Address of the pool member the shares and blocks of the CPU miner are credited to"`
+	PoolShareDifficulty    float64       `long:"poolsharedifficulty" description:"Difficulty of the shares of the CPU miner. 0 means 1/64 of the difficulty of the block"`
+	PoolWindowFactor       float64       `long:"pplnswindow" description:"Size of the PPLNS window as a multiple of the difficulty of the block"`
+	PoolPayoutThreshold    uint64        `long:"poolpayoutthreshold" description:"Balance, in leor, from which pool members are paid"`
+	PoolPayoutInterval     time.Duration `long:"poolpayoutinterval" description:"Interval in which matured rewards are credited and balances are paid"`
+	PoolReport             bool          `long:"poolreport" description:"Print the blocks, balances and payouts of the pool and exit"`
+	config.RPCAuthFlags
 	config.NetworkFlags
 }
 
 func parseConfig() (*configFlags, error) {
 	cfg := &configFlags{
-		RPCServer: defaultRPCServer,
+		RPCServer:              defaultRPCServer,
+		StratumDifficulty:      defaultStratumDifficulty,
+		StratumMinDifficulty:   defaultStratumMinDifficulty,
+		StratumSharesPerMinute: defaultStratumSharesPerMinute,
+		PoolWindowFactor:       defaultPoolWindowFactor,
+		PoolPayoutThreshold:    defaultPoolPayoutThreshold,
+		PoolPayoutInterval:     defaultPoolPayoutInterval,
 	}
 	parser := flags.NewParser(cfg, flags.PrintErrors|flags.HelpFlag)
 	_, err := parser.Parse()
@@ -79,6 +109,28 @@ func parseConfig() (*configFlags, error) {
 		}
 	}
 
+	if cfg.StratumDifficulty <= 0 || cfg.StratumMinDifficulty <= 0 {
+		return nil, errors.New("--stratumdifficulty and --stratummindifficulty must be positive")
+	}
+	if cfg.StratumSharesPerMinute < 0 {
+		return nil, errors.New("--stratumsharesperminute may not be negative")
+	}
+
+	if cfg.PoolDir == "" {
+		cfg.PoolDir = filepath.Join(defaultAppDir, "pool", cfg.NetParams().Name)
+	}
+	if cfg.Pool && !cfg.PoolReport {
+		if cfg.StratumListen == "" && cfg.PoolWorkerAddress == "" {
+			return nil, errors.New("--poolworkeraddress is required when mining with --pool without --stratumlisten")
+		}
+		if cfg.PoolShareDifficulty < 0 || cfg.PoolWindowFactor <= 0 {
+			return nil, errors.New("--poolsharedifficulty may not be negative and --pplnswindow must be positive")
+		}
+		if cfg.PoolPayoutInterval <= 0 {
+			return nil, errors.New("--poolpayoutinterval must be positive")
+		}
+	}
+
 	if cfg.Threads == nil {
 		numcpu := runtime.NumCPU()
 		fmt.Printf("Number of CPU's found: %d\n", numcpu)
@@ -86,7 +138,7 @@ func parseConfig() (*configFlags, error) {
 	}
 	fmt.Printf("Threads enabled: %d\n", *cfg.Threads)
 
-	if cfg.MiningAddr == "" {
+	if cfg.MiningAddr == "" && !cfg.PoolReport {
 		return nil, errors.New("--miningaddr is required")
 	}
 
@@ -35,6 +35,14 @@ func main() {
 		profiling.Start(cfg.Profile, log)
 	}
 
+	if cfg.PoolReport {
+		err := printPoolReport(cfg)
+		if err != nil {
+			printErrorAndExit(errors.Errorf("Error printing the pool report: %s", err))
+		}
+		return
+	}
+
 	client, err := newMinerClient(cfg)
 	if err != nil {
 		panic(errors.Wrap(err, "error connecting to the RPC server"))
@@ -46,14 +54,33 @@ func main() {
 		printErrorAndExit(errors.Errorf("Error decoding mining address: %s", err))
 	}
 
+	minerPool, err := newPool(cfg, client, miningAddr)
+	if err != nil {
+		printErrorAndExit(errors.Errorf("Error opening the pool accounting: %s", err))
+	}
+	if minerPool != nil {
+		defer minerPool.Stop()
+	}
+
 	doneChan := make(chan struct{})
-	spawn("mineLoop", func() {
-		err = mineLoop(client, cfg.NumberOfBlocks, *cfg.TargetBlocksPerSecond, cfg.MineWhenNotSynced, miningAddr, cfg.Threads)
-		if err != nil {
-			panic(errors.Wrap(err, "error in mine loop"))
-		}
-		doneChan <- struct{}{}
-	})
+	if cfg.StratumListen != "" {
+		spawn("stratumLoop", func() {
+			err = stratumLoop(client, cfg, miningAddr, minerPool)
+			if err != nil {
+				panic(errors.Wrap(err, "error in stratum loop"))
+			}
+			doneChan <- struct{}{}
+		})
+	} else {
+		spawn("mineLoop", func() {
+			err = mineLoop(client, cfg.NumberOfBlocks, *cfg.TargetBlocksPerSecond, cfg.MineWhenNotSynced, miningAddr, cfg.Threads,
+				newCPUPoolAccounting(cfg, minerPool))
+			if err != nil {
+				panic(errors.Wrap(err, "error in mine loop"))
+			}
+			doneChan <- struct{}{}
+		})
+	}
 
 	select {
 	case <-doneChan:
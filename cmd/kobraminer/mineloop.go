@@ -23,7 +23,7 @@ var hashesTried uint64
 const logHashRateInterval = 10 * time.Second
 
 func mineLoop(client *minerClient, numberOfBlocks uint64, targetBlocksPerSecond float64, mineWhenNotSynced bool,
-	miningAddr util.Address, threads *int) error {
+	miningAddr util.Address, threads *int, accounting *cpuPoolAccounting) error {
 	rand.Seed(time.Now().UnixNano()) // Seed the global concurrent-safe random source.
 
 	errChan := make(chan error)
@@ -36,7 +36,7 @@ func mineLoop(client *minerClient, numberOfBlocks uint64, targetBlocksPerSecond
 	foundBlockChan := make(chan *externalapi.DomainBlock, router.DefaultMaxMessages/2)
 
 	spawn("templatesLoop", func() {
-		templatesLoop(client, miningAddr, errChan)
+		templatesLoop(client, miningAddr, errChan, nil)
 	})
 
 	for t := 0; t < *threads; t++ {
@@ -61,7 +61,7 @@ func mineLoop(client *minerClient, numberOfBlocks uint64, targetBlocksPerSecond
 
 				windowStart := time.Now()
 				for blockIndex := 1; ; blockIndex++ {
-					foundBlockChan <- mineNextBlock(mineWhenNotSynced)
+					foundBlockChan <- mineNextBlock(mineWhenNotSynced, accounting)
 					if hasBlockRateTarget {
 						<-blockTicker.C
 						if (blockIndex % windowSize) == 0 {
@@ -84,6 +84,9 @@ func mineLoop(client *minerClient, numberOfBlocks uint64, targetBlocksPerSecond
 				errChan <- err
 				return
 			}
+			if accounting != nil {
+				accounting.handleFoundBlock(block)
+			}
 		}
 		doneChan <- struct{}{}
 	})
@@ -140,7 +143,7 @@ func handleFoundBlock(client *minerClient, block *externalapi.DomainBlock) error
 	return nil
 }
 
-func mineNextBlock(mineWhenNotSynced bool) *externalapi.DomainBlock {
+func mineNextBlock(mineWhenNotSynced bool, accounting *cpuPoolAccounting) *externalapi.DomainBlock {
 	nonce := rand.Uint64() // Use the global concurrent-safe random source.
 	for {
 		nonce++
@@ -152,7 +155,11 @@ func mineNextBlock(mineWhenNotSynced bool) *externalapi.DomainBlock {
 		block, state := getBlockForMining(mineWhenNotSynced)
 		state.Nonce = nonce
 		atomic.AddUint64(&hashesTried, 1)
-		if state.CheckProofOfWork() {
+		powValue := state.CalculateProofOfWorkValue()
+		if accounting != nil {
+			accounting.handleHash(powValue, &state.Target)
+		}
+		if powValue.Cmp(&state.Target) <= 0 {
 			mutHeader := block.Header.ToMutable()
 			mutHeader.SetNonce(nonce)
 			block.Header = mutHeader.ToImmutable()
@@ -192,7 +199,9 @@ func getBlockForMining(mineWhenNotSynced bool) (*externalapi.DomainBlock, *pow.S
 	}
 }
 
-func templatesLoop(client *minerClient, miningAddr util.Address, errChan chan error) {
+// templatesLoop keeps the template manager up to date, calling onTemplateSet
+// (if it's not nil) every time a template is set
+func templatesLoop(client *minerClient, miningAddr util.Address, errChan chan error, onTemplateSet func()) {
 	getBlockTemplate := func() {
 		template, err := client.GetBlockTemplate(miningAddr.String(), "kobraminer-"+version.Version())
 		if nativeerrors.Is(err, router.ErrTimeout) {
@@ -217,6 +226,9 @@ func templatesLoop(client *minerClient, miningAddr util.Address, errChan chan er
 			errChan <- errors.Wrapf(err, "Error setting block template from %s", client.Address())
 			return
 		}
+		if onTemplateSet != nil {
+			onTemplateSet()
+		}
 	}
 
 	getBlockTemplate()
@@ -0,0 +1,18 @@
+package pool
+
+import (
+	"github.com/kobradag/kobrad/infrastructure/logger"
+	"github.com/kobradag/kobrad/util/panics"
+)
+
+const logSubsystem = "POOL"
+
+var log = logger.RegisterSubSystem(logSubsystem)
+var spawn = panics.GoroutineWrapperFunc(log)
+
+// SetLogger uses a specified Logger to output package logging info
+func (p *Pool) SetLogger(backend *logger.Backend, level logger.Level) {
+	log = backend.Logger(logSubsystem)
+	log.SetLevel(level)
+	spawn = panics.GoroutineWrapperFunc(log)
+}
@@ -0,0 +1,80 @@
+package pool
+
+import "github.com/kobradag/kobrad/app/appmessage"
+
+// share is a share of work done for the pool by one of its members
+type share struct {
+	Sequence   uint64  `json:"sequence"`
+	Address    string  `json:"address"`
+	Difficulty float64 `json:"difficulty"`
+
+	// CumulativeWork is the sum of the difficulties of all the
+	// shares up to and including this one
+	CumulativeWork float64 `json:"cumulativeWork"`
+
+	// Timestamp is the time the share was recorded, in milliseconds
+	Timestamp int64 `json:"timestamp"`
+}
+
+// blockStatus is the status of the reward of a found block
+type blockStatus string
+
+const (
+	// blockStatusPending is the status of a block whose reward hasn't matured yet
+	blockStatusPending blockStatus = "pending"
+
+	// blockStatusRewarded is the status of a block whose reward
+	// matured and was credited to the members of the pool
+	blockStatusRewarded blockStatus = "rewarded"
+
+	// blockStatusLost is the status of a block whose reward never
+	// arrived, most likely because it was red
+	blockStatusLost blockStatus = "lost"
+)
+
+// foundBlock is a block found by one of the members of the pool
+type foundBlock struct {
+	Hash     string `json:"hash"`
+	Address  string `json:"address"`
+	DAAScore uint64 `json:"daaScore"`
+
+	// ShareSequence is the sequence of the last share that was recorded
+	// before the block was found. It ends the PPLNS window of the block.
+	ShareSequence uint64 `json:"shareSequence"`
+
+	// WindowWork is the total difficulty of the shares
+	// among which the reward of the block is split
+	WindowWork float64 `json:"windowWork"`
+
+	Status blockStatus `json:"status"`
+	Reward uint64      `json:"reward"`
+
+	// Timestamp is the time the block was found, in milliseconds
+	Timestamp int64 `json:"timestamp"`
+}
+
+// payout is a transaction that pays the balances of members of the pool
+type payout struct {
+	TransactionID string            `json:"transactionId"`
+	Amounts       map[string]uint64 `json:"amounts"`
+	Fee           uint64            `json:"fee"`
+
+	// Transaction is the signed transaction, which is kept
+	// so that it can be resubmitted if the node drops it
+	Transaction *appmessage.RPCTransaction `json:"transaction"`
+
+	// SpentOutpoints are the outpoints spent by the transaction. The
+	// payout is confirmed once none of them is in the UTXO set anymore.
+	// Until then they're reserved for it, even after it times out, since
+	// it might still be accepted as long as it wasn't rejected.
+	SpentOutpoints []string `json:"spentOutpoints"`
+
+	IsConfirmed bool `json:"isConfirmed"`
+
+	// Timestamp is the time the payout was made, in milliseconds
+	Timestamp int64 `json:"timestamp"`
+
+	// SubmissionTimestamp is the time the transaction was last
+	// submitted to the node, in milliseconds
+	SubmissionTimestamp int64 `json:"submissionTimestamp"`
+}
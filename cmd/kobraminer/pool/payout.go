@@ -0,0 +1,495 @@
+package pool
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/kobradag/kobrad/app/appmessage"
+	"github.com/kobradag/kobrad/cmd/kobrawallet/daemon/pb"
+	"github.com/kobradag/kobrad/cmd/kobrawallet/libkobrawallet"
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/domain/consensus/utils/consensushashing"
+	"github.com/kobradag/kobrad/infrastructure/db/database"
+	"github.com/kobradag/kobrad/infrastructure/network/rpcclient"
+	"github.com/kobradag/kobrad/util"
+	"github.com/kobradag/kobrad/util/txmass"
+	"github.com/pkg/errors"
+)
+
+const (
+	// maxPayoutInputs is the maximal number of inputs of a payout
+	// transaction, which keeps it well within the standard mass limit
+	maxPayoutInputs = 80
+
+	// payoutConfirmationTimeout is the time after which a payout whose
+	// inputs are still unspent and that isn't in the mempool of the node
+	// is submitted again. If the node rejects it, its amounts are
+	// returned to the balances of the members.
+	payoutConfirmationTimeout = time.Hour
+
+	// defaultFeeRate is the fee rate, in leor per gram of mass, paid
+	// when the node is unable to provide a fee estimate
+	defaultFeeRate = 1.0
+)
+
+// confirmPayouts marks the payouts whose inputs were spent as confirmed,
+// and resubmits the payouts that timed out. The amounts of the payouts the
+// node rejects are returned to the balances.
+func (p *Pool) confirmPayouts(entries []*appmessage.UTXOsByAddressesEntry, now time.Time) error {
+	utxoSet := make(map[string]struct{}, len(entries))
+	for _, entry := range entries {
+		utxoSet[outpointString(entry.Outpoint)] = struct{}{}
+	}
+
+	payouts, err := p.store.payouts(p.database)
+	if err != nil {
+		return err
+	}
+	dbTx, err := p.database.Begin()
+	if err != nil {
+		return err
+	}
+	defer dbTx.RollbackUnlessClosed()
+
+	for _, unconfirmedPayout := range payouts {
+		if unconfirmedPayout.IsConfirmed {
+			continue
+		}
+		isSpent := true
+		for _, outpoint := range unconfirmedPayout.SpentOutpoints {
+			if _, ok := utxoSet[outpoint]; ok {
+				isSpent = false
+				break
+			}
+		}
+		if isSpent {
+			unconfirmedPayout.IsConfirmed = true
+			err := p.store.putPayout(dbTx, unconfirmedPayout)
+			if err != nil {
+				return err
+			}
+			log.Infof("Payout %s was confirmed", unconfirmedPayout.TransactionID)
+			continue
+		}
+
+		if now.Sub(time.UnixMilli(unconfirmedPayout.SubmissionTimestamp)) < payoutConfirmationTimeout {
+			continue
+		}
+		isRejected, err := p.resubmitPayout(unconfirmedPayout, now)
+		if err != nil {
+			return err
+		}
+		if !isRejected {
+			err := p.store.putPayout(dbTx, unconfirmedPayout)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		err = p.returnPayout(dbTx, unconfirmedPayout)
+		if err != nil {
+			return err
+		}
+		log.Warnf("Payout %s wasn't confirmed within %s and was rejected by the node. "+
+			"Its amounts were returned to the balances", unconfirmedPayout.TransactionID, payoutConfirmationTimeout)
+	}
+	return dbTx.Commit()
+}
+
+// resubmitPayout submits the transaction of a payout that timed out again,
+// unless it's still in the mempool, and returns whether the node rejected it
+func (p *Pool) resubmitPayout(unconfirmedPayout *payout, now time.Time) (isRejected bool, err error) {
+	_, err = p.client.GetMempoolEntry(unconfirmedPayout.TransactionID, false, false)
+	if err == nil {
+		log.Infof("Payout %s is still waiting in the mempool", unconfirmedPayout.TransactionID)
+		unconfirmedPayout.SubmissionTimestamp = now.UnixMilli()
+		return false, nil
+	}
+	if !errors.Is(err, rpcclient.ErrRPC) {
+		return false, err
+	}
+
+	_, err = p.client.SubmitTransaction(unconfirmedPayout.Transaction, unconfirmedPayout.TransactionID, false)
+	if err != nil {
+		if errors.Is(err, rpcclient.ErrRPC) {
+			log.Warnf("The node rejected payout %s: %s", unconfirmedPayout.TransactionID, err)
+			return true, nil
+		}
+		return false, errors.Wrapf(err, "error resubmitting payout %s", unconfirmedPayout.TransactionID)
+	}
+	log.Infof("Resubmitted payout %s, which the node dropped", unconfirmedPayout.TransactionID)
+	unconfirmedPayout.SubmissionTimestamp = now.UnixMilli()
+	return false, nil
+}
+
+// returnPayout returns the amounts of a payout that was rejected to the
+// balances of the members, and deletes it so that its outpoints may be spent
+func (p *Pool) returnPayout(dbTx database.Transaction, rejectedPayout *payout) error {
+	for address, amount := range rejectedPayout.Amounts {
+		err := p.store.addToBalance(dbTx, address, amount)
+		if err != nil {
+			return err
+		}
+	}
+	return p.store.deletePayout(dbTx, rejectedPayout)
+}
+
+// creditMaturedRewards splits every matured coinbase output of the mining
+// address that wasn't credited yet among the members of the pool. Every
+// output is attributed to the oldest pending block that precedes it.
+func (p *Pool) creditMaturedRewards(entries []*appmessage.UTXOsByAddressesEntry, virtualDAAScore uint64) error {
+	var rewards []*appmessage.UTXOsByAddressesEntry
+	for _, entry := range entries {
+		if !entry.UTXOEntry.IsCoinbase || !p.isMatured(entry, virtualDAAScore) {
+			continue
+		}
+		isCredited, err := p.store.isRewardCredited(p.database, outpointString(entry.Outpoint))
+		if err != nil {
+			return err
+		}
+		if !isCredited {
+			rewards = append(rewards, entry)
+		}
+	}
+	if len(rewards) == 0 {
+		return nil
+	}
+	sort.Slice(rewards, func(i, j int) bool {
+		if rewards[i].UTXOEntry.BlockDAAScore != rewards[j].UTXOEntry.BlockDAAScore {
+			return rewards[i].UTXOEntry.BlockDAAScore < rewards[j].UTXOEntry.BlockDAAScore
+		}
+		return outpointString(rewards[i].Outpoint) < outpointString(rewards[j].Outpoint)
+	})
+
+	blocks, err := p.store.blocks(p.database)
+	if err != nil {
+		return err
+	}
+	var pendingBlocks []*foundBlock
+	for _, block := range blocks {
+		if block.Status == blockStatusPending {
+			pendingBlocks = append(pendingBlocks, block)
+		}
+	}
+	// Blocks that were found after the same share aren't necessarily
+	// stored in the order of their DAA scores
+	sort.SliceStable(pendingBlocks, func(i, j int) bool {
+		return pendingBlocks[i].DAAScore < pendingBlocks[j].DAAScore
+	})
+
+	dbTx, err := p.database.Begin()
+	if err != nil {
+		return err
+	}
+	defer dbTx.RollbackUnlessClosed()
+
+	for _, reward := range rewards {
+		outpoint := outpointString(reward.Outpoint)
+		amount := reward.UTXOEntry.Amount
+		rewardDAAScore := reward.UTXOEntry.BlockDAAScore
+
+		// A block whose reward would have been paid long before this
+		// one was most likely red, and its reward is never going to arrive
+		for len(pendingBlocks) > 0 && pendingBlocks[0].DAAScore+p.cfg.Params.MergeDepth < rewardDAAScore {
+			lostBlock := pendingBlocks[0]
+			pendingBlocks = pendingBlocks[1:]
+			lostBlock.Status = blockStatusLost
+			err := p.store.putBlock(dbTx, lostBlock)
+			if err != nil {
+				return err
+			}
+			log.Warnf("The reward of block %s found by %s never arrived", lostBlock.Hash, lostBlock.Address)
+		}
+
+		if len(pendingBlocks) == 0 || pendingBlocks[0].DAAScore >= rewardDAAScore {
+			err := p.store.markRewardCredited(dbTx, outpoint, "")
+			if err != nil {
+				return err
+			}
+			log.Warnf("Coinbase output %s of %d leor can't be attributed to a block found by the pool. "+
+				"It's kept by the pool", outpoint, amount)
+			continue
+		}
+		rewardedBlock := pendingBlocks[0]
+		pendingBlocks = pendingBlocks[1:]
+
+		shares, err := p.store.windowShares(dbTx, rewardedBlock.ShareSequence, rewardedBlock.WindowWork)
+		if err != nil {
+			return err
+		}
+		distribution := pplnsDistribution(shares, rewardedBlock.WindowWork, amount)
+		if len(distribution) == 0 {
+			// Without any shares the whole reward goes to whoever found the block
+			distribution = map[string]uint64{rewardedBlock.Address: amount}
+		}
+		for address, addressAmount := range distribution {
+			err := p.store.addToBalance(dbTx, address, addressAmount)
+			if err != nil {
+				return err
+			}
+		}
+
+		rewardedBlock.Status = blockStatusRewarded
+		rewardedBlock.Reward = amount
+		err = p.store.putBlock(dbTx, rewardedBlock)
+		if err != nil {
+			return err
+		}
+		err = p.store.markRewardCredited(dbTx, outpoint, rewardedBlock.Hash)
+		if err != nil {
+			return err
+		}
+		log.Infof("Credited the reward of %d leor of block %s to %d members",
+			amount, rewardedBlock.Hash, len(distribution))
+	}
+	return dbTx.Commit()
+}
+
+// payBalances pays every member whose balance reached the payout threshold
+// in a single transaction. The fee of the transaction is split evenly
+// between the members it pays.
+func (p *Pool) payBalances(entries []*appmessage.UTXOsByAddressesEntry, virtualDAAScore uint64) error {
+	balances, err := p.store.balances(p.database)
+	if err != nil {
+		return err
+	}
+	var payees []string
+	for address, balance := range balances {
+		if balance >= p.cfg.PayoutThreshold {
+			payees = append(payees, address)
+		}
+	}
+	if len(payees) == 0 {
+		return nil
+	}
+	// Pay the largest balances first in case the funds don't cover all of them
+	sort.Slice(payees, func(i, j int) bool {
+		if balances[payees[i]] != balances[payees[j]] {
+			return balances[payees[i]] > balances[payees[j]]
+		}
+		return payees[i] < payees[j]
+	})
+
+	spendableUTXOs, err := p.spendableUTXOs(entries, virtualDAAScore)
+	if err != nil {
+		return err
+	}
+	if len(spendableUTXOs) > maxPayoutInputs {
+		spendableUTXOs = spendableUTXOs[:maxPayoutInputs]
+	}
+	available := uint64(0)
+	for _, utxo := range spendableUTXOs {
+		available += utxo.UTXOEntry.Amount()
+	}
+
+	total := uint64(0)
+	for _, address := range payees {
+		total += balances[address]
+	}
+	for len(payees) > 0 && total > available {
+		total -= balances[payees[len(payees)-1]]
+		payees = payees[:len(payees)-1]
+	}
+	if len(payees) == 0 {
+		log.Warnf("The matured funds of the pool don't cover any of the balances due for payment")
+		return nil
+	}
+
+	var selectedUTXOs []*libkobrawallet.UTXO
+	selectedAmount := uint64(0)
+	for _, utxo := range spendableUTXOs {
+		if selectedAmount >= total {
+			break
+		}
+		selectedUTXOs = append(selectedUTXOs, utxo)
+		selectedAmount += utxo.UTXOEntry.Amount()
+	}
+
+	// Build the transaction once without a fee to learn its mass, and
+	// then again with the fee that mass requires
+	transaction, err := p.buildPayoutTransaction(selectedUTXOs, selectedAmount, payees, balances, 0)
+	if err != nil {
+		return err
+	}
+	massCalculator := txmass.NewCalculator(p.cfg.Params.MassPerTxByte,
+		p.cfg.Params.MassPerScriptPubKeyByte, p.cfg.Params.MassPerSigOp)
+	fee := uint64(math.Ceil(p.feeRate() * float64(massCalculator.CalculateTransactionMass(transaction))))
+	feePerPayee := (fee + uint64(len(payees)) - 1) / uint64(len(payees))
+	for _, address := range payees {
+		if balances[address] <= feePerPayee {
+			log.Warnf("The payout fee of %d leor per member exceeds the balance of %s. "+
+				"Consider raising the payout threshold", feePerPayee, address)
+			return nil
+		}
+	}
+	transaction, err = p.buildPayoutTransaction(selectedUTXOs, selectedAmount, payees, balances, feePerPayee)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	newPayout := &payout{
+		TransactionID:       consensushashing.TransactionID(transaction).String(),
+		Amounts:             make(map[string]uint64, len(payees)),
+		Fee:                 feePerPayee * uint64(len(payees)),
+		Transaction:         appmessage.DomainTransactionToRPCTransaction(transaction),
+		Timestamp:           now.UnixMilli(),
+		SubmissionTimestamp: now.UnixMilli(),
+	}
+	for _, address := range payees {
+		newPayout.Amounts[address] = balances[address]
+	}
+	for _, utxo := range selectedUTXOs {
+		newPayout.SpentOutpoints = append(newPayout.SpentOutpoints, outpointString(&appmessage.RPCOutpoint{
+			TransactionID: utxo.Outpoint.TransactionID.String(),
+			Index:         utxo.Outpoint.Index,
+		}))
+	}
+
+	err = p.submitPayout(newPayout)
+	if err != nil {
+		return err
+	}
+	log.Infof("Submitted payout %s paying %d leor to %d members", newPayout.TransactionID, total, len(payees))
+	return nil
+}
+
+// submitPayout stores the given payout, subtracts its amounts from the
+// balances and only then submits its transaction, so that a payout that
+// reached the node is never forgotten. If the node rejects the
+// transaction, the payout is undone.
+func (p *Pool) submitPayout(newPayout *payout) error {
+	dbTx, err := p.database.Begin()
+	if err != nil {
+		return err
+	}
+	defer dbTx.RollbackUnlessClosed()
+	for address, amount := range newPayout.Amounts {
+		err := p.store.subtractFromBalance(dbTx, address, amount)
+		if err != nil {
+			return err
+		}
+	}
+	err = p.store.putPayout(dbTx, newPayout)
+	if err != nil {
+		return err
+	}
+	err = dbTx.Commit()
+	if err != nil {
+		return err
+	}
+
+	_, submitErr := p.client.SubmitTransaction(newPayout.Transaction, newPayout.TransactionID, false)
+	if submitErr == nil {
+		return nil
+	}
+	if !errors.Is(submitErr, rpcclient.ErrRPC) {
+		// The node might have received the transaction, so the
+		// payout is kept until it's confirmed or times out
+		return errors.Wrapf(submitErr, "error submitting payout %s", newPayout.TransactionID)
+	}
+
+	dbTx, err = p.database.Begin()
+	if err != nil {
+		return err
+	}
+	defer dbTx.RollbackUnlessClosed()
+	err = p.returnPayout(dbTx, newPayout)
+	if err != nil {
+		return err
+	}
+	err = dbTx.Commit()
+	if err != nil {
+		return err
+	}
+	return errors.Wrapf(submitErr, "the node rejected payout %s", newPayout.TransactionID)
+}
+
+// spendableUTXOs returns the matured UTXOs of the mining address that
+// aren't spent by a payout that's still unconfirmed, from the largest
+func (p *Pool) spendableUTXOs(entries []*appmessage.UTXOsByAddressesEntry,
+	virtualDAAScore uint64) ([]*libkobrawallet.UTXO, error) {
+
+	payouts, err := p.store.payouts(p.database)
+	if err != nil {
+		return nil, err
+	}
+	spentOutpoints := make(map[string]struct{})
+	for _, unconfirmedPayout := range payouts {
+		if unconfirmedPayout.IsConfirmed {
+			continue
+		}
+		for _, outpoint := range unconfirmedPayout.SpentOutpoints {
+			spentOutpoints[outpoint] = struct{}{}
+		}
+	}
+
+	var spendableUTXOs []*libkobrawallet.UTXO
+	for _, entry := range entries {
+		if _, ok := spentOutpoints[outpointString(entry.Outpoint)]; ok || !p.isMatured(entry, virtualDAAScore) {
+			continue
+		}
+		utxos, err := libkobrawallet.KobrawalletdUTXOsTolibkobrawalletUTXOs(
+			[]*pb.UtxosByAddressesEntry{libkobrawallet.AppMessageUTXOToKobrawalletdUTXO(entry)})
+		if err != nil {
+			return nil, err
+		}
+		utxos[0].DerivationPath = p.derivationPath
+		spendableUTXOs = append(spendableUTXOs, utxos[0])
+	}
+	sort.Slice(spendableUTXOs, func(i, j int) bool {
+		return spendableUTXOs[i].UTXOEntry.Amount() > spendableUTXOs[j].UTXOEntry.Amount()
+	})
+	return spendableUTXOs, nil
+}
+
+// buildPayoutTransaction builds and signs a transaction that spends the
+// given UTXOs, pays the balances of the given payees minus the given fee
+// each, and returns the change to the mining address
+func (p *Pool) buildPayoutTransaction(utxos []*libkobrawallet.UTXO, utxosAmount uint64, payees []string,
+	balances map[string]uint64, feePerPayee uint64) (*externalapi.DomainTransaction, error) {
+
+	payments := make([]*libkobrawallet.Payment, 0, len(payees)+1)
+	paid := uint64(0)
+	for _, payee := range payees {
+		address, err := util.DecodeAddress(payee, p.cfg.Params.Prefix)
+		if err != nil {
+			return nil, err
+		}
+		payments = append(payments, &libkobrawallet.Payment{
+			Address: address,
+			Amount:  balances[payee] - feePerPayee,
+		})
+		paid += balances[payee]
+	}
+	if utxosAmount > paid {
+		payments = append(payments, &libkobrawallet.Payment{
+			Address: p.cfg.MiningAddress,
+			Amount:  utxosAmount - paid,
+		})
+	}
+
+	unsignedTransaction, err := libkobrawallet.CreateUnsignedTransaction(p.keysFile.ExtendedPublicKeys,
+		p.keysFile.MinimumSignatures, payments, utxos)
+	if err != nil {
+		return nil, err
+	}
+	signedTransaction, err := libkobrawallet.Sign(p.cfg.Params, []string{p.mnemonic}, unsignedTransaction, p.keysFile.ECDSA)
+	if err != nil {
+		return nil, err
+	}
+	return libkobrawallet.ExtractTransaction(signedTransaction, p.keysFile.ECDSA)
+}
+
+// feeRate returns the fee rate the node estimates for inclusion
+// within the normal time frame
+func (p *Pool) feeRate() float64 {
+	feeEstimateResponse, err := p.client.GetFeeEstimate()
+	if err != nil {
+		log.Warnf("Could not get a fee estimate from the node, falling back to %g leor per gram: %s",
+			defaultFeeRate, err)
+		return defaultFeeRate
+	}
+	return feeEstimateResponse.Estimate.NormalBucket.FeeRate
+}
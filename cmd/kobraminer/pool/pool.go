@@ -0,0 +1,291 @@
+package pool
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kobradag/kobrad/app/appmessage"
+	"github.com/kobradag/kobrad/cmd/kobrawallet/keys"
+	"github.com/kobradag/kobrad/cmd/kobrawallet/libkobrawallet"
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/domain/consensus/utils/consensushashing"
+	"github.com/kobradag/kobrad/domain/dagconfig"
+	"github.com/kobradag/kobrad/infrastructure/db/database"
+	"github.com/kobradag/kobrad/infrastructure/db/database/ldb"
+	"github.com/kobradag/kobrad/util"
+	"github.com/pkg/errors"
+)
+
+const (
+	// databaseCacheSizeMiB is the cache size of the pool database, which
+	// is small and written to far more than it's read from
+	databaseCacheSizeMiB = 8
+
+	// shareRetention is the time shares are kept for
+	shareRetention = 7 * 24 * time.Hour
+)
+
+// RPCClient is the part of the RPC client of kobrad the pool uses
+type RPCClient interface {
+	GetBlockDAGInfo() (*appmessage.GetBlockDAGInfoResponseMessage, error)
+	GetUTXOsByAddresses(addresses []string) (*appmessage.GetUTXOsByAddressesResponseMessage, error)
+	GetFeeEstimate() (*appmessage.GetFeeEstimateResponseMessage, error)
+	GetMempoolEntry(txID string, includeOrphanPool bool,
+		filterTransactionPool bool) (*appmessage.GetMempoolEntryResponseMessage, error)
+	SubmitTransaction(transaction *appmessage.RPCTransaction, transactionID string,
+		allowOrphan bool) (*appmessage.SubmitTransactionResponseMessage, error)
+}
+
+// Config is the configuration of the pool accounting
+type Config struct {
+	// DataDir is the directory the accounting of the pool is stored in
+	DataDir string
+
+	Params *dagconfig.Params
+
+	// MiningAddress is the address the blocks of the pool pay to. It must
+	// be one of the addresses of the single-signer kobrawallet keys file
+	// KeysFile, so that the pool can pay its members from it.
+	MiningAddress util.Address
+	KeysFile      string
+	KeysPassword  string
+
+	// WindowFactor is the size of the PPLNS window of a block
+	// as a multiple of the difficulty of the block
+	WindowFactor float64
+
+	// PayoutThreshold is the balance, in leor, from which a member is paid
+	PayoutThreshold uint64
+
+	// PayoutInterval is the interval in which matured rewards are
+	// credited and balances are paid
+	PayoutInterval time.Duration
+}
+
+// Pool records the shares and blocks of the members of a pool, credits
+// them with PPLNS shares of the rewards of the blocks once they mature,
+// and pays their balances
+type Pool struct {
+	cfg      *Config
+	client   RPCClient
+	database database.Database
+	store    *store
+	stop     chan struct{}
+
+	keysFile       *keys.File
+	mnemonic       string
+	derivationPath string
+
+	lock sync.Mutex
+}
+
+// New opens the accounting of the pool in cfg.DataDir
+func New(cfg *Config, client RPCClient) (*Pool, error) {
+	keysFile, err := keys.ReadKeysFile(cfg.Params, cfg.KeysFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading the keys file of the pool")
+	}
+	if len(keysFile.ExtendedPublicKeys) != 1 {
+		return nil, errors.New("the keys file of the pool must belong to a single-signer wallet")
+	}
+	password := cfg.KeysPassword
+	if password == "" {
+		password = keys.GetPassword("Password of the keys file of the pool:")
+	}
+	mnemonics, err := keysFile.DecryptMnemonics(password)
+	if err != nil {
+		return nil, err
+	}
+	derivationPath, err := findDerivationPath(cfg.Params, keysFile, cfg.MiningAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := ldb.NewLevelDB(cfg.DataDir, databaseCacheSizeMiB)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Pool{
+		cfg:            cfg,
+		client:         client,
+		database:       db,
+		store:          newStore(db),
+		stop:           make(chan struct{}),
+		keysFile:       keysFile,
+		mnemonic:       mnemonics[0],
+		derivationPath: derivationPath,
+	}, nil
+}
+
+// findDerivationPath returns the derivation path of the given address
+// within the given keys file
+func findDerivationPath(params *dagconfig.Params, keysFile *keys.File, address util.Address) (string, error) {
+	keychains := []struct {
+		keychain      uint32
+		lastUsedIndex uint32
+	}{
+		{libkobrawallet.ExternalKeychain, keysFile.LastUsedExternalIndex()},
+		{libkobrawallet.InternalKeychain, keysFile.LastUsedInternalIndex()},
+	}
+	for _, keychain := range keychains {
+		for index := uint32(0); index <= keychain.lastUsedIndex; index++ {
+			path := fmt.Sprintf("m/%d/%d", keychain.keychain, index)
+			pathAddress, err := libkobrawallet.Address(params, keysFile.ExtendedPublicKeys,
+				keysFile.MinimumSignatures, path, keysFile.ECDSA)
+			if err != nil {
+				return "", err
+			}
+			if pathAddress.String() == address.String() {
+				return path, nil
+			}
+		}
+	}
+	return "", errors.Errorf("the mining address %s is not an address of the keys file of the pool", address)
+}
+
+// Start starts crediting matured rewards and paying balances periodically
+func (p *Pool) Start() {
+	spawn("pool-updateLoop", p.updateLoop)
+}
+
+// Stop stops the pool and closes its database
+func (p *Pool) Stop() error {
+	close(p.stop)
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.database.Close()
+}
+
+// WorkerAddress returns the address of the member the given worker mines
+// for, which is the part of the worker name before the first dot
+func (p *Pool) WorkerAddress(workerName string) (string, error) {
+	addressString := strings.SplitN(workerName, ".", 2)[0]
+	address, err := util.DecodeAddress(addressString, p.cfg.Params.Prefix)
+	if err != nil {
+		return "", errors.Wrapf(err, "worker %s doesn't start with a valid address", workerName)
+	}
+	return address.String(), nil
+}
+
+// RecordShare records a share of the given difficulty that was found by
+// the given worker
+func (p *Pool) RecordShare(workerName string, difficulty float64) error {
+	address, err := p.WorkerAddress(workerName)
+	if err != nil {
+		return err
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	recordedShare, err := p.store.addShare(address, difficulty, time.Now())
+	if err != nil {
+		return err
+	}
+	log.Tracef("Recorded share %d of difficulty %g for %s", recordedShare.Sequence, difficulty, address)
+	return nil
+}
+
+// RecordBlock records the given block, of the given difficulty, that was
+// found by the given worker and submitted to the node. Its reward is split
+// among the shares that preceded it once it matures.
+func (p *Pool) RecordBlock(workerName string, block *externalapi.DomainBlock, blockDifficulty float64) error {
+	address, err := p.WorkerAddress(workerName)
+	if err != nil {
+		return err
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	latestShare, err := p.store.latestShare(p.database)
+	if err != nil {
+		return err
+	}
+	recordedBlock := &foundBlock{
+		Hash:       consensushashing.BlockHash(block).String(),
+		Address:    address,
+		DAAScore:   block.Header.DAAScore(),
+		WindowWork: p.cfg.WindowFactor * blockDifficulty,
+		Status:     blockStatusPending,
+		Timestamp:  time.Now().UnixMilli(),
+	}
+	if latestShare != nil {
+		recordedBlock.ShareSequence = latestShare.Sequence
+	}
+	err = p.store.putBlock(p.database, recordedBlock)
+	if err != nil {
+		return err
+	}
+	log.Infof("Recorded block %s found by %s", recordedBlock.Hash, address)
+	return nil
+}
+
+func (p *Pool) updateLoop() {
+	ticker := time.NewTicker(p.cfg.PayoutInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+			err := p.update()
+			if err != nil {
+				log.Errorf("Error updating the pool accounting: %+v", err)
+			}
+		}
+	}
+}
+
+// update credits the members of the pool with the rewards that matured,
+// pays the balances that reached the payout threshold and prunes old shares
+func (p *Pool) update() error {
+	dagInfo, err := p.client.GetBlockDAGInfo()
+	if err != nil {
+		return err
+	}
+	utxosResponse, err := p.client.GetUTXOsByAddresses([]string{p.cfg.MiningAddress.String()})
+	if err != nil {
+		return errors.Wrap(err, "error getting the UTXOs of the mining address. Is kobrad running with --utxoindex?")
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	err = p.confirmPayouts(utxosResponse.Entries, time.Now())
+	if err != nil {
+		return err
+	}
+	err = p.creditMaturedRewards(utxosResponse.Entries, dagInfo.VirtualDAAScore)
+	if err != nil {
+		return err
+	}
+	err = p.payBalances(utxosResponse.Entries, dagInfo.VirtualDAAScore)
+	if err != nil {
+		return err
+	}
+
+	prunedCount, err := p.store.pruneShares(time.Now().Add(-shareRetention))
+	if err != nil {
+		return err
+	}
+	if prunedCount > 0 {
+		log.Debugf("Pruned %d shares", prunedCount)
+	}
+	return nil
+}
+
+func outpointString(outpoint *appmessage.RPCOutpoint) string {
+	return fmt.Sprintf("%s:%d", outpoint.TransactionID, outpoint.Index)
+}
+
+// isMatured returns whether the given UTXO may be spent at the given virtual DAA score
+func (p *Pool) isMatured(entry *appmessage.UTXOsByAddressesEntry, virtualDAAScore uint64) bool {
+	return !entry.UTXOEntry.IsCoinbase ||
+		entry.UTXOEntry.BlockDAAScore+p.cfg.Params.BlockCoinbaseMaturity <= virtualDAAScore
+}
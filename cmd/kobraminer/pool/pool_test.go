@@ -0,0 +1,454 @@
+package pool
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kobradag/kobrad/app/appmessage"
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/domain/dagconfig"
+	"github.com/kobradag/kobrad/infrastructure/db/database/ldb"
+	"github.com/kobradag/kobrad/infrastructure/network/rpcclient"
+	"github.com/pkg/errors"
+)
+
+func preparePoolForTest(t *testing.T, testName string) (*Pool, func()) {
+	path, err := ioutil.TempDir("", testName)
+	if err != nil {
+		t.Fatalf("TempDir: %s", err)
+	}
+	db, err := ldb.NewLevelDB(path, databaseCacheSizeMiB)
+	if err != nil {
+		t.Fatalf("NewLevelDB: %s", err)
+	}
+	params := dagconfig.SimnetParams
+	testPool := &Pool{
+		cfg: &Config{
+			Params:       &params,
+			WindowFactor: 1,
+		},
+		database: db,
+		store:    newStore(db),
+	}
+	return testPool, func() {
+		err := db.Close()
+		if err != nil {
+			t.Fatalf("Close: %s", err)
+		}
+		err = os.RemoveAll(path)
+		if err != nil {
+			t.Fatalf("RemoveAll: %s", err)
+		}
+	}
+}
+
+func TestPPLNSDistribution(t *testing.T) {
+	shares := []*share{
+		{Address: "a", Difficulty: 1},
+		{Address: "b", Difficulty: 1},
+		{Address: "a", Difficulty: 2},
+	}
+	tests := []struct {
+		name       string
+		shares     []*share
+		windowWork float64
+		reward     uint64
+		expected   map[string]uint64
+	}{
+		{
+			name:       "window covers the shares exactly",
+			shares:     shares[1:],
+			windowWork: 3,
+			reward:     900,
+			expected:   map[string]uint64{"a": 600, "b": 300},
+		},
+		{
+			name:       "oldest share counts partially",
+			shares:     shares,
+			windowWork: 2.5,
+			reward:     1000,
+			expected:   map[string]uint64{"a": 800, "b": 200},
+		},
+		{
+			name:       "window larger than the shares",
+			shares:     shares,
+			windowWork: 100,
+			reward:     1000,
+			expected:   map[string]uint64{"a": 750, "b": 250},
+		},
+		{
+			name: "remainder goes to the address with the most work",
+			shares: []*share{
+				{Address: "c", Difficulty: 1},
+				{Address: "b", Difficulty: 1},
+				{Address: "a", Difficulty: 1},
+			},
+			windowWork: 3,
+			reward:     10,
+			expected:   map[string]uint64{"a": 4, "b": 3, "c": 3},
+		},
+		{
+			name:       "no shares",
+			windowWork: 3,
+			reward:     10,
+			expected:   nil,
+		},
+	}
+	for _, test := range tests {
+		distribution := pplnsDistribution(test.shares, test.windowWork, test.reward)
+		if !reflect.DeepEqual(distribution, test.expected) {
+			t.Errorf("%s: unexpected distribution. Want: %v, got: %v", test.name, test.expected, distribution)
+		}
+	}
+}
+
+func TestWindowShares(t *testing.T) {
+	testPool, teardown := preparePoolForTest(t, "TestWindowShares")
+	defer teardown()
+
+	now := time.Now()
+	for i := 0; i < 10; i++ {
+		_, err := testPool.store.addShare("a", float64(i+1), now.Add(time.Duration(i)*time.Hour))
+		if err != nil {
+			t.Fatalf("addShare: %s", err)
+		}
+	}
+
+	// The window that ends with share 8 and spans 20 work holds
+	// shares 7 and 8 (15 work), with share 6 crossing its edge
+	shares, err := testPool.store.windowShares(testPool.database, 8, 20)
+	if err != nil {
+		t.Fatalf("windowShares: %s", err)
+	}
+	if len(shares) != 3 || shares[0].Sequence != 6 || shares[2].Sequence != 8 {
+		t.Fatalf("Unexpected window shares %+v", shares)
+	}
+
+	prunedCount, err := testPool.store.pruneShares(now.Add(6 * time.Hour))
+	if err != nil {
+		t.Fatalf("pruneShares: %s", err)
+	}
+	if prunedCount != 6 {
+		t.Fatalf("Expected 6 pruned shares but got %d", prunedCount)
+	}
+	shares, err = testPool.store.windowShares(testPool.database, 8, 100)
+	if err != nil {
+		t.Fatalf("windowShares: %s", err)
+	}
+	if len(shares) != 2 || shares[0].Sequence != 7 {
+		t.Fatalf("Unexpected window shares after pruning %+v", shares)
+	}
+}
+
+func coinbaseEntry(transactionID string, amount uint64, blockDAAScore uint64) *appmessage.UTXOsByAddressesEntry {
+	return &appmessage.UTXOsByAddressesEntry{
+		Outpoint: &appmessage.RPCOutpoint{TransactionID: transactionID, Index: 0},
+		UTXOEntry: &appmessage.RPCUTXOEntry{
+			Amount:        amount,
+			BlockDAAScore: blockDAAScore,
+			IsCoinbase:    true,
+		},
+	}
+}
+
+func TestCreditMaturedRewards(t *testing.T) {
+	testPool, teardown := preparePoolForTest(t, "TestCreditMaturedRewards")
+	defer teardown()
+	params := testPool.cfg.Params
+
+	for _, testShare := range []*share{{Address: "a", Difficulty: 1}, {Address: "b", Difficulty: 1}, {Address: "a", Difficulty: 2}} {
+		_, err := testPool.store.addShare(testShare.Address, testShare.Difficulty, time.Now())
+		if err != nil {
+			t.Fatalf("addShare: %s", err)
+		}
+	}
+	blocks := []*foundBlock{
+		{Hash: "rewarded", Address: "b", DAAScore: 10, ShareSequence: 3, WindowWork: 4, Status: blockStatusPending},
+		{Hash: "lost", Address: "b", DAAScore: 20, ShareSequence: 3, WindowWork: 4, Status: blockStatusPending},
+	}
+	for _, block := range blocks {
+		err := testPool.store.putBlock(testPool.database, block)
+		if err != nil {
+			t.Fatalf("putBlock: %s", err)
+		}
+	}
+
+	reward := coinbaseEntry("reward", 1000, 12)
+	unattributedReward := coinbaseEntry("unattributed", 500, 21+params.MergeDepth)
+	entries := []*appmessage.UTXOsByAddressesEntry{reward, unattributedReward}
+
+	// Nothing is credited before the reward matures
+	err := testPool.creditMaturedRewards(entries, 11+params.BlockCoinbaseMaturity)
+	if err != nil {
+		t.Fatalf("creditMaturedRewards: %s", err)
+	}
+	balances, err := testPool.store.balances(testPool.database)
+	if err != nil {
+		t.Fatalf("balances: %s", err)
+	}
+	if len(balances) != 0 {
+		t.Fatalf("Unexpected balances before the reward matured: %v", balances)
+	}
+
+	// Once they mature, the first reward is split among the shares that
+	// preceded the first block, and the second reward, which arrived long
+	// after the second block, is kept by the pool. Crediting again
+	// changes nothing.
+	for i := 0; i < 2; i++ {
+		err = testPool.creditMaturedRewards(entries, unattributedReward.UTXOEntry.BlockDAAScore+params.BlockCoinbaseMaturity)
+		if err != nil {
+			t.Fatalf("creditMaturedRewards: %s", err)
+		}
+		balances, err = testPool.store.balances(testPool.database)
+		if err != nil {
+			t.Fatalf("balances: %s", err)
+		}
+		expectedBalances := map[string]uint64{"a": 750, "b": 250}
+		if !reflect.DeepEqual(balances, expectedBalances) {
+			t.Fatalf("Unexpected balances. Want: %v, got: %v", expectedBalances, balances)
+		}
+	}
+
+	storedBlocks, err := testPool.store.blocks(testPool.database)
+	if err != nil {
+		t.Fatalf("blocks: %s", err)
+	}
+	expectedStatuses := map[string]blockStatus{"rewarded": blockStatusRewarded, "lost": blockStatusLost}
+	for _, block := range storedBlocks {
+		if block.Status != expectedStatuses[block.Hash] {
+			t.Fatalf("Unexpected status of block %s. Want: %s, got: %s",
+				block.Hash, expectedStatuses[block.Hash], block.Status)
+		}
+	}
+}
+
+// fakeRPCClient is an RPCClient whose mempool holds the transactions in
+// mempoolTransactionIDs, and that rejects the transactions in
+// rejectedTransactionIDs
+type fakeRPCClient struct {
+	mempoolTransactionIDs  map[string]struct{}
+	rejectedTransactionIDs map[string]struct{}
+	submittedTransactions  []string
+	onSubmit               func(transactionID string)
+}
+
+func newFakeRPCClient() *fakeRPCClient {
+	return &fakeRPCClient{
+		mempoolTransactionIDs:  make(map[string]struct{}),
+		rejectedTransactionIDs: make(map[string]struct{}),
+	}
+}
+
+func (c *fakeRPCClient) GetBlockDAGInfo() (*appmessage.GetBlockDAGInfoResponseMessage, error) {
+	return &appmessage.GetBlockDAGInfoResponseMessage{}, nil
+}
+
+func (c *fakeRPCClient) GetUTXOsByAddresses(addresses []string) (*appmessage.GetUTXOsByAddressesResponseMessage, error) {
+	return &appmessage.GetUTXOsByAddressesResponseMessage{}, nil
+}
+
+func (c *fakeRPCClient) GetFeeEstimate() (*appmessage.GetFeeEstimateResponseMessage, error) {
+	return nil, errors.New("no fee estimate")
+}
+
+func (c *fakeRPCClient) GetMempoolEntry(txID string, includeOrphanPool bool,
+	filterTransactionPool bool) (*appmessage.GetMempoolEntryResponseMessage, error) {
+
+	if _, ok := c.mempoolTransactionIDs[txID]; !ok {
+		return nil, errors.Wrapf(rpcclient.ErrRPC, "Transaction %s was not found", txID)
+	}
+	return &appmessage.GetMempoolEntryResponseMessage{}, nil
+}
+
+func (c *fakeRPCClient) SubmitTransaction(transaction *appmessage.RPCTransaction, transactionID string,
+	allowOrphan bool) (*appmessage.SubmitTransactionResponseMessage, error) {
+
+	if c.onSubmit != nil {
+		c.onSubmit(transactionID)
+	}
+	if _, ok := c.rejectedTransactionIDs[transactionID]; ok {
+		return nil, errors.Wrapf(rpcclient.ErrRPC, "Rejected transaction %s", transactionID)
+	}
+	c.submittedTransactions = append(c.submittedTransactions, transactionID)
+	c.mempoolTransactionIDs[transactionID] = struct{}{}
+	return &appmessage.SubmitTransactionResponseMessage{TransactionID: transactionID}, nil
+}
+
+func TestConfirmPayouts(t *testing.T) {
+	testPool, teardown := preparePoolForTest(t, "TestConfirmPayouts")
+	defer teardown()
+	client := newFakeRPCClient()
+	testPool.client = client
+
+	now := time.Now()
+	unspentTransactionID := strings.Repeat("11", externalapi.DomainHashSize)
+	newTestPayout := func(transactionID string, amounts map[string]uint64, spentOutpoint string,
+		submissionTime time.Time) *payout {
+
+		return &payout{
+			TransactionID:       transactionID,
+			Amounts:             amounts,
+			Transaction:         &appmessage.RPCTransaction{},
+			SpentOutpoints:      []string{spentOutpoint},
+			Timestamp:           submissionTime.UnixMilli(),
+			SubmissionTimestamp: submissionTime.UnixMilli(),
+		}
+	}
+	confirmedPayout := newTestPayout("confirmed", map[string]uint64{"a": 100}, "spent:0", now.Add(-time.Minute))
+	pendingPayout := newTestPayout("pending", map[string]uint64{"a": 200}, unspentTransactionID+":0", now.Add(-time.Minute))
+	timedOut := now.Add(-2 * payoutConfirmationTimeout)
+	inMempoolPayout := newTestPayout("inMempool", map[string]uint64{"a": 300}, unspentTransactionID+":1", timedOut)
+	droppedPayout := newTestPayout("dropped", map[string]uint64{"b": 400}, unspentTransactionID+":2", timedOut)
+	rejectedPayout := newTestPayout("rejected", map[string]uint64{"a": 500, "b": 600}, unspentTransactionID+":3", timedOut)
+	for _, testPayout := range []*payout{confirmedPayout, pendingPayout, inMempoolPayout, droppedPayout, rejectedPayout} {
+		err := testPool.store.putPayout(testPool.database, testPayout)
+		if err != nil {
+			t.Fatalf("putPayout: %s", err)
+		}
+	}
+	client.mempoolTransactionIDs[inMempoolPayout.TransactionID] = struct{}{}
+	client.rejectedTransactionIDs[rejectedPayout.TransactionID] = struct{}{}
+
+	var entries []*appmessage.UTXOsByAddressesEntry
+	for index := uint32(0); index < 4; index++ {
+		entries = append(entries, &appmessage.UTXOsByAddressesEntry{
+			Outpoint: &appmessage.RPCOutpoint{TransactionID: unspentTransactionID, Index: index},
+			UTXOEntry: &appmessage.RPCUTXOEntry{
+				Amount:          1000,
+				ScriptPublicKey: &appmessage.RPCScriptPublicKey{},
+			},
+		})
+	}
+	err := testPool.confirmPayouts(entries, now)
+	if err != nil {
+		t.Fatalf("confirmPayouts: %s", err)
+	}
+
+	// Only the payout that was dropped from the mempool is submitted
+	// again, and only the one the node rejected is deleted
+	expectedSubmitted := []string{droppedPayout.TransactionID}
+	if !reflect.DeepEqual(client.submittedTransactions, expectedSubmitted) {
+		t.Fatalf("Unexpected submitted transactions. Want: %v, got: %v",
+			expectedSubmitted, client.submittedTransactions)
+	}
+	payouts, err := testPool.store.payouts(testPool.database)
+	if err != nil {
+		t.Fatalf("payouts: %s", err)
+	}
+	if len(payouts) != 4 {
+		t.Fatalf("Expected 4 payouts but got %d", len(payouts))
+	}
+	for _, storedPayout := range payouts {
+		if storedPayout.TransactionID == rejectedPayout.TransactionID {
+			t.Fatalf("Expected the rejected payout to be deleted")
+		}
+		expectedIsConfirmed := storedPayout.TransactionID == confirmedPayout.TransactionID
+		if storedPayout.IsConfirmed != expectedIsConfirmed {
+			t.Fatalf("Unexpected confirmation of payout %s: %t", storedPayout.TransactionID, storedPayout.IsConfirmed)
+		}
+		isTimedOut := storedPayout.TransactionID == inMempoolPayout.TransactionID ||
+			storedPayout.TransactionID == droppedPayout.TransactionID
+		if isTimedOut && storedPayout.SubmissionTimestamp != now.UnixMilli() {
+			t.Fatalf("Expected the submission timestamp of payout %s to be renewed", storedPayout.TransactionID)
+		}
+	}
+
+	// The outpoints of the timed out payouts that weren't rejected stay
+	// reserved
+	spendableUTXOs, err := testPool.spendableUTXOs(entries, 0)
+	if err != nil {
+		t.Fatalf("spendableUTXOs: %s", err)
+	}
+	if len(spendableUTXOs) != 1 || spendableUTXOs[0].Outpoint.Index != 3 {
+		t.Fatalf("Expected only the outpoint of the rejected payout to be spendable, but got %d UTXOs",
+			len(spendableUTXOs))
+	}
+
+	balances, err := testPool.store.balances(testPool.database)
+	if err != nil {
+		t.Fatalf("balances: %s", err)
+	}
+	if !reflect.DeepEqual(balances, rejectedPayout.Amounts) {
+		t.Fatalf("Unexpected balances. Want: %v, got: %v", rejectedPayout.Amounts, balances)
+	}
+}
+
+func TestSubmitPayout(t *testing.T) {
+	testPool, teardown := preparePoolForTest(t, "TestSubmitPayout")
+	defer teardown()
+	client := newFakeRPCClient()
+	testPool.client = client
+
+	initialBalances := map[string]uint64{"a": 1000, "b": 2000}
+	for address, balance := range initialBalances {
+		err := testPool.store.addToBalance(testPool.database, address, balance)
+		if err != nil {
+			t.Fatalf("addToBalance: %s", err)
+		}
+	}
+
+	// The payout must already be stored when its transaction is submitted
+	client.onSubmit = func(transactionID string) {
+		payouts, err := testPool.store.payouts(testPool.database)
+		if err != nil {
+			t.Fatalf("payouts: %s", err)
+		}
+		if len(payouts) != 1 || payouts[0].TransactionID != transactionID {
+			t.Fatalf("Expected payout %s to be stored before it's submitted", transactionID)
+		}
+	}
+
+	acceptedPayout := &payout{
+		TransactionID: "accepted",
+		Amounts:       map[string]uint64{"a": 1000},
+		Transaction:   &appmessage.RPCTransaction{},
+		Timestamp:     1,
+	}
+	err := testPool.submitPayout(acceptedPayout)
+	if err != nil {
+		t.Fatalf("submitPayout: %s", err)
+	}
+	balances, err := testPool.store.balances(testPool.database)
+	if err != nil {
+		t.Fatalf("balances: %s", err)
+	}
+	expectedBalances := map[string]uint64{"b": 2000}
+	if !reflect.DeepEqual(balances, expectedBalances) {
+		t.Fatalf("Unexpected balances. Want: %v, got: %v", expectedBalances, balances)
+	}
+
+	// A payout the node rejects is undone
+	err = testPool.store.deletePayout(testPool.database, acceptedPayout)
+	if err != nil {
+		t.Fatalf("deletePayout: %s", err)
+	}
+	rejectedPayout := &payout{
+		TransactionID: "rejected",
+		Amounts:       map[string]uint64{"b": 2000},
+		Transaction:   &appmessage.RPCTransaction{},
+		Timestamp:     2,
+	}
+	client.rejectedTransactionIDs[rejectedPayout.TransactionID] = struct{}{}
+	err = testPool.submitPayout(rejectedPayout)
+	if !errors.Is(err, rpcclient.ErrRPC) {
+		t.Fatalf("Expected submitPayout to return the rejection, but got: %v", err)
+	}
+	balances, err = testPool.store.balances(testPool.database)
+	if err != nil {
+		t.Fatalf("balances: %s", err)
+	}
+	if !reflect.DeepEqual(balances, expectedBalances) {
+		t.Fatalf("Unexpected balances after the rejection. Want: %v, got: %v", expectedBalances, balances)
+	}
+	payouts, err := testPool.store.payouts(testPool.database)
+	if err != nil {
+		t.Fatalf("payouts: %s", err)
+	}
+	if len(payouts) != 0 {
+		t.Fatalf("Expected the rejected payout to be deleted, but got %d payouts", len(payouts))
+	}
+}
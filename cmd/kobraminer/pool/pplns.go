@@ -0,0 +1,51 @@
+package pool
+
+import (
+	"math"
+	"sort"
+)
+
+// pplnsDistribution splits the given reward among the addresses of the
+// given window shares (ordered from the oldest) in proportion to their
+// work. Only the most recent windowWork of work counts, so the oldest share
+// may count only partially. The rounding remainder goes to the address that
+// did the most work.
+func pplnsDistribution(shares []*share, windowWork float64, reward uint64) map[string]uint64 {
+	work := make(map[string]float64)
+	remainingWindowWork := windowWork
+	for i := len(shares) - 1; i >= 0 && remainingWindowWork > 0; i-- {
+		shareWork := math.Min(shares[i].Difficulty, remainingWindowWork)
+		work[shares[i].Address] += shareWork
+		remainingWindowWork -= shareWork
+	}
+	if len(work) == 0 {
+		return nil
+	}
+
+	addresses := make([]string, 0, len(work))
+	totalWork := 0.0
+	for address, addressWork := range work {
+		addresses = append(addresses, address)
+		totalWork += addressWork
+	}
+	// Sort by work, then by address, so that the distribution is deterministic
+	sort.Slice(addresses, func(i, j int) bool {
+		if work[addresses[i]] != work[addresses[j]] {
+			return work[addresses[i]] > work[addresses[j]]
+		}
+		return addresses[i] < addresses[j]
+	})
+
+	distribution := make(map[string]uint64, len(addresses))
+	distributed := uint64(0)
+	for _, address := range addresses {
+		amount := uint64(math.Floor(float64(reward) * work[address] / totalWork))
+		if distributed+amount > reward {
+			amount = reward - distributed
+		}
+		distribution[address] = amount
+		distributed += amount
+	}
+	distribution[addresses[0]] += reward - distributed
+	return distribution
+}
@@ -0,0 +1,74 @@
+package pool
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/kobradag/kobrad/infrastructure/db/database/ldb"
+)
+
+// WriteReport writes a report of the accounting stored in the given
+// directory: the blocks found by the members of the pool, the balances
+// owed to them and the payouts made to them. It can't be used while a
+// pool that uses the same directory is running.
+func WriteReport(dataDir string, writer io.Writer) error {
+	db, err := ldb.NewLevelDB(dataDir, databaseCacheSizeMiB)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	reportStore := newStore(db)
+
+	blocks, err := reportStore.blocks(db)
+	if err != nil {
+		return err
+	}
+	balances, err := reportStore.balances(db)
+	if err != nil {
+		return err
+	}
+	payouts, err := reportStore.payouts(db)
+	if err != nil {
+		return err
+	}
+
+	tabWriter := tabwriter.NewWriter(writer, 0, 4, 2, ' ', 0)
+
+	fmt.Fprintf(tabWriter, "Blocks (%d):\n", len(blocks))
+	fmt.Fprintf(tabWriter, "Found at\tHash\tFound by\tStatus\tReward (leor)\n")
+	for _, block := range blocks {
+		fmt.Fprintf(tabWriter, "%s\t%s\t%s\t%s\t%d\n", formatTimestamp(block.Timestamp),
+			block.Hash, block.Address, block.Status, block.Reward)
+	}
+
+	addresses := make([]string, 0, len(balances))
+	for address := range balances {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+	fmt.Fprintf(tabWriter, "\nBalances (%d):\n", len(balances))
+	fmt.Fprintf(tabWriter, "Address\tBalance (leor)\n")
+	for _, address := range addresses {
+		fmt.Fprintf(tabWriter, "%s\t%d\n", address, balances[address])
+	}
+
+	fmt.Fprintf(tabWriter, "\nPayouts (%d):\n", len(payouts))
+	fmt.Fprintf(tabWriter, "Submitted at\tTransaction ID\tMembers\tAmount (leor)\tFee (leor)\tConfirmed\n")
+	for _, payout := range payouts {
+		total := uint64(0)
+		for _, amount := range payout.Amounts {
+			total += amount
+		}
+		fmt.Fprintf(tabWriter, "%s\t%s\t%d\t%d\t%d\t%t\n", formatTimestamp(payout.Timestamp),
+			payout.TransactionID, len(payout.Amounts), total, payout.Fee, payout.IsConfirmed)
+	}
+
+	return tabWriter.Flush()
+}
+
+func formatTimestamp(timestamp int64) string {
+	return time.UnixMilli(timestamp).Format(time.RFC3339)
+}
@@ -0,0 +1,350 @@
+package pool
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"time"
+
+	"github.com/kobradag/kobrad/infrastructure/db/database"
+	"github.com/pkg/errors"
+)
+
+var (
+	sharesBucket          = database.MakeBucket([]byte("shares"))
+	blocksBucket          = database.MakeBucket([]byte("blocks"))
+	creditedRewardsBucket = database.MakeBucket([]byte("credited-rewards"))
+	balancesBucket        = database.MakeBucket([]byte("balances"))
+	payoutsBucket         = database.MakeBucket([]byte("payouts"))
+	latestShareKey        = database.MakeBucket([]byte("")).Key([]byte("latest-share"))
+)
+
+// store keeps the accounting of the pool: the shares of its members, the
+// blocks they found, the balances they're owed and the payouts made to them
+type store struct {
+	database database.Database
+}
+
+func newStore(database database.Database) *store {
+	return &store{database: database}
+}
+
+func uint64Key(bucket *database.Bucket, value uint64, suffix []byte) *database.Key {
+	keyBytes := make([]byte, 8, 8+len(suffix))
+	binary.BigEndian.PutUint64(keyBytes, value)
+	return bucket.Key(append(keyBytes, suffix...))
+}
+
+func putJSON(accessor database.DataAccessor, key *database.Key, value interface{}) error {
+	valueBytes, err := json.Marshal(value)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return accessor.Put(key, valueBytes)
+}
+
+func getJSON(accessor database.DataAccessor, key *database.Key, value interface{}) error {
+	valueBytes, err := accessor.Get(key)
+	if err != nil {
+		return err
+	}
+	return errors.WithStack(json.Unmarshal(valueBytes, value))
+}
+
+// forEachJSON calls newValue for every entry of the given bucket in key
+// order, and passes the unmarshalled entry to callback
+func forEachJSON(accessor database.DataAccessor, bucket *database.Bucket,
+	newValue func() interface{}, callback func(key *database.Key, value interface{}) error) error {
+
+	cursor, err := accessor.Cursor(bucket)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+
+	for ok := cursor.First(); ok; ok = cursor.Next() {
+		key, err := cursor.Key()
+		if err != nil {
+			return err
+		}
+		valueBytes, err := cursor.Value()
+		if err != nil {
+			return err
+		}
+		value := newValue()
+		err = json.Unmarshal(valueBytes, value)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		err = callback(key, value)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// latestShare returns the most recent share, or nil if there are none
+func (s *store) latestShare(accessor database.DataAccessor) (*share, error) {
+	latest := &share{}
+	err := getJSON(accessor, latestShareKey, latest)
+	if database.IsNotFoundError(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return latest, nil
+}
+
+func (s *store) addShare(address string, difficulty float64, now time.Time) (*share, error) {
+	dbTx, err := s.database.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer dbTx.RollbackUnlessClosed()
+
+	latest, err := s.latestShare(dbTx)
+	if err != nil {
+		return nil, err
+	}
+	newShare := &share{
+		Sequence:       1,
+		Address:        address,
+		Difficulty:     difficulty,
+		CumulativeWork: difficulty,
+		Timestamp:      now.UnixMilli(),
+	}
+	if latest != nil {
+		newShare.Sequence = latest.Sequence + 1
+		newShare.CumulativeWork += latest.CumulativeWork
+	}
+
+	err = putJSON(dbTx, uint64Key(sharesBucket, newShare.Sequence, nil), newShare)
+	if err != nil {
+		return nil, err
+	}
+	err = putJSON(dbTx, latestShareKey, newShare)
+	if err != nil {
+		return nil, err
+	}
+	return newShare, dbTx.Commit()
+}
+
+func (s *store) share(accessor database.DataAccessor, sequence uint64) (*share, error) {
+	sequenceShare := &share{}
+	err := getJSON(accessor, uint64Key(sharesBucket, sequence, nil), sequenceShare)
+	if err != nil {
+		return nil, err
+	}
+	return sequenceShare, nil
+}
+
+// firstShareSequence returns the sequence of the oldest share that
+// wasn't pruned, or false if there are none
+func (s *store) firstShareSequence(accessor database.DataAccessor) (uint64, bool, error) {
+	cursor, err := accessor.Cursor(sharesBucket)
+	if err != nil {
+		return 0, false, err
+	}
+	defer cursor.Close()
+
+	if !cursor.First() {
+		return 0, false, nil
+	}
+	key, err := cursor.Key()
+	if err != nil {
+		return 0, false, err
+	}
+	return binary.BigEndian.Uint64(key.Suffix()), true, nil
+}
+
+// windowShares returns the shares of the PPLNS window that ends with the
+// share of the given sequence and spans the given work, from the oldest.
+// The oldest share may cross the beginning of the window.
+func (s *store) windowShares(accessor database.DataAccessor, endSequence uint64, windowWork float64) ([]*share, error) {
+	firstSequence, ok, err := s.firstShareSequence(accessor)
+	if err != nil {
+		return nil, err
+	}
+	if !ok || endSequence < firstSequence {
+		return nil, nil
+	}
+	endShare, err := s.share(accessor, endSequence)
+	if err != nil {
+		return nil, err
+	}
+	windowStartWork := endShare.CumulativeWork - windowWork
+
+	// Binary search for the oldest share that ends after the window starts.
+	// The sequences of the shares that weren't pruned are contiguous.
+	low, high := firstSequence, endSequence
+	for low < high {
+		middle := low + (high-low)/2
+		middleShare, err := s.share(accessor, middle)
+		if err != nil {
+			return nil, err
+		}
+		if middleShare.CumulativeWork > windowStartWork {
+			high = middle
+		} else {
+			low = middle + 1
+		}
+	}
+
+	shares := make([]*share, 0, endSequence-low+1)
+	for sequence := low; sequence <= endSequence; sequence++ {
+		windowShare, err := s.share(accessor, sequence)
+		if err != nil {
+			return nil, err
+		}
+		shares = append(shares, windowShare)
+	}
+	return shares, nil
+}
+
+// pruneShares deletes the shares that were recorded before the given time
+func (s *store) pruneShares(before time.Time) (int, error) {
+	dbTx, err := s.database.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer dbTx.RollbackUnlessClosed()
+
+	var toDelete []*database.Key
+	errStop := errors.New("stop")
+	err = forEachJSON(dbTx, sharesBucket, func() interface{} { return &share{} },
+		func(key *database.Key, value interface{}) error {
+			if value.(*share).Timestamp >= before.UnixMilli() {
+				return errStop
+			}
+			// The key is only valid until the cursor moves on
+			toDelete = append(toDelete, sharesBucket.Key(append([]byte(nil), key.Suffix()...)))
+			return nil
+		})
+	if err != nil && !errors.Is(err, errStop) {
+		return 0, err
+	}
+	for _, key := range toDelete {
+		err := dbTx.Delete(key)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return len(toDelete), dbTx.Commit()
+}
+
+func blockKey(block *foundBlock) *database.Key {
+	return uint64Key(blocksBucket, block.ShareSequence, []byte(block.Hash))
+}
+
+func (s *store) putBlock(accessor database.DataAccessor, block *foundBlock) error {
+	return putJSON(accessor, blockKey(block), block)
+}
+
+// blocks returns the found blocks in the order they were found
+func (s *store) blocks(accessor database.DataAccessor) ([]*foundBlock, error) {
+	var blocks []*foundBlock
+	err := forEachJSON(accessor, blocksBucket, func() interface{} { return &foundBlock{} },
+		func(_ *database.Key, value interface{}) error {
+			blocks = append(blocks, value.(*foundBlock))
+			return nil
+		})
+	return blocks, err
+}
+
+func (s *store) isRewardCredited(accessor database.DataAccessor, outpoint string) (bool, error) {
+	return accessor.Has(creditedRewardsBucket.Key([]byte(outpoint)))
+}
+
+// markRewardCredited marks the coinbase output of the given outpoint as
+// credited to the members of the pool for the block of the given hash,
+// which is empty if it couldn't be attributed to any block
+func (s *store) markRewardCredited(accessor database.DataAccessor, outpoint string, blockHash string) error {
+	return accessor.Put(creditedRewardsBucket.Key([]byte(outpoint)), []byte(blockHash))
+}
+
+func (s *store) balance(accessor database.DataAccessor, address string) (uint64, error) {
+	balanceBytes, err := accessor.Get(balancesBucket.Key([]byte(address)))
+	if database.IsNotFoundError(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint64(balanceBytes), nil
+}
+
+func (s *store) setBalance(accessor database.DataAccessor, address string, balance uint64) error {
+	key := balancesBucket.Key([]byte(address))
+	if balance == 0 {
+		return accessor.Delete(key)
+	}
+	balanceBytes := make([]byte, 8)
+	binary.LittleEndian.PutUint64(balanceBytes, balance)
+	return accessor.Put(key, balanceBytes)
+}
+
+func (s *store) addToBalance(accessor database.DataAccessor, address string, amount uint64) error {
+	balance, err := s.balance(accessor, address)
+	if err != nil {
+		return err
+	}
+	return s.setBalance(accessor, address, balance+amount)
+}
+
+func (s *store) subtractFromBalance(accessor database.DataAccessor, address string, amount uint64) error {
+	balance, err := s.balance(accessor, address)
+	if err != nil {
+		return err
+	}
+	if amount > balance {
+		return errors.Errorf("cannot subtract %d from the balance %d of %s", amount, balance, address)
+	}
+	return s.setBalance(accessor, address, balance-amount)
+}
+
+// balances returns the balances owed to the members of the pool
+func (s *store) balances(accessor database.DataAccessor) (map[string]uint64, error) {
+	cursor, err := accessor.Cursor(balancesBucket)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	balances := make(map[string]uint64)
+	for ok := cursor.First(); ok; ok = cursor.Next() {
+		key, err := cursor.Key()
+		if err != nil {
+			return nil, err
+		}
+		balanceBytes, err := cursor.Value()
+		if err != nil {
+			return nil, err
+		}
+		balances[string(key.Suffix())] = binary.LittleEndian.Uint64(balanceBytes)
+	}
+	return balances, nil
+}
+
+func payoutKey(payout *payout) *database.Key {
+	return uint64Key(payoutsBucket, uint64(payout.Timestamp), []byte(payout.TransactionID))
+}
+
+func (s *store) putPayout(accessor database.DataAccessor, payout *payout) error {
+	return putJSON(accessor, payoutKey(payout), payout)
+}
+
+func (s *store) deletePayout(accessor database.DataAccessor, payout *payout) error {
+	return accessor.Delete(payoutKey(payout))
+}
+
+// payouts returns the payouts in the order they were made
+func (s *store) payouts(accessor database.DataAccessor) ([]*payout, error) {
+	var payouts []*payout
+	err := forEachJSON(accessor, payoutsBucket, func() interface{} { return &payout{} },
+		func(_ *database.Key, value interface{}) error {
+			payouts = append(payouts, value.(*payout))
+			return nil
+		})
+	return payouts, err
+}
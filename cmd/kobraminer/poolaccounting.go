@@ -0,0 +1,114 @@
+package main
+
+import (
+	"math/big"
+	"os"
+
+	"github.com/kobradag/kobrad/cmd/kobraminer/pool"
+	"github.com/kobradag/kobrad/cmd/kobraminer/stratum"
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/domain/consensus/utils/consensushashing"
+	"github.com/kobradag/kobrad/infrastructure/logger"
+	"github.com/kobradag/kobrad/util"
+	"github.com/kobradag/kobrad/util/difficulty"
+)
+
+// relativeShareDifficultyShift is the binary logarithm of the ratio between
+// the difficulty of a block and the default difficulty of a CPU share
+const relativeShareDifficultyShift = 6
+
+// newPool opens the pool accounting if it's enabled, or returns nil otherwise
+func newPool(cfg *configFlags, client *minerClient, miningAddr util.Address) (*pool.Pool, error) {
+	if !cfg.Pool {
+		return nil, nil
+	}
+	minerPool, err := pool.New(&pool.Config{
+		DataDir:         cfg.PoolDir,
+		Params:          cfg.NetParams(),
+		MiningAddress:   miningAddr,
+		KeysFile:        cfg.PoolKeysFile,
+		KeysPassword:    cfg.PoolPassword,
+		WindowFactor:    cfg.PoolWindowFactor,
+		PayoutThreshold: cfg.PoolPayoutThreshold,
+		PayoutInterval:  cfg.PoolPayoutInterval,
+	}, client)
+	if err != nil {
+		return nil, err
+	}
+	minerPool.SetLogger(backendLog, logger.LevelDebug)
+	minerPool.Start()
+	return minerPool, nil
+}
+
+// printPoolReport prints the accounting of the pool
+func printPoolReport(cfg *configFlags) error {
+	return pool.WriteReport(cfg.PoolDir, os.Stdout)
+}
+
+// recordPoolBlock records a block that was found by the given worker
+// and submitted to the node in the pool accounting
+func recordPoolBlock(minerPool *pool.Pool, workerName string, block *externalapi.DomainBlock) {
+	blockDifficulty := stratum.TargetToDifficulty(difficulty.CompactToBig(block.Header.Bits()))
+	err := minerPool.RecordBlock(workerName, block, blockDifficulty)
+	if err != nil {
+		log.Warnf("Error recording block %s in the pool accounting: %s", consensushashing.BlockHash(block), err)
+	}
+}
+
+// recordPoolShare records a share of the given difficulty that was found
+// by the given worker in the pool accounting
+func recordPoolShare(minerPool *pool.Pool, workerName string, shareDifficulty float64) {
+	err := minerPool.RecordShare(workerName, shareDifficulty)
+	if err != nil {
+		log.Warnf("Error recording a share of %s in the pool accounting: %s", workerName, err)
+	}
+}
+
+// cpuPoolAccounting records the shares and blocks of the CPU miner
+// in the pool accounting, on behalf of a single pool member
+type cpuPoolAccounting struct {
+	pool          *pool.Pool
+	workerAddress string
+
+	// fixedShareTarget is the target of the shares if their difficulty
+	// is fixed, or nil if it's relative to the difficulty of the block
+	fixedShareTarget     *big.Int
+	fixedShareDifficulty float64
+}
+
+func newCPUPoolAccounting(cfg *configFlags, minerPool *pool.Pool) *cpuPoolAccounting {
+	if minerPool == nil {
+		return nil
+	}
+	accounting := &cpuPoolAccounting{
+		pool:          minerPool,
+		workerAddress: cfg.PoolWorkerAddress,
+	}
+	if cfg.PoolShareDifficulty != 0 {
+		accounting.fixedShareTarget = stratum.DifficultyToTarget(cfg.PoolShareDifficulty)
+		accounting.fixedShareDifficulty = cfg.PoolShareDifficulty
+	}
+	return accounting
+}
+
+// handleHash records a share if the given proof of work value, of a header
+// with the given target, meets the share target or solves the block
+func (a *cpuPoolAccounting) handleHash(powValue *big.Int, target *big.Int) {
+	shareTarget := a.fixedShareTarget
+	if shareTarget == nil {
+		shareTarget = new(big.Int).Lsh(target, relativeShareDifficultyShift)
+	}
+	if powValue.Cmp(shareTarget) > 0 && powValue.Cmp(target) > 0 {
+		return
+	}
+
+	shareDifficulty := a.fixedShareDifficulty
+	if a.fixedShareTarget == nil {
+		shareDifficulty = stratum.TargetToDifficulty(shareTarget)
+	}
+	recordPoolShare(a.pool, a.workerAddress, shareDifficulty)
+}
+
+func (a *cpuPoolAccounting) handleFoundBlock(block *externalapi.DomainBlock) {
+	recordPoolBlock(a.pool, a.workerAddress, block)
+}
@@ -0,0 +1,98 @@
+package stratum
+
+import (
+	"math"
+	"math/big"
+	"time"
+)
+
+// diff1Target is the target of shares of difficulty 1, which take
+// 2^32 hashes on average to find
+var diff1Target = new(big.Int).Lsh(big.NewInt(1), 224)
+
+// hashesPerDifficulty is the average number of hashes it takes to find
+// a share of difficulty 1
+const hashesPerDifficulty = 1 << 32
+
+// DifficultyToTarget returns the target that hashes of shares of the
+// given difficulty must not exceed
+func DifficultyToTarget(difficulty float64) *big.Int {
+	target, _ := new(big.Float).Quo(new(big.Float).SetInt(diff1Target), big.NewFloat(difficulty)).Int(nil)
+	return target
+}
+
+// TargetToDifficulty returns the difficulty of shares whose
+// hashes don't exceed the given target
+func TargetToDifficulty(target *big.Int) float64 {
+	difficulty, _ := new(big.Float).Quo(new(big.Float).SetInt(diff1Target), new(big.Float).SetInt(target)).Float64()
+	return difficulty
+}
+
+const (
+	// varDiffRetargetInterval is the minimal time between changes
+	// of the difficulty of a session
+	varDiffRetargetInterval = time.Minute
+
+	// maxVarDiffChange is the maximal factor by which a single
+	// retarget may change the difficulty
+	maxVarDiffChange = 4
+
+	// varDiffTolerance is the deviation from the target share rate
+	// that doesn't cause a retarget, so that the difficulty doesn't
+	// flap due to the randomness of mining
+	varDiffTolerance = 0.25
+)
+
+// varDiff adjusts the difficulty of the shares of a session so that it
+// submits about sharesPerMinute of them. If sharesPerMinute is 0 the
+// difficulty is fixed.
+type varDiff struct {
+	difficulty      float64
+	minDifficulty   float64
+	sharesPerMinute float64
+
+	windowStart time.Time
+	shareCount  int
+}
+
+func newVarDiff(difficulty float64, minDifficulty float64, sharesPerMinute float64, now time.Time) *varDiff {
+	return &varDiff{
+		difficulty:      difficulty,
+		minDifficulty:   minDifficulty,
+		sharesPerMinute: sharesPerMinute,
+		windowStart:     now,
+	}
+}
+
+// addShare records a share that was accepted at the current difficulty
+func (vd *varDiff) addShare() {
+	vd.shareCount++
+}
+
+// retarget returns the new difficulty if enough time has passed since
+// the last retarget and the share rate strayed from the target, or
+// false if the difficulty stays as is
+func (vd *varDiff) retarget(now time.Time) (float64, bool) {
+	if vd.sharesPerMinute == 0 {
+		return 0, false
+	}
+	elapsed := now.Sub(vd.windowStart)
+	if elapsed < varDiffRetargetInterval {
+		return 0, false
+	}
+
+	ratio := float64(vd.shareCount) / elapsed.Minutes() / vd.sharesPerMinute
+	vd.windowStart = now
+	vd.shareCount = 0
+	if math.Abs(ratio-1) <= varDiffTolerance {
+		return 0, false
+	}
+
+	ratio = math.Max(math.Min(ratio, maxVarDiffChange), 1.0/maxVarDiffChange)
+	difficulty := math.Max(vd.difficulty*ratio, vd.minDifficulty)
+	if difficulty == vd.difficulty {
+		return 0, false
+	}
+	vd.difficulty = difficulty
+	return difficulty, true
+}
@@ -0,0 +1,94 @@
+package stratum
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/domain/consensus/utils/pow"
+)
+
+// maxJobs is the number of most recent jobs that shares are accepted for.
+// Shares of older jobs are rejected as stale.
+const maxJobs = 64
+
+// job is a block template handed to miners
+type job struct {
+	id        string
+	block     *externalapi.DomainBlock
+	state     *pow.State
+	createdAt time.Time
+
+	// nonces are the nonces of the shares submitted for this job,
+	// used to reject duplicates
+	nonces map[uint64]struct{}
+}
+
+// params returns the parameters of the mining.notify notification of the
+// job. Most miners take the pre-PoW hash as four little endian words, while
+// some take it along with the timestamp as a single hex string.
+func (j *job) params(isBigJob bool) []interface{} {
+	prePowHash := j.state.PrePowHash().ByteSlice()
+	if isBigJob {
+		header := make([]byte, len(prePowHash)+8)
+		copy(header, prePowHash)
+		binary.LittleEndian.PutUint64(header[len(prePowHash):], uint64(j.state.Timestamp))
+		return []interface{}{j.id, hex.EncodeToString(header)}
+	}
+
+	words := make([]uint64, len(prePowHash)/8)
+	for i := range words {
+		words[i] = binary.LittleEndian.Uint64(prePowHash[i*8:])
+	}
+	return []interface{}{j.id, words, j.state.Timestamp}
+}
+
+// jobStore keeps the most recent jobs
+type jobStore struct {
+	jobs   map[string]*job
+	order  []string
+	nextID uint64
+}
+
+func newJobStore() *jobStore {
+	return &jobStore{
+		jobs:  make(map[string]*job),
+		order: make([]string, 0, maxJobs),
+	}
+}
+
+// add creates a job for the given template and evicts the oldest
+// job if there are too many
+func (js *jobStore) add(block *externalapi.DomainBlock, state *pow.State, now time.Time) *job {
+	js.nextID++
+	newJob := &job{
+		id:        strconv.FormatUint(js.nextID, 10),
+		block:     block,
+		state:     state,
+		createdAt: now,
+		nonces:    make(map[uint64]struct{}),
+	}
+
+	if len(js.order) == maxJobs {
+		delete(js.jobs, js.order[0])
+		js.order = js.order[1:]
+	}
+	js.jobs[newJob.id] = newJob
+	js.order = append(js.order, newJob.id)
+	return newJob
+}
+
+func (js *jobStore) get(id string) (*job, bool) {
+	j, ok := js.jobs[id]
+	return j, ok
+}
+
+// latest returns the most recent job, or nil if there are none
+func (js *jobStore) latest() *job {
+	if len(js.order) == 0 {
+		return nil
+	}
+	return js.jobs[js.order[len(js.order)-1]]
+}
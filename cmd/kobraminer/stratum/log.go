@@ -0,0 +1,18 @@
+package stratum
+
+import (
+	"github.com/kobradag/kobrad/infrastructure/logger"
+	"github.com/kobradag/kobrad/util/panics"
+)
+
+const logSubsystem = "STRM"
+
+var log = logger.RegisterSubSystem(logSubsystem)
+var spawn = panics.GoroutineWrapperFunc(log)
+
+// SetLogger uses a specified Logger to output package logging info
+func (s *Server) SetLogger(backend *logger.Backend, level logger.Level) {
+	log = backend.Logger(logSubsystem)
+	log.SetLevel(level)
+	spawn = panics.GoroutineWrapperFunc(log)
+}
@@ -0,0 +1,73 @@
+package stratum
+
+import (
+	"encoding/json"
+)
+
+// The methods of the stratum protocol, as spoken by the miners of
+// DAG-based coins (the EthereumStratum/1.0.0 flavor, with an extranonce
+// per connection and jobs that carry the pre-PoW hash of the header)
+const (
+	methodSubscribe           = "mining.subscribe"
+	methodExtranonceSubscribe = "mining.extranonce.subscribe"
+	methodAuthorize           = "mining.authorize"
+	methodSubmit              = "mining.submit"
+	methodSetExtranonce       = "mining.set_extranonce"
+	methodSetDifficulty       = "mining.set_difficulty"
+	methodNotify              = "mining.notify"
+
+	protocolVersion = "EthereumStratum/1.0.0"
+)
+
+// The error codes that are customary in stratum responses
+const (
+	errorCodeOther             = 20
+	errorCodeJobNotFound       = 21
+	errorCodeDuplicateShare    = 22
+	errorCodeLowDifficulty     = 23
+	errorCodeUnauthorized      = 24
+	errorCodeNotSubscribed     = 25
+	errorCodeMalformedArgument = 26
+)
+
+// request is a stratum request sent by a miner
+type request struct {
+	ID     json.RawMessage   `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+}
+
+// response is the response to a stratum request
+type response struct {
+	ID     json.RawMessage `json:"id"`
+	Result interface{}     `json:"result"`
+	Error  *responseError  `json:"error"`
+}
+
+// notification is a stratum request sent to a miner, which
+// expects no response
+type notification struct {
+	ID     interface{}   `json:"id"`
+	Method string        `json:"method"`
+	Params []interface{} `json:"params"`
+}
+
+// responseError is a stratum error. It's marshalled as the customary
+// [code, message, traceback] triplet.
+type responseError struct {
+	Code    int
+	Message string
+}
+
+func newResponseError(code int, message string) *responseError {
+	return &responseError{Code: code, Message: message}
+}
+
+// MarshalJSON implements json.Marshaler
+func (e *responseError) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]interface{}{e.Code, e.Message, nil})
+}
+
+func (e *responseError) Error() string {
+	return e.Message
+}
@@ -0,0 +1,281 @@
+package stratum
+
+import (
+	"net"
+	"sync"
+	"time"
+
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/domain/consensus/utils/pow"
+	"github.com/pkg/errors"
+)
+
+const (
+	// retargetCheckInterval is the interval in which the
+	// difficulties of the sessions are reconsidered
+	retargetCheckInterval = 10 * time.Second
+
+	// statsLogInterval is the interval in which the share
+	// statistics of the workers are logged
+	statsLogInterval = time.Minute
+)
+
+// Config is the configuration of a stratum server
+type Config struct {
+	// ListenAddress is the address the server listens on for miners
+	ListenAddress string
+
+	// Difficulty is the initial difficulty of the shares of every session
+	Difficulty float64
+
+	// MinDifficulty is the lowest difficulty variable difficulty may set
+	MinDifficulty float64
+
+	// SharesPerMinute is the rate of shares variable difficulty aims
+	// for. Variable difficulty is disabled if it's 0.
+	SharesPerMinute float64
+
+	// ShareHandler, if it's not nil, is called for every accepted share
+	ShareHandler ShareHandler
+}
+
+// BlockSubmitter submits a block that was solved by the given worker
+type BlockSubmitter func(workerName string, block *externalapi.DomainBlock) error
+
+// ShareHandler handles a share of the given difficulty that was
+// accepted from the given worker
+type ShareHandler func(workerName string, difficulty float64)
+
+// Server is a stratum server that hands block templates to external miners
+// as jobs, validates the shares they submit and submits the blocks they solve
+type Server struct {
+	cfg         *Config
+	submitBlock BlockSubmitter
+	listener    net.Listener
+	stop        chan struct{}
+
+	lock           sync.Mutex
+	jobs           *jobStore
+	sessions       map[*session]struct{}
+	nextExtranonce uint16
+	accounting     *shareAccounting
+}
+
+// NewServer creates a new stratum server
+func NewServer(cfg *Config, submitBlock BlockSubmitter) *Server {
+	return &Server{
+		cfg:         cfg,
+		submitBlock: submitBlock,
+		stop:        make(chan struct{}),
+		jobs:        newJobStore(),
+		sessions:    make(map[*session]struct{}),
+		accounting:  newShareAccounting(time.Now()),
+	}
+}
+
+// Start starts listening for miners
+func (s *Server) Start() error {
+	listener, err := net.Listen("tcp", s.cfg.ListenAddress)
+	if err != nil {
+		return errors.Wrapf(err, "error listening on %s", s.cfg.ListenAddress)
+	}
+	s.listener = listener
+	log.Infof("Stratum server listening on %s", listener.Addr())
+
+	spawn("stratum-acceptLoop", s.acceptLoop)
+	spawn("stratum-housekeepingLoop", s.housekeepingLoop)
+	return nil
+}
+
+// Stop stops listening for miners and disconnects all of them
+func (s *Server) Stop() error {
+	close(s.stop)
+	err := s.listener.Close()
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for session := range s.sessions {
+		session.close()
+	}
+	return err
+}
+
+// Address returns the address the server listens on
+func (s *Server) Address() net.Addr {
+	return s.listener.Addr()
+}
+
+// Stats returns the share statistics of every worker
+func (s *Server) Stats() map[string]WorkerStats {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.accounting.snapshot()
+}
+
+// NotifyNewTemplate hands the given block template to the miners as a
+// new job, unless it doesn't differ from the latest job in anything but
+// its timestamp
+func (s *Server) NotifyNewTemplate(block *externalapi.DomainBlock, state *pow.State) {
+	s.lock.Lock()
+	latestJob := s.jobs.latest()
+	if latestJob != nil && latestJob.state.PrePowHash().Equal(state.PrePowHash()) {
+		s.lock.Unlock()
+		return
+	}
+	newJob := s.jobs.add(block, state, time.Now())
+	sessions := s.sessionsLocked()
+	s.lock.Unlock()
+
+	log.Debugf("Sending job %s with pre-PoW hash %s to %d sessions", newJob.id, state.PrePowHash(), len(sessions))
+	for _, session := range sessions {
+		session.notifyJob(newJob)
+	}
+}
+
+func (s *Server) acceptLoop() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			select {
+			case <-s.stop:
+				return
+			default:
+			}
+			log.Errorf("Error accepting a stratum connection: %s", err)
+			continue
+		}
+
+		s.lock.Lock()
+		session := newSession(s, conn, s.nextExtranonce)
+		s.nextExtranonce++
+		s.sessions[session] = struct{}{}
+		s.lock.Unlock()
+
+		log.Infof("Miner %s connected", conn.RemoteAddr())
+		spawn("stratum-session", session.run)
+	}
+}
+
+func (s *Server) removeSession(session *session) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	delete(s.sessions, session)
+}
+
+func (s *Server) sessionsLocked() []*session {
+	sessions := make([]*session, 0, len(s.sessions))
+	for session := range s.sessions {
+		sessions = append(sessions, session)
+	}
+	return sessions
+}
+
+func (s *Server) latestJob() *job {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.jobs.latest()
+}
+
+func (s *Server) housekeepingLoop() {
+	retargetTicker := time.NewTicker(retargetCheckInterval)
+	defer retargetTicker.Stop()
+	statsTicker := time.NewTicker(statsLogInterval)
+	defer statsTicker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case now := <-retargetTicker.C:
+			s.lock.Lock()
+			sessions := s.sessionsLocked()
+			s.lock.Unlock()
+			for _, session := range sessions {
+				session.retarget(now)
+			}
+		case now := <-statsTicker.C:
+			s.lock.Lock()
+			s.accounting.logAndResetWindow(now)
+			s.lock.Unlock()
+		}
+	}
+}
+
+// shareResult is the outcome of the validation of a share
+type shareResult int
+
+const (
+	shareAccepted shareResult = iota
+	shareStale
+	shareDuplicate
+	shareLowDifficulty
+)
+
+// validateShare validates a share of the given difficulty that was
+// submitted by the given worker for the given job, accounts for it, and
+// submits the block it solves if it does
+func (s *Server) validateShare(workerName string, jobID string, nonce uint64, difficulty float64) shareResult {
+	s.lock.Lock()
+	shareJob, ok := s.jobs.get(jobID)
+	if !ok {
+		s.accounting.addStaleShare(workerName)
+		s.lock.Unlock()
+		return shareStale
+	}
+	if _, ok := shareJob.nonces[nonce]; ok {
+		s.accounting.addInvalidShare(workerName)
+		s.lock.Unlock()
+		return shareDuplicate
+	}
+	shareJob.nonces[nonce] = struct{}{}
+	s.lock.Unlock()
+
+	state := *shareJob.state
+	state.Nonce = nonce
+	powValue := state.CalculateProofOfWorkValue()
+
+	solvesBlock := powValue.Cmp(&state.Target) <= 0
+	if solvesBlock {
+		s.handleSolvedBlock(workerName, shareJob, nonce)
+	}
+
+	// A share that solves a block is accepted even if the network
+	// difficulty happens to be lower than the share difficulty
+	isLowDifficulty := !solvesBlock && powValue.Cmp(DifficultyToTarget(difficulty)) > 0
+
+	s.lock.Lock()
+	if isLowDifficulty {
+		s.accounting.addInvalidShare(workerName)
+		s.lock.Unlock()
+		return shareLowDifficulty
+	}
+	s.accounting.addAcceptedShare(workerName, difficulty)
+	s.lock.Unlock()
+
+	if s.cfg.ShareHandler != nil {
+		s.cfg.ShareHandler(workerName, difficulty)
+	}
+	return shareAccepted
+}
+
+func (s *Server) handleSolvedBlock(workerName string, solvedJob *job, nonce uint64) {
+	header := solvedJob.block.Header.ToMutable()
+	header.SetNonce(nonce)
+	block := &externalapi.DomainBlock{
+		Header:       header.ToImmutable(),
+		Transactions: solvedJob.block.Transactions,
+	}
+
+	s.lock.Lock()
+	s.accounting.addBlock(workerName)
+	s.lock.Unlock()
+
+	log.Infof("Worker %s solved a block with job %s", workerName, solvedJob.id)
+	err := s.submitBlock(workerName, block)
+	if err != nil {
+		log.Warnf("Error submitting the block solved by worker %s: %s", workerName, err)
+	}
+}
@@ -0,0 +1,258 @@
+package stratum
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/domain/consensus/utils/blockheader"
+	"github.com/kobradag/kobrad/domain/consensus/utils/pow"
+)
+
+func TestDifficultyToTarget(t *testing.T) {
+	if DifficultyToTarget(1).Cmp(diff1Target) != 0 {
+		t.Fatalf("Unexpected target of difficulty 1: %x", DifficultyToTarget(1))
+	}
+	expectedTarget := new(big.Int).Rsh(diff1Target, 4)
+	if DifficultyToTarget(16).Cmp(expectedTarget) != 0 {
+		t.Fatalf("Unexpected target of difficulty 16. Want: %x, got: %x", expectedTarget, DifficultyToTarget(16))
+	}
+	for _, difficulty := range []float64{0.5, 1, 16, 1000} {
+		roundTrip := TargetToDifficulty(DifficultyToTarget(difficulty))
+		if roundTrip != difficulty {
+			t.Fatalf("Difficulty %g became %g after a round trip", difficulty, roundTrip)
+		}
+	}
+}
+
+func TestVarDiff(t *testing.T) {
+	start := time.Now()
+	vd := newVarDiff(8, 1, 10, start)
+
+	// Retargets aren't considered before the retarget interval passes
+	for i := 0; i < 100; i++ {
+		vd.addShare()
+	}
+	if _, ok := vd.retarget(start.Add(varDiffRetargetInterval / 2)); ok {
+		t.Fatalf("Unexpected retarget before the retarget interval passed")
+	}
+
+	// Too many shares raise the difficulty, by no more than maxVarDiffChange
+	difficulty, ok := vd.retarget(start.Add(varDiffRetargetInterval))
+	if !ok || difficulty != 8*maxVarDiffChange {
+		t.Fatalf("Unexpected retarget after too many shares: %g, %t", difficulty, ok)
+	}
+
+	// A share rate within the tolerance keeps the difficulty
+	for i := 0; i < 11; i++ {
+		vd.addShare()
+	}
+	if _, ok := vd.retarget(start.Add(2 * varDiffRetargetInterval)); ok {
+		t.Fatalf("Unexpected retarget of a share rate within the tolerance")
+	}
+
+	// Too few shares lower the difficulty, but not below the minimum
+	vd.addShare()
+	difficulty, ok = vd.retarget(start.Add(3 * varDiffRetargetInterval))
+	if !ok || difficulty != 32.0/maxVarDiffChange {
+		t.Fatalf("Unexpected retarget after too few shares: %g, %t", difficulty, ok)
+	}
+	difficulty, ok = vd.retarget(start.Add(4 * varDiffRetargetInterval))
+	if !ok || difficulty != 2 {
+		t.Fatalf("Unexpected retarget after no shares: %g, %t", difficulty, ok)
+	}
+	difficulty, ok = vd.retarget(start.Add(5 * varDiffRetargetInterval))
+	if !ok || difficulty != 1 {
+		t.Fatalf("Unexpected retarget towards the minimum difficulty: %g, %t", difficulty, ok)
+	}
+	if _, ok := vd.retarget(start.Add(6 * varDiffRetargetInterval)); ok {
+		t.Fatalf("Unexpected retarget below the minimum difficulty")
+	}
+
+	// Variable difficulty is disabled if there's no target share rate
+	staticVarDiff := newVarDiff(8, 8, 0, start)
+	if _, ok := staticVarDiff.retarget(start.Add(time.Hour)); ok {
+		t.Fatalf("Unexpected retarget of a static difficulty")
+	}
+}
+
+type testMiner struct {
+	t       *testing.T
+	conn    net.Conn
+	scanner *bufio.Scanner
+	nextID  int
+}
+
+func (tm *testMiner) request(method string, params ...interface{}) *response {
+	tm.nextID++
+	requestBytes, err := json.Marshal(map[string]interface{}{"id": tm.nextID, "method": method, "params": params})
+	if err != nil {
+		tm.t.Fatalf("json.Marshal: %s", err)
+	}
+	_, err = tm.conn.Write(append(requestBytes, '\n'))
+	if err != nil {
+		tm.t.Fatalf("Write: %s", err)
+	}
+
+	for {
+		message := tm.read()
+		if _, ok := message["method"]; ok {
+			continue
+		}
+		var resp struct {
+			ID     int             `json:"id"`
+			Result interface{}     `json:"result"`
+			Error  json.RawMessage `json:"error"`
+		}
+		tm.unmarshal(message, &resp)
+		if resp.ID != tm.nextID {
+			tm.t.Fatalf("Unexpected response ID. Want: %d, got: %d", tm.nextID, resp.ID)
+		}
+		result := &response{Result: resp.Result}
+		if string(resp.Error) != "null" {
+			var errorTriplet []interface{}
+			err := json.Unmarshal(resp.Error, &errorTriplet)
+			if err != nil || len(errorTriplet) != 3 {
+				tm.t.Fatalf("Malformed error %s", resp.Error)
+			}
+			result.Error = newResponseError(int(errorTriplet[0].(float64)), errorTriplet[1].(string))
+		}
+		return result
+	}
+}
+
+// readNotification skips messages until it reads a notification of the given method
+func (tm *testMiner) readNotification(method string) []json.RawMessage {
+	for {
+		message := tm.read()
+		if string(message["method"]) != fmt.Sprintf("%q", method) {
+			continue
+		}
+		var params []json.RawMessage
+		tm.unmarshal(message, &struct {
+			Params *[]json.RawMessage `json:"params"`
+		}{&params})
+		return params
+	}
+}
+
+func (tm *testMiner) read() map[string]json.RawMessage {
+	err := tm.conn.SetReadDeadline(time.Now().Add(10 * time.Second))
+	if err != nil {
+		tm.t.Fatalf("SetReadDeadline: %s", err)
+	}
+	if !tm.scanner.Scan() {
+		tm.t.Fatalf("Error reading from the server: %v", tm.scanner.Err())
+	}
+	var message map[string]json.RawMessage
+	err = json.Unmarshal(tm.scanner.Bytes(), &message)
+	if err != nil {
+		tm.t.Fatalf("Malformed message %s: %s", tm.scanner.Bytes(), err)
+	}
+	return message
+}
+
+func (tm *testMiner) unmarshal(message map[string]json.RawMessage, v interface{}) {
+	messageBytes, err := json.Marshal(message)
+	if err != nil {
+		tm.t.Fatalf("json.Marshal: %s", err)
+	}
+	err = json.Unmarshal(messageBytes, v)
+	if err != nil {
+		tm.t.Fatalf("json.Unmarshal: %s", err)
+	}
+}
+
+func TestServer(t *testing.T) {
+	submittedBlocks := make(chan *externalapi.DomainBlock, maxJobs)
+	server := NewServer(&Config{
+		ListenAddress: "127.0.0.1:0",
+		Difficulty:    1e-9,
+		MinDifficulty: 1e-9,
+	}, func(_ string, block *externalapi.DomainBlock) error {
+		submittedBlocks <- block
+		return nil
+	})
+	err := server.Start()
+	if err != nil {
+		t.Fatalf("Start: %s", err)
+	}
+	defer server.Stop()
+
+	// A template whose target is so easy that practically every nonce solves it
+	header := blockheader.NewImmutableBlockHeader(1, nil, &externalapi.DomainHash{}, &externalapi.DomainHash{},
+		&externalapi.DomainHash{}, 1234, 0x2100ffff, 0, 1, 1, big.NewInt(1), &externalapi.DomainHash{})
+	block := &externalapi.DomainBlock{Header: header}
+	server.NotifyNewTemplate(block, pow.NewState(header.ToMutable()))
+
+	conn, err := net.Dial("tcp", server.Address().String())
+	if err != nil {
+		t.Fatalf("Dial: %s", err)
+	}
+	defer conn.Close()
+	miner := &testMiner{t: t, conn: conn, scanner: bufio.NewScanner(conn)}
+
+	resp := miner.request(methodSubmit, "worker", "1", "0")
+	if resp.Error == nil || resp.Error.Code != errorCodeUnauthorized {
+		t.Fatalf("Expected an unauthorized error, got %+v", resp)
+	}
+
+	resp = miner.request(methodSubscribe, "TestMiner/1.0")
+	if resp.Error != nil {
+		t.Fatalf("Subscribe: %s", resp.Error)
+	}
+	resp = miner.request(methodAuthorize, "kobra:address.worker", "x")
+	if resp.Error != nil || resp.Result != true {
+		t.Fatalf("Authorize: %+v", resp)
+	}
+
+	extranonceParams := miner.readNotification(methodSetExtranonce)
+	var extranonce string
+	err = json.Unmarshal(extranonceParams[0], &extranonce)
+	if err != nil || len(extranonce) != extranonceSize*2 {
+		t.Fatalf("Malformed extranonce %s", extranonceParams[0])
+	}
+	notifyParams := miner.readNotification(methodNotify)
+	var jobID string
+	err = json.Unmarshal(notifyParams[0], &jobID)
+	if err != nil {
+		t.Fatalf("Malformed job ID %s", notifyParams[0])
+	}
+
+	resp = miner.request(methodSubmit, "kobra:address.worker", jobID, "000000000001")
+	if resp.Error != nil || resp.Result != true {
+		t.Fatalf("Submit: %+v", resp)
+	}
+	select {
+	case submittedBlock := <-submittedBlocks:
+		expectedNonce, err := strconv.ParseUint(extranonce+"000000000001", 16, 64)
+		if err != nil {
+			t.Fatalf("ParseUint: %s", err)
+		}
+		if submittedBlock.Header.Nonce() != expectedNonce {
+			t.Fatalf("Unexpected nonce. Want: %x, got: %x", expectedNonce, submittedBlock.Header.Nonce())
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatalf("Timed out waiting for the block to be submitted")
+	}
+
+	resp = miner.request(methodSubmit, "kobra:address.worker", jobID, "000000000001")
+	if resp.Error == nil || resp.Error.Code != errorCodeDuplicateShare {
+		t.Fatalf("Expected a duplicate share error, got %+v", resp)
+	}
+	resp = miner.request(methodSubmit, "kobra:address.worker", "no-such-job", "000000000002")
+	if resp.Error == nil || resp.Error.Code != errorCodeJobNotFound {
+		t.Fatalf("Expected a job not found error, got %+v", resp)
+	}
+
+	stats := server.Stats()["kobra:address.worker"]
+	if stats.AcceptedShares != 1 || stats.InvalidShares != 1 || stats.StaleShares != 1 || stats.BlocksFound != 1 {
+		t.Fatalf("Unexpected worker stats: %+v", stats)
+	}
+}
@@ -0,0 +1,328 @@
+package stratum
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// extranonceSize is the size in bytes of the part of the nonce that's
+	// fixed per session, so that miners don't search the same nonces
+	extranonceSize = 2
+
+	// nonceSize is the size in bytes of a nonce
+	nonceSize = 8
+
+	// maxRequestSize is the maximal length of a request line
+	maxRequestSize = 16 * 1024
+
+	// writeTimeout is the time a miner has to read a message
+	// before it's disconnected
+	writeTimeout = 10 * time.Second
+
+	// difficultyChangeGracePeriod is the time after a change of the
+	// difficulty of a session in which shares of the previous difficulty
+	// are still accepted, since the miner may not have switched yet
+	difficultyChangeGracePeriod = 30 * time.Second
+
+	// staticDifficultyPrefix is the prefix of an authorization password that
+	// sets a static difficulty for the session, e.g. "d=64"
+	staticDifficultyPrefix = "d="
+)
+
+// session is a connection of a miner to the server
+type session struct {
+	server     *Server
+	conn       net.Conn
+	extranonce string
+
+	writeLock sync.Mutex
+	closeOnce sync.Once
+
+	lock                sync.Mutex
+	isSubscribed        bool
+	isBigJob            bool
+	workerName          string
+	varDiff             *varDiff
+	previousDifficulty  float64
+	difficultyChangedAt time.Time
+}
+
+func newSession(server *Server, conn net.Conn, extranonce uint16) *session {
+	return &session{
+		server:     server,
+		conn:       conn,
+		extranonce: fmt.Sprintf("%0*x", extranonceSize*2, extranonce),
+		varDiff: newVarDiff(server.cfg.Difficulty, server.cfg.MinDifficulty,
+			server.cfg.SharesPerMinute, time.Now()),
+	}
+}
+
+func (s *session) run() {
+	defer s.server.removeSession(s)
+	defer s.close()
+
+	scanner := bufio.NewScanner(s.conn)
+	scanner.Buffer(make([]byte, 0, 1024), maxRequestSize)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(strings.TrimSpace(string(line))) == 0 {
+			continue
+		}
+		var req request
+		err := json.Unmarshal(line, &req)
+		if err != nil {
+			log.Warnf("Disconnecting miner %s after a malformed request: %s", s.conn.RemoteAddr(), err)
+			return
+		}
+		s.handleRequest(&req)
+	}
+	if err := scanner.Err(); err != nil {
+		log.Debugf("Error reading from miner %s: %s", s.conn.RemoteAddr(), err)
+	}
+	log.Infof("Miner %s disconnected", s.conn.RemoteAddr())
+}
+
+func (s *session) close() {
+	s.closeOnce.Do(func() {
+		err := s.conn.Close()
+		if err != nil {
+			log.Debugf("Error closing the connection to miner %s: %s", s.conn.RemoteAddr(), err)
+		}
+	})
+}
+
+func (s *session) handleRequest(req *request) {
+	var result interface{}
+	var respErr *responseError
+	var onResponded func()
+
+	switch req.Method {
+	case methodSubscribe:
+		result, respErr = s.handleSubscribe(req.Params)
+	case methodExtranonceSubscribe:
+		result = true
+	case methodAuthorize:
+		result, respErr = s.handleAuthorize(req.Params)
+		if respErr == nil {
+			onResponded = s.sendInitialWork
+		}
+	case methodSubmit:
+		result, respErr = s.handleSubmit(req.Params)
+	default:
+		respErr = newResponseError(errorCodeOther, fmt.Sprintf("unknown method %s", req.Method))
+	}
+
+	if respErr != nil {
+		log.Debugf("Rejected %s from miner %s: %s", req.Method, s.conn.RemoteAddr(), respErr)
+		result = nil
+	}
+	s.send(&response{ID: req.ID, Result: result, Error: respErr})
+	if onResponded != nil {
+		onResponded()
+	}
+}
+
+func (s *session) handleSubscribe(params []json.RawMessage) (interface{}, *responseError) {
+	var userAgent string
+	if len(params) > 0 {
+		// The user agent is informative, so it's fine if it's not a string
+		_ = json.Unmarshal(params[0], &userAgent)
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.isSubscribed = true
+	s.isBigJob = strings.Contains(strings.ToLower(userAgent), "bzminer")
+	log.Debugf("Miner %s subscribed with user agent %q", s.conn.RemoteAddr(), userAgent)
+
+	return []interface{}{true, protocolVersion}, nil
+}
+
+func (s *session) handleAuthorize(params []json.RawMessage) (interface{}, *responseError) {
+	if len(params) < 1 {
+		return nil, newResponseError(errorCodeMalformedArgument, "missing worker name")
+	}
+	var workerName string
+	err := json.Unmarshal(params[0], &workerName)
+	if err != nil || workerName == "" {
+		return nil, newResponseError(errorCodeUnauthorized, "malformed worker name")
+	}
+
+	staticDifficulty := 0.0
+	if len(params) > 1 {
+		var password string
+		// Miners that don't use a password send whatever, which is fine
+		_ = json.Unmarshal(params[1], &password)
+		if strings.HasPrefix(password, staticDifficultyPrefix) {
+			staticDifficulty, err = strconv.ParseFloat(strings.TrimPrefix(password, staticDifficultyPrefix), 64)
+			if err != nil || staticDifficulty <= 0 || math.IsInf(staticDifficulty, 0) {
+				return nil, newResponseError(errorCodeMalformedArgument, "malformed static difficulty")
+			}
+		}
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if !s.isSubscribed {
+		return nil, newResponseError(errorCodeNotSubscribed, "not subscribed")
+	}
+	s.workerName = workerName
+	if staticDifficulty != 0 {
+		s.varDiff = newVarDiff(staticDifficulty, staticDifficulty, 0, time.Now())
+	}
+	log.Infof("Miner %s authorized as worker %s", s.conn.RemoteAddr(), workerName)
+
+	return true, nil
+}
+
+// sendInitialWork sends a freshly authorized miner its extranonce,
+// difficulty and the latest job
+func (s *session) sendInitialWork() {
+	s.lock.Lock()
+	difficulty := s.varDiff.difficulty
+	s.lock.Unlock()
+
+	s.send(&notification{Method: methodSetExtranonce, Params: []interface{}{s.extranonce, nonceSize - extranonceSize}})
+	s.send(&notification{Method: methodSetDifficulty, Params: []interface{}{difficulty}})
+	latestJob := s.server.latestJob()
+	if latestJob != nil {
+		s.notifyJob(latestJob)
+	}
+}
+
+func (s *session) handleSubmit(params []json.RawMessage) (interface{}, *responseError) {
+	s.lock.Lock()
+	workerName := s.workerName
+	s.lock.Unlock()
+	if workerName == "" {
+		return nil, newResponseError(errorCodeUnauthorized, "unauthorized worker")
+	}
+
+	if len(params) < 3 {
+		return nil, newResponseError(errorCodeMalformedArgument, "expected worker name, job ID and nonce")
+	}
+	var jobID, nonceString string
+	err := json.Unmarshal(params[1], &jobID)
+	if err != nil {
+		return nil, newResponseError(errorCodeMalformedArgument, "malformed job ID")
+	}
+	err = json.Unmarshal(params[2], &nonceString)
+	if err != nil {
+		return nil, newResponseError(errorCodeMalformedArgument, "malformed nonce")
+	}
+	nonce, err := s.parseNonce(nonceString)
+	if err != nil {
+		return nil, newResponseError(errorCodeMalformedArgument, "malformed nonce")
+	}
+
+	difficulty := s.shareDifficulty(time.Now())
+	switch s.server.validateShare(workerName, jobID, nonce, difficulty) {
+	case shareStale:
+		return nil, newResponseError(errorCodeJobNotFound, "job not found")
+	case shareDuplicate:
+		return nil, newResponseError(errorCodeDuplicateShare, "duplicate share")
+	case shareLowDifficulty:
+		return nil, newResponseError(errorCodeLowDifficulty, "low difficulty share")
+	}
+
+	s.lock.Lock()
+	if difficulty == s.varDiff.difficulty {
+		s.varDiff.addShare()
+	}
+	s.lock.Unlock()
+	return true, nil
+}
+
+// parseNonce parses a nonce submitted by the miner. Miners that only
+// search the part of the nonce that follows the extranonce submit only
+// that part.
+func (s *session) parseNonce(nonceString string) (uint64, error) {
+	nonceString = strings.TrimPrefix(nonceString, "0x")
+	if len(nonceString) <= (nonceSize-extranonceSize)*2 {
+		nonceString = fmt.Sprintf("%s%0*s", s.extranonce, (nonceSize-extranonceSize)*2, nonceString)
+	}
+	return strconv.ParseUint(nonceString, 16, 64)
+}
+
+// shareDifficulty returns the difficulty shares are validated at. Shortly
+// after a change of the difficulty it's the lower of the previous and the
+// current difficulty.
+func (s *session) shareDifficulty(now time.Time) float64 {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if now.Sub(s.difficultyChangedAt) < difficultyChangeGracePeriod {
+		return math.Min(s.previousDifficulty, s.varDiff.difficulty)
+	}
+	return s.varDiff.difficulty
+}
+
+// retarget changes the difficulty of the session if its share rate
+// strayed from the target, and resends the latest job at the new difficulty
+func (s *session) retarget(now time.Time) {
+	s.lock.Lock()
+	if s.workerName == "" {
+		s.lock.Unlock()
+		return
+	}
+	previousDifficulty := s.varDiff.difficulty
+	difficulty, ok := s.varDiff.retarget(now)
+	if !ok {
+		s.lock.Unlock()
+		return
+	}
+	s.previousDifficulty = previousDifficulty
+	s.difficultyChangedAt = now
+	workerName := s.workerName
+	s.lock.Unlock()
+
+	log.Debugf("Changing the difficulty of worker %s from %g to %g", workerName, previousDifficulty, difficulty)
+	s.send(&notification{Method: methodSetDifficulty, Params: []interface{}{difficulty}})
+	latestJob := s.server.latestJob()
+	if latestJob != nil {
+		s.notifyJob(latestJob)
+	}
+}
+
+// notifyJob sends the given job to the miner if it's authorized
+func (s *session) notifyJob(j *job) {
+	s.lock.Lock()
+	isAuthorized := s.workerName != ""
+	isBigJob := s.isBigJob
+	s.lock.Unlock()
+	if !isAuthorized {
+		return
+	}
+
+	s.send(&notification{Method: methodNotify, Params: j.params(isBigJob)})
+}
+
+// send writes the given message to the miner as a single line, and
+// disconnects it if that fails
+func (s *session) send(message interface{}) {
+	messageBytes, err := json.Marshal(message)
+	if err != nil {
+		log.Errorf("Error marshalling a stratum message: %s", err)
+		return
+	}
+	messageBytes = append(messageBytes, '\n')
+
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+	err = s.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	if err == nil {
+		_, err = s.conn.Write(messageBytes)
+	}
+	if err != nil {
+		log.Debugf("Disconnecting miner %s after a failed write: %s", s.conn.RemoteAddr(), err)
+		s.close()
+	}
+}
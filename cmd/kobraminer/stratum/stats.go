@@ -0,0 +1,90 @@
+package stratum
+
+import (
+	"sort"
+	"time"
+)
+
+// WorkerStats are the share statistics of a worker since the server started
+type WorkerStats struct {
+	AcceptedShares uint64
+	StaleShares    uint64
+	InvalidShares  uint64
+	BlocksFound    uint64
+
+	// Work is the sum of the difficulties of the accepted shares
+	Work float64
+}
+
+// shareAccounting keeps the share statistics of every worker, along with
+// the work they did since the last time their hash rates were logged
+type shareAccounting struct {
+	workers     map[string]*WorkerStats
+	windowWork  map[string]float64
+	windowStart time.Time
+}
+
+func newShareAccounting(now time.Time) *shareAccounting {
+	return &shareAccounting{
+		workers:     make(map[string]*WorkerStats),
+		windowWork:  make(map[string]float64),
+		windowStart: now,
+	}
+}
+
+func (sa *shareAccounting) worker(workerName string) *WorkerStats {
+	stats, ok := sa.workers[workerName]
+	if !ok {
+		stats = &WorkerStats{}
+		sa.workers[workerName] = stats
+	}
+	return stats
+}
+
+func (sa *shareAccounting) addAcceptedShare(workerName string, difficulty float64) {
+	stats := sa.worker(workerName)
+	stats.AcceptedShares++
+	stats.Work += difficulty
+	sa.windowWork[workerName] += difficulty
+}
+
+func (sa *shareAccounting) addStaleShare(workerName string) {
+	sa.worker(workerName).StaleShares++
+}
+
+func (sa *shareAccounting) addInvalidShare(workerName string) {
+	sa.worker(workerName).InvalidShares++
+}
+
+func (sa *shareAccounting) addBlock(workerName string) {
+	sa.worker(workerName).BlocksFound++
+}
+
+func (sa *shareAccounting) snapshot() map[string]WorkerStats {
+	snapshot := make(map[string]WorkerStats, len(sa.workers))
+	for workerName, stats := range sa.workers {
+		snapshot[workerName] = *stats
+	}
+	return snapshot
+}
+
+// logAndResetWindow logs the statistics of every worker along with its
+// hash rate, as estimated from the work it did since the last call
+func (sa *shareAccounting) logAndResetWindow(now time.Time) {
+	elapsed := now.Sub(sa.windowStart).Seconds()
+	workerNames := make([]string, 0, len(sa.workers))
+	for workerName := range sa.workers {
+		workerNames = append(workerNames, workerName)
+	}
+	sort.Strings(workerNames)
+
+	for _, workerName := range workerNames {
+		stats := sa.workers[workerName]
+		megaHashRate := sa.windowWork[workerName] * hashesPerDifficulty / elapsed / 1e6
+		log.Infof("Worker %s: %.2f Mhash/s, %d accepted, %d stale and %d invalid shares, %d blocks found",
+			workerName, megaHashRate, stats.AcceptedShares, stats.StaleShares, stats.InvalidShares, stats.BlocksFound)
+	}
+
+	sa.windowWork = make(map[string]float64)
+	sa.windowStart = now
+}
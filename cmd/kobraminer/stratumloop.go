@@ -0,0 +1,63 @@
+package main
+
+import (
+	"github.com/kobradag/kobrad/cmd/kobraminer/pool"
+	"github.com/kobradag/kobrad/cmd/kobraminer/stratum"
+	"github.com/kobradag/kobrad/cmd/kobraminer/templatemanager"
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/infrastructure/logger"
+	"github.com/kobradag/kobrad/util"
+)
+
+// stratumLoop serves the block templates of the node to external miners
+// over stratum, and submits the blocks they solve to the node. If minerPool
+// isn't nil, the shares and blocks of the miners are recorded in it.
+func stratumLoop(client *minerClient, cfg *configFlags, miningAddr util.Address, minerPool *pool.Pool) error {
+	stratumConfig := &stratum.Config{
+		ListenAddress:   cfg.StratumListen,
+		Difficulty:      cfg.StratumDifficulty,
+		MinDifficulty:   cfg.StratumMinDifficulty,
+		SharesPerMinute: cfg.StratumSharesPerMinute,
+	}
+	if minerPool != nil {
+		stratumConfig.ShareHandler = func(workerName string, difficulty float64) {
+			recordPoolShare(minerPool, workerName, difficulty)
+		}
+	}
+	server := stratum.NewServer(stratumConfig, func(workerName string, block *externalapi.DomainBlock) error {
+		err := handleFoundBlock(client, block)
+		if err != nil {
+			return err
+		}
+		if minerPool != nil {
+			recordPoolBlock(minerPool, workerName, block)
+		}
+		return nil
+	})
+	server.SetLogger(backendLog, logger.LevelDebug)
+
+	err := server.Start()
+	if err != nil {
+		return err
+	}
+	defer server.Stop()
+
+	errChan := make(chan error)
+	wasSynced := true
+	spawn("templatesLoop", func() {
+		templatesLoop(client, miningAddr, errChan, func() {
+			template, state, isSynced := templatemanager.Get()
+			if !isSynced && !cfg.MineWhenNotSynced {
+				if wasSynced {
+					log.Warnf("kobrad is not synced. Not handing out block templates until it is")
+				}
+				wasSynced = false
+				return
+			}
+			wasSynced = true
+			server.NotifyNewTemplate(template, state)
+		})
+	})
+
+	return <-errChan
+}
@@ -38,6 +38,9 @@ func balance(conf *balanceConfig) error {
 		print("                                                 ")
 	}
 	fmt.Printf("Total balance, KODA %s %s%s\n", utils.FormatKobra(response.Available), utils.FormatKobra(response.Pending), pendingSuffix)
+	if response.Frozen > 0 {
+		fmt.Printf("Frozen, KODA        %s\n", utils.FormatKobra(response.Frozen))
+	}
 
 	return nil
 }
@@ -8,6 +8,8 @@ import (
 
 	"github.com/kobradag/kobrad/cmd/kobrawallet/daemon/client"
 	"github.com/kobradag/kobrad/cmd/kobrawallet/daemon/pb"
+	"github.com/kobradag/kobrad/cmd/kobrawallet/libkobrawallet"
+	"github.com/kobradag/kobrad/cmd/kobrawallet/libkobrawallet/serialization"
 	"github.com/pkg/errors"
 )
 
@@ -42,7 +44,32 @@ func broadcast(conf *broadcastConfig) error {
 		return err
 	}
 
-	response, err := daemonClient.Broadcast(ctx, &pb.BroadcastRequest{Transactions: transactions})
+	// The transactions are finalized and extracted here, so the daemon gets them
+	// ready to be submitted
+	domainTransactions := make([][]byte, len(transactions))
+	for i, transaction := range transactions {
+		if !serialization.IsPSKT(transaction) {
+			transaction, err = libkobrawallet.PartiallySignedTransactionToPSKT(transaction)
+			if err != nil {
+				return err
+			}
+		}
+
+		finalizedTransaction, err := libkobrawallet.FinalizePSKT(transaction)
+		if err != nil {
+			return errors.Wrapf(err, "Could not finalize transaction #%d", i+1)
+		}
+		domainTransaction, err := libkobrawallet.ExtractPSKTTransaction(finalizedTransaction)
+		if err != nil {
+			return err
+		}
+		domainTransactions[i], err = serialization.SerializeDomainTransaction(domainTransaction)
+		if err != nil {
+			return err
+		}
+	}
+
+	response, err := daemonClient.Broadcast(ctx, &pb.BroadcastRequest{Transactions: domainTransactions, IsDomain: true})
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/kobradag/kobrad/cmd/kobrawallet/daemon/client"
+	"github.com/kobradag/kobrad/cmd/kobrawallet/daemon/pb"
+	"github.com/kobradag/kobrad/cmd/kobrawallet/keys"
+	"github.com/kobradag/kobrad/cmd/kobrawallet/libkobrawallet"
+	"github.com/pkg/errors"
+)
+
+func bumpFee(conf *bumpFeeConfig) error {
+	keysFile, err := keys.ReadKeysFile(conf.NetParams(), conf.KeysFile)
+	if err != nil {
+		return err
+	}
+
+	if keysFile.IsWatchOnly() {
+		return errors.Wrap(keys.ErrWatchOnly, "Cannot use 'bump-fee' command")
+	}
+
+	if len(keysFile.ExtendedPublicKeys) > len(keysFile.EncryptedMnemonics) {
+		return errors.Errorf("Cannot use 'bump-fee' command for multisig wallet without all of the keys")
+	}
+
+	daemonClient, tearDown, err := client.Connect(conf.DaemonAddress)
+	if err != nil {
+		return err
+	}
+	defer tearDown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), daemonTimeout)
+	defer cancel()
+
+	bumpFeeResponse, err := daemonClient.BumpFee(ctx, &pb.BumpFeeRequest{
+		TxID:    conf.TxID,
+		FeeRate: conf.FeeRate,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(conf.Password) == 0 {
+		conf.Password = keys.GetPassword("Password:")
+	}
+	mnemonics, err := keysFile.DecryptMnemonics(conf.Password)
+	if err != nil {
+		if strings.Contains(err.Error(), "message authentication failed") {
+			fmt.Fprintf(os.Stderr, "Password decryption failed. Sometimes this is a result of not "+
+				"specifying the same keys file used by the wallet daemon process.\n")
+		}
+		return err
+	}
+
+	signedTransaction, err := libkobrawallet.Sign(conf.NetParams(), mnemonics, bumpFeeResponse.UnsignedTransaction, keysFile.ECDSA)
+	if err != nil {
+		return err
+	}
+
+	// Since we waited for user input when getting the password, which could take unbound amount of time -
+	// create a new context for broadcast, to reset the timeout.
+	broadcastCtx, broadcastCancel := context.WithTimeout(context.Background(), daemonTimeout)
+	defer broadcastCancel()
+
+	response, err := daemonClient.BroadcastReplacement(broadcastCtx, &pb.BroadcastRequest{
+		Transactions: [][]byte{signedTransaction},
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Transaction %s was replaced by transaction %s\n", conf.TxID, response.TxIDs[0])
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kobradag/kobrad/cmd/kobrawallet/daemon/client"
+	"github.com/kobradag/kobrad/cmd/kobrawallet/daemon/pb"
+	"github.com/kobradag/kobrad/cmd/kobrawallet/utils"
+)
+
+func listUTXOs(conf *listUTXOsConfig) error {
+	daemonClient, tearDown, err := client.Connect(conf.DaemonAddress)
+	if err != nil {
+		return err
+	}
+	defer tearDown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), daemonTimeout)
+	defer cancel()
+
+	response, err := daemonClient.ListUTXOs(ctx, &pb.ListUTXOsRequest{Addresses: conf.Addresses})
+	if err != nil {
+		return err
+	}
+
+	for _, utxo := range response.Utxos {
+		fmt.Printf("%s:%d\n", utxo.Outpoint.TransactionId, utxo.Outpoint.Index)
+		fmt.Printf("\tAddress:         %s\n", utxo.Address)
+		fmt.Printf("\tAmount, KODA:    %s\n", utils.FormatKobra(utxo.Amount))
+		fmt.Printf("\tBlock DAA score: %d\n", utxo.BlockDaaScore)
+		fmt.Printf("\tStatus:          %s\n", utxoStatus(utxo))
+	}
+	fmt.Printf("%d UTXOs\n", len(response.Utxos))
+
+	return nil
+}
+
+func utxoStatus(utxo *pb.WalletUtxo) string {
+	switch {
+	case utxo.IsFrozen:
+		return "frozen"
+	case utxo.IsUsed:
+		return "spent by a pending transaction"
+	case !utxo.IsMature:
+		return "immature coinbase"
+	case utxo.IsCoinbase:
+		return "spendable (coinbase)"
+	default:
+		return "spendable"
+	}
+}
+
+func freeze(conf *freezeConfig) error {
+	outpoints, err := parseOutpoints(conf.UTXOs)
+	if err != nil {
+		return err
+	}
+
+	daemonClient, tearDown, err := client.Connect(conf.DaemonAddress)
+	if err != nil {
+		return err
+	}
+	defer tearDown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), daemonTimeout)
+	defer cancel()
+
+	_, err = daemonClient.FreezeUTXOs(ctx, &pb.FreezeUTXOsRequest{Outpoints: outpoints})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Froze %d UTXOs\n", len(outpoints))
+	return nil
+}
+
+func unfreeze(conf *unfreezeConfig) error {
+	outpoints, err := parseOutpoints(conf.UTXOs)
+	if err != nil {
+		return err
+	}
+
+	daemonClient, tearDown, err := client.Connect(conf.DaemonAddress)
+	if err != nil {
+		return err
+	}
+	defer tearDown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), daemonTimeout)
+	defer cancel()
+
+	_, err = daemonClient.UnfreezeUTXOs(ctx, &pb.UnfreezeUTXOsRequest{Outpoints: outpoints})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Unfroze %d UTXOs\n", len(outpoints))
+	return nil
+}
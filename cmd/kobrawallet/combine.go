@@ -0,0 +1,57 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/kobradag/kobrad/cmd/kobrawallet/libkobrawallet"
+	"github.com/pkg/errors"
+)
+
+func combine(conf *combineConfig) error {
+	transactionsHexes := conf.Transactions
+	for _, transactionFile := range conf.TransactionFiles {
+		transactionHexBytes, err := ioutil.ReadFile(transactionFile)
+		if err != nil {
+			return errors.Wrapf(err, "Could not read hex from %s", transactionFile)
+		}
+		transactionsHexes = append(transactionsHexes, strings.TrimSpace(string(transactionHexBytes)))
+	}
+	if len(transactionsHexes) < 2 {
+		return errors.Errorf("At least two transactions are required, using --transaction or --transaction-file")
+	}
+
+	// Each of the given hexes may contain several transactions, in which case the
+	// respective transactions of all of them are combined
+	var copies [][][]byte
+	for _, transactionsHex := range transactionsHexes {
+		transactions, err := decodeTransactionsFromHex(transactionsHex)
+		if err != nil {
+			return err
+		}
+		if len(copies) > 0 && len(transactions) != len(copies[0]) {
+			return errors.Errorf("Can't combine %d transactions with %d transactions", len(transactions), len(copies[0]))
+		}
+		copies = append(copies, transactions)
+	}
+
+	combinedTransactions := make([][]byte, len(copies[0]))
+	for i := range combinedTransactions {
+		transactionCopies := make([][]byte, len(copies))
+		for j := range copies {
+			transactionCopies[j] = copies[j][i]
+		}
+
+		var err error
+		combinedTransactions[i], err = libkobrawallet.CombinePSKTs(transactionCopies)
+		if err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintln(os.Stderr, "Successfully combined transactions")
+	fmt.Println(encodeTransactionsToHex(combinedTransactions))
+	return nil
+}
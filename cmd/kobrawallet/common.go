@@ -1,9 +1,17 @@
 package main
 
 import (
+	"encoding/csv"
 	"fmt"
+	"io"
 	"os"
+	"strconv"
+	"strings"
 	"time"
+
+	"github.com/kobradag/kobrad/cmd/kobrawallet/daemon/pb"
+	"github.com/kobradag/kobrad/cmd/kobrawallet/utils"
+	"github.com/pkg/errors"
 )
 
 const daemonTimeout = 2 * time.Minute
@@ -12,3 +20,71 @@ func printErrorAndExit(err error) {
 	fmt.Fprintf(os.Stderr, "%s\n", err)
 	os.Exit(1)
 }
+
+// parseOutpoints parses outpoints that are formatted as <transaction ID>:<index>
+func parseOutpoints(outpointStrings []string) ([]*pb.Outpoint, error) {
+	outpoints := make([]*pb.Outpoint, len(outpointStrings))
+	for i, outpointString := range outpointStrings {
+		separatorIndex := strings.LastIndex(outpointString, ":")
+		if separatorIndex < 0 {
+			return nil, errors.Errorf("UTXO %s is not in the format <transaction ID>:<index>", outpointString)
+		}
+		index, err := strconv.ParseUint(outpointString[separatorIndex+1:], 10, 32)
+		if err != nil {
+			return nil, errors.Wrapf(err, "UTXO %s has an invalid index", outpointString)
+		}
+		outpoints[i] = &pb.Outpoint{
+			TransactionId: outpointString[:separatorIndex],
+			Index:         uint32(index),
+		}
+	}
+	return outpoints, nil
+}
+
+// readPaymentsFile reads a CSV file of payments, one <address>,<amount in Kobra>
+// per line. Empty lines and an "address,amount" header line are skipped.
+func readPaymentsFile(path string) ([]*pb.Payment, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return parsePayments(file)
+}
+
+func parsePayments(reader io.Reader) ([]*pb.Payment, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = 2
+	csvReader.TrimLeadingSpace = true
+
+	var payments []*pb.Payment
+	for {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "malformed payments file")
+		}
+		address, amount := strings.TrimSpace(record[0]), strings.TrimSpace(record[1])
+		if len(payments) == 0 && strings.EqualFold(address, "address") && strings.EqualFold(amount, "amount") {
+			continue
+		}
+
+		amountLeor, err := utils.KobraToLeor(amount)
+		if err != nil {
+			line, _ := csvReader.FieldPos(0)
+			return nil, errors.Wrapf(err, "invalid amount of the payment on line %d", line)
+		}
+		payments = append(payments, &pb.Payment{
+			Address: address,
+			Amount:  amountLeor,
+		})
+	}
+
+	if len(payments) == 0 {
+		return nil, errors.New("the payments file contains no payments")
+	}
+	return payments, nil
+}
@@ -12,13 +12,21 @@ const (
 	createSubCmd                    = "create"
 	balanceSubCmd                   = "balance"
 	sendSubCmd                      = "send"
+	bumpFeeSubCmd                   = "bump-fee"
 	sweepSubCmd                     = "sweep"
 	createUnsignedTransactionSubCmd = "create-unsigned-transaction"
 	signSubCmd                      = "sign"
 	broadcastSubCmd                 = "broadcast"
+	combineSubCmd                   = "combine"
+	finalizeSubCmd                  = "finalize"
 	parseSubCmd                     = "parse"
 	showAddressesSubCmd             = "show-addresses"
 	newAddressSubCmd                = "new-address"
+	transactionHistorySubCmd        = "transaction-history"
+	labelSubCmd                     = "label"
+	listUTXOsSubCmd                 = "list-utxos"
+	freezeSubCmd                    = "freeze"
+	unfreezeSubCmd                  = "unfreeze"
 	dumpUnencryptedDataSubCmd       = "dump-unencrypted-data"
 	startDaemonSubCmd               = "start-daemon"
 	versionSubCmd                   = "version"
@@ -36,14 +44,16 @@ type configFlags struct {
 }
 
 type createConfig struct {
-	KeysFile          string `long:"keys-file" short:"f" description:"Keys file location (default: ~/.kobrawallet/keys.json (*nix), %USERPROFILE%\\AppData\\Local\\kobrawallet\\key.json (Windows))"`
-	Password          string `long:"password" short:"p" description:"Wallet password"`
-	Yes               bool   `long:"yes" short:"y" description:"Assume \"yes\" to all questions"`
-	MinimumSignatures uint32 `long:"min-signatures" short:"m" description:"Minimum required signatures" default:"1"`
-	NumPrivateKeys    uint32 `long:"num-private-keys" short:"k" description:"Number of private keys" default:"1"`
-	NumPublicKeys     uint32 `long:"num-public-keys" short:"n" description:"Total number of keys" default:"1"`
-	ECDSA             bool   `long:"ecdsa" description:"Create an ECDSA wallet"`
-	Import            bool   `long:"import" short:"i" description:"Import private keys (as opposed to generating them)"`
+	KeysFile          string   `long:"keys-file" short:"f" description:"Keys file location (default: ~/.kobrawallet/keys.json (*nix), %USERPROFILE%\\AppData\\Local\\kobrawallet\\key.json (Windows))"`
+	Password          string   `long:"password" short:"p" description:"Wallet password"`
+	Yes               bool     `long:"yes" short:"y" description:"Assume \"yes\" to all questions"`
+	MinimumSignatures uint32   `long:"min-signatures" short:"m" description:"Minimum required signatures" default:"1"`
+	NumPrivateKeys    uint32   `long:"num-private-keys" short:"k" description:"Number of private keys" default:"1"`
+	NumPublicKeys     uint32   `long:"num-public-keys" short:"n" description:"Total number of keys" default:"1"`
+	ECDSA             bool     `long:"ecdsa" description:"Create an ECDSA wallet"`
+	Import            bool     `long:"import" short:"i" description:"Import private keys (as opposed to generating them)"`
+	WatchOnly         bool     `long:"watch-only" description:"Create a watch-only wallet out of extended public keys only. It can't sign transactions"`
+	PublicKeys        []string `long:"public-key" description:"An extended public key of a watch-only wallet, instead of entering it interactively. Use multiple times for a multisig wallet"`
 	config.NetworkFlags
 }
 
@@ -57,15 +67,26 @@ type sendConfig struct {
 	KeysFile                 string   `long:"keys-file" short:"f" description:"Keys file location (default: ~/.kobrawallet/keys.json (*nix), %USERPROFILE%\\AppData\\Local\\kobrawallet\\key.json (Windows))"`
 	Password                 string   `long:"password" short:"p" description:"Wallet password"`
 	DaemonAddress            string   `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
-	ToAddress                string   `long:"to-address" short:"t" description:"The public address to send Kobra to" required:"true"`
+	ToAddress                string   `long:"to-address" short:"t" description:"The public address to send Kobra to"`
+	PaymentsFile             string   `long:"payments-file" description:"A CSV file of payments to several recipients, one <address>,<amount in Kobra> per line (mutually exclusive with --to-address)"`
 	FromAddresses            []string `long:"from-address" short:"a" description:"Specific public address to send Kobra from. Use multiple times to accept several addresses" required:"false"`
 	SendAmount               string   `long:"send-amount" short:"v" description:"An amount to send in Kobra (e.g. 1234.12345678)"`
 	IsSendAll                bool     `long:"send-all" description:"Send all the Kobra in the wallet (mutually exclusive with --send-amount)"`
 	UseExistingChangeAddress bool     `long:"use-existing-change-address" short:"u" description:"Will use an existing change address (in case no change address was ever used, it will use a new one)"`
+	UTXOs                    []string `long:"utxo" description:"A specific UTXO to spend, as <transaction ID>:<index>. Use multiple times to spend several UTXOs. Only the given UTXOs are spent (mutually exclusive with --from-address)"`
 	Verbose                  bool     `long:"show-serialized" short:"s" description:"Show a list of hex encoded sent transactions"`
 	config.NetworkFlags
 }
 
+type bumpFeeConfig struct {
+	KeysFile      string  `long:"keys-file" short:"f" description:"Keys file location (default: ~/.kobrawallet/keys.json (*nix), %USERPROFILE%\\AppData\\Local\\kobrawallet\\key.json (Windows))"`
+	Password      string  `long:"password" short:"p" description:"Wallet password"`
+	DaemonAddress string  `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
+	TxID          string  `long:"txid" short:"i" description:"The ID of the unconfirmed transaction to replace" required:"true"`
+	FeeRate       float64 `long:"fee-rate" short:"r" description:"The fee rate of the replacement in leor/gram (default: the node's priority fee rate estimate)"`
+	config.NetworkFlags
+}
+
 type sweepConfig struct {
 	PrivateKey    string `long:"private-key" short:"k" description:"Private key in hex format"`
 	DaemonAddress string `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
@@ -74,19 +95,22 @@ type sweepConfig struct {
 
 type createUnsignedTransactionConfig struct {
 	DaemonAddress            string   `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
-	ToAddress                string   `long:"to-address" short:"t" description:"The public address to send Kobra to" required:"true"`
+	ToAddress                string   `long:"to-address" short:"t" description:"The public address to send Kobra to"`
+	PaymentsFile             string   `long:"payments-file" description:"A CSV file of payments to several recipients, one <address>,<amount in Kobra> per line (mutually exclusive with --to-address)"`
 	FromAddresses            []string `long:"from-address" short:"a" description:"Specific public address to send Kobra from. Use multiple times to accept several addresses" required:"false"`
 	SendAmount               string   `long:"send-amount" short:"v" description:"An amount to send in Kobra (e.g. 1234.12345678)"`
 	IsSendAll                bool     `long:"send-all" description:"Send all the Kobra in the wallet (mutually exclusive with --send-amount)"`
 	UseExistingChangeAddress bool     `long:"use-existing-change-address" short:"u" description:"Will use an existing change address (in case no change address was ever used, it will use a new one)"`
+	UTXOs                    []string `long:"utxo" description:"A specific UTXO to spend, as <transaction ID>:<index>. Use multiple times to spend several UTXOs. Only the given UTXOs are spent (mutually exclusive with --from-address)"`
 	config.NetworkFlags
 }
 
 type signConfig struct {
-	KeysFile        string `long:"keys-file" short:"f" description:"Keys file location (default: ~/.kobrawallet/keys.json (*nix), %USERPROFILE%\\AppData\\Local\\kobrawallet\\key.json (Windows))"`
-	Password        string `long:"password" short:"p" description:"Wallet password"`
-	Transaction     string `long:"transaction" short:"t" description:"The unsigned transaction(s) to sign on (encoded in hex)"`
-	TransactionFile string `long:"transaction-file" short:"F" description:"The file containing the unsigned transaction(s) to sign on (encoded in hex)"`
+	KeysFile            string `long:"keys-file" short:"f" description:"Keys file location (default: ~/.kobrawallet/keys.json (*nix), %USERPROFILE%\\AppData\\Local\\kobrawallet\\key.json (Windows))"`
+	Password            string `long:"password" short:"p" description:"Wallet password"`
+	Transaction         string `long:"transaction" short:"t" description:"The unsigned transaction(s) to sign on (encoded in hex)"`
+	TransactionFile     string `long:"transaction-file" short:"F" description:"The file containing the unsigned transaction(s) to sign on (encoded in hex)"`
+	AllowAnySigHashType bool   `long:"allow-any-sighash-type" description:"Sign inputs that request a sighash type other than SIGHASH_ALL, whose signatures don't commit to the whole transaction"`
 	config.NetworkFlags
 }
 
@@ -97,6 +121,18 @@ type broadcastConfig struct {
 	config.NetworkFlags
 }
 
+type combineConfig struct {
+	Transactions     []string `long:"transaction" short:"t" description:"The partially signed transaction(s) to combine (encoded in hex). Use multiple times to combine several of them"`
+	TransactionFiles []string `long:"transaction-file" short:"F" description:"The file containing the partially signed transaction(s) to combine (encoded in hex). Use multiple times to combine several of them"`
+	config.NetworkFlags
+}
+
+type finalizeConfig struct {
+	Transaction     string `long:"transaction" short:"t" description:"The signed transaction(s) to finalize (encoded in hex)"`
+	TransactionFile string `long:"transaction-file" short:"F" description:"The file containing the signed transaction(s) to finalize (encoded in hex)"`
+	config.NetworkFlags
+}
+
 type parseConfig struct {
 	Transaction     string `long:"transaction" short:"t" description:"The transaction to parse (encoded in hex)"`
 	TransactionFile string `long:"transaction-file" short:"F" description:"The file containing the transaction to parse (encoded in hex)"`
@@ -114,6 +150,41 @@ type newAddressConfig struct {
 	config.NetworkFlags
 }
 
+type transactionHistoryConfig struct {
+	DaemonAddress string `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
+	Address       string `long:"address" short:"a" description:"Show only the transactions of this address"`
+	Offset        uint32 `long:"offset" short:"o" description:"Number of most recent transactions to skip"`
+	Limit         uint32 `long:"limit" short:"n" description:"Maximum number of transactions to show (default: 20, or all of them with --csv)"`
+	CSV           bool   `long:"csv" description:"Print the transactions in CSV format, e.g. for exporting them to a spreadsheet"`
+	config.NetworkFlags
+}
+
+type labelConfig struct {
+	DaemonAddress string `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
+	TxID          string `long:"txid" short:"i" description:"The ID of the transaction to label"`
+	Address       string `long:"address" short:"a" description:"The address to label"`
+	Label         string `long:"label" short:"l" description:"The label. An empty label removes the existing one"`
+	config.NetworkFlags
+}
+
+type listUTXOsConfig struct {
+	DaemonAddress string   `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
+	Addresses     []string `long:"address" short:"a" description:"Show only the UTXOs of this address. Use multiple times to show the UTXOs of several addresses"`
+	config.NetworkFlags
+}
+
+type freezeConfig struct {
+	DaemonAddress string   `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
+	UTXOs         []string `long:"utxo" description:"The UTXO to freeze, as <transaction ID>:<index>. Use multiple times to freeze several UTXOs" required:"true"`
+	config.NetworkFlags
+}
+
+type unfreezeConfig struct {
+	DaemonAddress string   `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
+	UTXOs         []string `long:"utxo" description:"The UTXO to unfreeze, as <transaction ID>:<index>. Use multiple times to unfreeze several UTXOs" required:"true"`
+	config.NetworkFlags
+}
+
 type startDaemonConfig struct {
 	KeysFile  string `long:"keys-file" short:"f" description:"Keys file location (default: ~/.kobrawallet/keys.json (*nix), %USERPROFILE%\\AppData\\Local\\kobrawallet\\key.json (Windows))"`
 	Password  string `long:"password" short:"p" description:"Wallet password"`
@@ -121,6 +192,7 @@ type startDaemonConfig struct {
 	Listen    string `long:"listen" short:"l" description:"Address to listen on (default: 0.0.0.0:8882)"`
 	Timeout   uint32 `long:"wait-timeout" short:"w" description:"Waiting timeout for RPC calls, seconds (default: 30 s)"`
 	Profile   string `long:"profile" description:"Enable HTTP profiling on given port -- NOTE port must be between 1024 and 65536"`
+	config.RPCAuthFlags
 	config.NetworkFlags
 }
 
@@ -154,6 +226,11 @@ func parseCommandLine() (subCommand string, config interface{}) {
 	parser.AddCommand(sendSubCmd, "Sends a Kobra transaction to a public address",
 		"Sends a Kobra transaction to a public address", sendConf)
 
+	bumpFeeConf := &bumpFeeConfig{DaemonAddress: defaultListen}
+	parser.AddCommand(bumpFeeSubCmd, "Replaces an unconfirmed transaction with one paying a higher fee",
+		"Replaces an unconfirmed transaction sent from this wallet with a transaction that spends the same inputs "+
+			"and pays a higher fee out of its change output", bumpFeeConf)
+
 	sweepConf := &sweepConfig{DaemonAddress: defaultListen}
 	parser.AddCommand(sweepSubCmd, "Sends all funds associated with the given schnorr private key to a new address of the current wallet",
 		"Sends all funds associated with the given schnorr private key to a newly created external (i.e. not a change) address of the "+
@@ -172,6 +249,15 @@ func parseCommandLine() (subCommand string, config interface{}) {
 	parser.AddCommand(broadcastSubCmd, "Broadcast the given transaction",
 		"Broadcast the given transaction", broadcastConf)
 
+	combineConf := &combineConfig{}
+	parser.AddCommand(combineSubCmd, "Combine the signatures of several copies of the same partially signed transaction",
+		"Combine the signatures of several copies of the same partially signed transaction, each signed by different "+
+			"parties, into a single partially signed transaction", combineConf)
+
+	finalizeConf := &finalizeConfig{}
+	parser.AddCommand(finalizeSubCmd, "Finalize the given signed transaction",
+		"Build the final signature scripts of the given signed transaction out of its signatures", finalizeConf)
+
 	parseConf := &parseConfig{}
 	parser.AddCommand(parseSubCmd, "Parse the given transaction and print its contents",
 		"Parse the given transaction and print its contents", parseConf)
@@ -184,6 +270,29 @@ func parseCommandLine() (subCommand string, config interface{}) {
 	parser.AddCommand(newAddressSubCmd, "Generates new public address of the current wallet and shows it",
 		"Generates new public address of the current wallet and shows it", newAddressConf)
 
+	transactionHistoryConf := &transactionHistoryConfig{DaemonAddress: defaultListen}
+	parser.AddCommand(transactionHistorySubCmd, "Shows the transaction history of the current wallet",
+		"Shows the accepted transactions that paid to or spent from the addresses of the current wallet, "+
+			"from the most recent one, along with their labels. Requires the node to run with --addressindex", transactionHistoryConf)
+
+	labelConf := &labelConfig{DaemonAddress: defaultListen}
+	parser.AddCommand(labelSubCmd, "Labels a transaction or an address",
+		"Sets the label of a transaction or an address, which is shown in the transaction history", labelConf)
+
+	listUTXOsConf := &listUTXOsConfig{DaemonAddress: defaultListen}
+	parser.AddCommand(listUTXOsSubCmd, "Lists the UTXOs of the current wallet",
+		"Lists the UTXOs of the current wallet, along with whether they are frozen, mature, or already spent by a "+
+			"transaction that wasn't accepted yet", listUTXOsConf)
+
+	freezeConf := &freezeConfig{DaemonAddress: defaultListen}
+	parser.AddCommand(freezeSubCmd, "Freezes UTXOs so that they are not spent",
+		"Freezes UTXOs of the current wallet, so that they are never selected for spending, e.g. to reserve them "+
+			"for another payment. Frozen UTXOs remain frozen until they are unfrozen", freezeConf)
+
+	unfreezeConf := &unfreezeConfig{DaemonAddress: defaultListen}
+	parser.AddCommand(unfreezeSubCmd, "Unfreezes frozen UTXOs",
+		"Unfreezes frozen UTXOs of the current wallet, so that they can be spent again", unfreezeConf)
+
 	dumpUnencryptedDataConf := &dumpUnencryptedDataConfig{}
 	parser.AddCommand(dumpUnencryptedDataSubCmd, "Prints the unencrypted wallet data",
 		"Prints the unencrypted wallet data including its private keys. Anyone that sees it can access "+
@@ -216,6 +325,10 @@ func parseCommandLine() (subCommand string, config interface{}) {
 		if err != nil {
 			printErrorAndExit(err)
 		}
+		err = validateCreateConf(createConf)
+		if err != nil {
+			printErrorAndExit(err)
+		}
 		config = createConf
 	case balanceSubCmd:
 		combineNetworkFlags(&balanceConf.NetworkFlags, &cfg.NetworkFlags)
@@ -235,6 +348,13 @@ func parseCommandLine() (subCommand string, config interface{}) {
 			printErrorAndExit(err)
 		}
 		config = sendConf
+	case bumpFeeSubCmd:
+		combineNetworkFlags(&bumpFeeConf.NetworkFlags, &cfg.NetworkFlags)
+		err := bumpFeeConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = bumpFeeConf
 	case sweepSubCmd:
 		combineNetworkFlags(&sweepConf.NetworkFlags, &cfg.NetworkFlags)
 		err := sweepConf.ResolveNetwork(parser)
@@ -267,6 +387,20 @@ func parseCommandLine() (subCommand string, config interface{}) {
 			printErrorAndExit(err)
 		}
 		config = broadcastConf
+	case combineSubCmd:
+		combineNetworkFlags(&combineConf.NetworkFlags, &cfg.NetworkFlags)
+		err := combineConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = combineConf
+	case finalizeSubCmd:
+		combineNetworkFlags(&finalizeConf.NetworkFlags, &cfg.NetworkFlags)
+		err := finalizeConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = finalizeConf
 	case parseSubCmd:
 		combineNetworkFlags(&parseConf.NetworkFlags, &cfg.NetworkFlags)
 		err := parseConf.ResolveNetwork(parser)
@@ -288,6 +422,45 @@ func parseCommandLine() (subCommand string, config interface{}) {
 			printErrorAndExit(err)
 		}
 		config = newAddressConf
+	case transactionHistorySubCmd:
+		combineNetworkFlags(&transactionHistoryConf.NetworkFlags, &cfg.NetworkFlags)
+		err := transactionHistoryConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = transactionHistoryConf
+	case labelSubCmd:
+		combineNetworkFlags(&labelConf.NetworkFlags, &cfg.NetworkFlags)
+		err := labelConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		err = validateLabelConf(labelConf)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = labelConf
+	case listUTXOsSubCmd:
+		combineNetworkFlags(&listUTXOsConf.NetworkFlags, &cfg.NetworkFlags)
+		err := listUTXOsConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = listUTXOsConf
+	case freezeSubCmd:
+		combineNetworkFlags(&freezeConf.NetworkFlags, &cfg.NetworkFlags)
+		err := freezeConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = freezeConf
+	case unfreezeSubCmd:
+		combineNetworkFlags(&unfreezeConf.NetworkFlags, &cfg.NetworkFlags)
+		err := unfreezeConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = unfreezeConf
 	case dumpUnencryptedDataSubCmd:
 		combineNetworkFlags(&dumpUnencryptedDataConf.NetworkFlags, &cfg.NetworkFlags)
 		err := dumpUnencryptedDataConf.ResolveNetwork(parser)
@@ -310,24 +483,75 @@ func parseCommandLine() (subCommand string, config interface{}) {
 	return parser.Command.Active.Name, config
 }
 
-func validateCreateUnsignedTransactionConf(conf *createUnsignedTransactionConfig) error {
-	if (!conf.IsSendAll && conf.SendAmount == "") ||
-		(conf.IsSendAll && conf.SendAmount != "") {
+func validateCreateConf(conf *createConfig) error {
+	if len(conf.PublicKeys) > 0 && !conf.WatchOnly {
+		return errors.New("'--public-key' can only be used with '--watch-only'")
+	}
+	if conf.WatchOnly {
+		if conf.Import {
+			return errors.New("'--import' can't be used with '--watch-only'")
+		}
+		conf.NumPrivateKeys = 0
+		if len(conf.PublicKeys) > 0 {
+			conf.NumPublicKeys = uint32(len(conf.PublicKeys))
+		}
+		if conf.MinimumSignatures == 0 || conf.MinimumSignatures > conf.NumPublicKeys {
+			return errors.Errorf("'--min-signatures' must be between 1 and the number of keys (%d)", conf.NumPublicKeys)
+		}
+	}
+	return nil
+}
 
-		return errors.New("exactly one of '--send-amount' or '--all' must be specified")
+func validateCreateUnsignedTransactionConf(conf *createUnsignedTransactionConfig) error {
+	err := validatePaymentFlags(conf.ToAddress, conf.PaymentsFile, conf.SendAmount, conf.IsSendAll)
+	if err != nil {
+		return err
+	}
+	if len(conf.UTXOs) > 0 && len(conf.FromAddresses) > 0 {
+		return errors.New("'--utxo' and '--from-address' can't be used together")
 	}
 	return nil
 }
 
 func validateSendConfig(conf *sendConfig) error {
-	if (!conf.IsSendAll && conf.SendAmount == "") ||
-		(conf.IsSendAll && conf.SendAmount != "") {
+	err := validatePaymentFlags(conf.ToAddress, conf.PaymentsFile, conf.SendAmount, conf.IsSendAll)
+	if err != nil {
+		return err
+	}
+	if len(conf.UTXOs) > 0 && len(conf.FromAddresses) > 0 {
+		return errors.New("'--utxo' and '--from-address' can't be used together")
+	}
+	return nil
+}
+
+// validatePaymentFlags makes sure that a transaction either pays a single
+// recipient, with exactly one of '--send-amount' or '--send-all', or pays
+// the recipients of a payments file
+func validatePaymentFlags(toAddress, paymentsFile, sendAmount string, isSendAll bool) error {
+	if (toAddress == "") == (paymentsFile == "") {
+		return errors.New("exactly one of '--to-address' or '--payments-file' must be specified")
+	}
+	if paymentsFile != "" {
+		if isSendAll || sendAmount != "" {
+			return errors.New("'--send-amount' and '--send-all' can't be used with '--payments-file'")
+		}
+		return nil
+	}
+	if (!isSendAll && sendAmount == "") ||
+		(isSendAll && sendAmount != "") {
 
 		return errors.New("exactly one of '--send-amount' or '--all' must be specified")
 	}
 	return nil
 }
 
+func validateLabelConf(conf *labelConfig) error {
+	if (conf.TxID == "") == (conf.Address == "") {
+		return errors.New("exactly one of '--txid' or '--address' must be specified")
+	}
+	return nil
+}
+
 func combineNetworkFlags(dst, src *config.NetworkFlags) {
 	dst.Testnet = dst.Testnet || src.Testnet
 	dst.Simnet = dst.Simnet || src.Simnet
@@ -18,41 +18,63 @@ func create(conf *createConfig) error {
 	var signerExtendedPublicKeys []string
 	var err error
 	isMultisig := conf.NumPublicKeys > 1
-	if !conf.Import {
-		encryptedMnemonics, signerExtendedPublicKeys, err = keys.CreateMnemonics(conf.NetParams(), conf.NumPrivateKeys, conf.Password, isMultisig)
-	} else {
-		encryptedMnemonics, signerExtendedPublicKeys, err = keys.ImportMnemonics(conf.NetParams(), conf.NumPrivateKeys, conf.Password, isMultisig)
-	}
-	if err != nil {
-		return err
+	// A watch-only wallet has no private keys, and therefore no password
+	if !conf.WatchOnly {
+		if !conf.Import {
+			encryptedMnemonics, signerExtendedPublicKeys, err = keys.CreateMnemonics(conf.NetParams(), conf.NumPrivateKeys, conf.Password, isMultisig)
+		} else {
+			encryptedMnemonics, signerExtendedPublicKeys, err = keys.ImportMnemonics(conf.NetParams(), conf.NumPrivateKeys, conf.Password, isMultisig)
+		}
+		if err != nil {
+			return err
+		}
 	}
 
 	for i, extendedPublicKey := range signerExtendedPublicKeys {
 		fmt.Printf("Extended public key of mnemonic #%d:\n%s\n\n", i+1, extendedPublicKey)
 	}
 
-	fmt.Printf("Notice the above is neither a secret key to your wallet " +
-		"(use \"kobrawallet dump-unencrypted-data\" to see a secret seed phrase) " +
-		"nor a wallet public address (use \"kobrawallet new-address\" to create and see one)\n\n")
+	if !conf.WatchOnly {
+		fmt.Printf("Notice the above is neither a secret key to your wallet " +
+			"(use \"kobrawallet dump-unencrypted-data\" to see a secret seed phrase) " +
+			"nor a wallet public address (use \"kobrawallet new-address\" to create and see one)\n\n")
+	}
 
 	extendedPublicKeys := make([]string, conf.NumPrivateKeys, conf.NumPublicKeys)
 	copy(extendedPublicKeys, signerExtendedPublicKeys)
-	reader := bufio.NewReader(os.Stdin)
-	for i := conf.NumPrivateKeys; i < conf.NumPublicKeys; i++ {
-		fmt.Printf("Enter public key #%d here:\n", i+1)
-		extendedPublicKey, err := utils.ReadLine(reader)
-		if err != nil {
-			return err
+	if len(conf.PublicKeys) > 0 {
+		for _, extendedPublicKey := range conf.PublicKeys {
+			err = libkobrawallet.ValidateExtendedPublicKey(conf.NetParams(), extendedPublicKey)
+			if err != nil {
+				return err
+			}
 		}
-
-		_, err = bip32.DeserializeExtendedKey(string(extendedPublicKey))
-		if err != nil {
-			return errors.Wrapf(err, "%s is invalid extended public key", string(extendedPublicKey))
+		extendedPublicKeys = append(extendedPublicKeys, conf.PublicKeys...)
+	} else {
+		reader := bufio.NewReader(os.Stdin)
+		for i := conf.NumPrivateKeys; i < conf.NumPublicKeys; i++ {
+			fmt.Printf("Enter public key #%d here:\n", i+1)
+			extendedPublicKey, err := utils.ReadLine(reader)
+			if err != nil {
+				return err
+			}
+
+			if conf.WatchOnly {
+				err = libkobrawallet.ValidateExtendedPublicKey(conf.NetParams(), string(extendedPublicKey))
+				if err != nil {
+					return err
+				}
+			} else {
+				_, err = bip32.DeserializeExtendedKey(string(extendedPublicKey))
+				if err != nil {
+					return errors.Wrapf(err, "%s is invalid extended public key", string(extendedPublicKey))
+				}
+			}
+
+			fmt.Println()
+
+			extendedPublicKeys = append(extendedPublicKeys, string(extendedPublicKey))
 		}
-
-		fmt.Println()
-
-		extendedPublicKeys = append(extendedPublicKeys, string(extendedPublicKey))
 	}
 
 	// For a read only wallet the cosigner index is 0
@@ -88,6 +110,12 @@ func create(conf *createConfig) error {
 		return err
 	}
 
+	if conf.WatchOnly {
+		fmt.Printf("Wrote the extended public keys of a watch-only wallet into %s. "+
+			"Its transactions have to be signed elsewhere, e.g. with \"kobrawallet sign\" on a machine "+
+			"that has the private keys\n", file.Path())
+		return nil
+	}
 	fmt.Printf("Wrote the keys into %s\n", file.Path())
 	return nil
 }
@@ -7,6 +7,7 @@ import (
 	"os"
 	"github.com/kobradag/kobrad/cmd/kobrawallet/daemon/client"
 	"github.com/kobradag/kobrad/cmd/kobrawallet/daemon/pb"
+	"github.com/kobradag/kobrad/cmd/kobrawallet/libkobrawallet"
 )
 
 func createUnsignedTransaction(conf *createUnsignedTransactionConfig) error {
@@ -19,24 +20,48 @@ func createUnsignedTransaction(conf *createUnsignedTransactionConfig) error {
 	ctx, cancel := context.WithTimeout(context.Background(), daemonTimeout)
 	defer cancel()
 
-	sendAmountLeor, err := utils.KobraToLeor(conf.SendAmount)
+	var sendAmountLeor uint64
+	var payments []*pb.Payment
+	if conf.PaymentsFile != "" {
+		payments, err = readPaymentsFile(conf.PaymentsFile)
+		if err != nil {
+			return err
+		}
+	} else if !conf.IsSendAll {
+		sendAmountLeor, err = utils.KobraToLeor(conf.SendAmount)
+		if err != nil {
+			return err
+		}
+	}
+
+	inputs, err := parseOutpoints(conf.UTXOs)
 	if err != nil {
 		return err
 	}
-	
+
 	response, err := daemonClient.CreateUnsignedTransactions(ctx, &pb.CreateUnsignedTransactionsRequest{
 		From:                     conf.FromAddresses,
+		Inputs:                   inputs,
 		Address:                  conf.ToAddress,
 		Amount:                   sendAmountLeor,
 		IsSendAll:                conf.IsSendAll,
+		Payments:                 payments,
 		UseExistingChangeAddress: conf.UseExistingChangeAddress,
 	})
 	if err != nil {
 		return err
 	}
 
+	unsignedPSKTs := make([][]byte, len(response.UnsignedTransactions))
+	for i, unsignedTransaction := range response.UnsignedTransactions {
+		unsignedPSKTs[i], err = libkobrawallet.PartiallySignedTransactionToPSKT(unsignedTransaction)
+		if err != nil {
+			return err
+		}
+	}
+
 	fmt.Fprintln(os.Stderr, "Created unsigned transaction")
-	fmt.Println(encodeTransactionsToHex(response.UnsignedTransactions))
+	fmt.Println(encodeTransactionsToHex(unsignedPSKTs))
 
 	return nil
 }
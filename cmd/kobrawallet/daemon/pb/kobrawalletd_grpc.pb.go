@@ -34,6 +34,13 @@ type KobrawalletdClient interface {
 	// Since SignRequest contains a password - this command should only be used on a trusted or secure connection
 	Sign(ctx context.Context, in *SignRequest, opts ...grpc.CallOption) (*SignResponse, error)
 	GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*GetVersionResponse, error)
+	BumpFee(ctx context.Context, in *BumpFeeRequest, opts ...grpc.CallOption) (*BumpFeeResponse, error)
+	BroadcastReplacement(ctx context.Context, in *BroadcastRequest, opts ...grpc.CallOption) (*BroadcastResponse, error)
+	GetTransactionHistory(ctx context.Context, in *GetTransactionHistoryRequest, opts ...grpc.CallOption) (*GetTransactionHistoryResponse, error)
+	SetLabel(ctx context.Context, in *SetLabelRequest, opts ...grpc.CallOption) (*SetLabelResponse, error)
+	ListUTXOs(ctx context.Context, in *ListUTXOsRequest, opts ...grpc.CallOption) (*ListUTXOsResponse, error)
+	FreezeUTXOs(ctx context.Context, in *FreezeUTXOsRequest, opts ...grpc.CallOption) (*FreezeUTXOsResponse, error)
+	UnfreezeUTXOs(ctx context.Context, in *UnfreezeUTXOsRequest, opts ...grpc.CallOption) (*UnfreezeUTXOsResponse, error)
 }
 
 type kobrawalletdClient struct {
@@ -134,6 +141,69 @@ func (c *kobrawalletdClient) GetVersion(ctx context.Context, in *GetVersionReque
 	return out, nil
 }
 
+func (c *kobrawalletdClient) BumpFee(ctx context.Context, in *BumpFeeRequest, opts ...grpc.CallOption) (*BumpFeeResponse, error) {
+	out := new(BumpFeeResponse)
+	err := c.cc.Invoke(ctx, "/kobrawalletd.kobrawalletd/BumpFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kobrawalletdClient) BroadcastReplacement(ctx context.Context, in *BroadcastRequest, opts ...grpc.CallOption) (*BroadcastResponse, error) {
+	out := new(BroadcastResponse)
+	err := c.cc.Invoke(ctx, "/kobrawalletd.kobrawalletd/BroadcastReplacement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kobrawalletdClient) GetTransactionHistory(ctx context.Context, in *GetTransactionHistoryRequest, opts ...grpc.CallOption) (*GetTransactionHistoryResponse, error) {
+	out := new(GetTransactionHistoryResponse)
+	err := c.cc.Invoke(ctx, "/kobrawalletd.kobrawalletd/GetTransactionHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kobrawalletdClient) SetLabel(ctx context.Context, in *SetLabelRequest, opts ...grpc.CallOption) (*SetLabelResponse, error) {
+	out := new(SetLabelResponse)
+	err := c.cc.Invoke(ctx, "/kobrawalletd.kobrawalletd/SetLabel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kobrawalletdClient) ListUTXOs(ctx context.Context, in *ListUTXOsRequest, opts ...grpc.CallOption) (*ListUTXOsResponse, error) {
+	out := new(ListUTXOsResponse)
+	err := c.cc.Invoke(ctx, "/kobrawalletd.kobrawalletd/ListUTXOs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kobrawalletdClient) FreezeUTXOs(ctx context.Context, in *FreezeUTXOsRequest, opts ...grpc.CallOption) (*FreezeUTXOsResponse, error) {
+	out := new(FreezeUTXOsResponse)
+	err := c.cc.Invoke(ctx, "/kobrawalletd.kobrawalletd/FreezeUTXOs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kobrawalletdClient) UnfreezeUTXOs(ctx context.Context, in *UnfreezeUTXOsRequest, opts ...grpc.CallOption) (*UnfreezeUTXOsResponse, error) {
+	out := new(UnfreezeUTXOsResponse)
+	err := c.cc.Invoke(ctx, "/kobrawalletd.kobrawalletd/UnfreezeUTXOs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // KobrawalletdServer is the server API for Kobrawalletd service.
 // All implementations must embed UnimplementedKobrawalletdServer
 // for forward compatibility
@@ -150,6 +220,13 @@ type KobrawalletdServer interface {
 	// Since SignRequest contains a password - this command should only be used on a trusted or secure connection
 	Sign(context.Context, *SignRequest) (*SignResponse, error)
 	GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error)
+	BumpFee(context.Context, *BumpFeeRequest) (*BumpFeeResponse, error)
+	BroadcastReplacement(context.Context, *BroadcastRequest) (*BroadcastResponse, error)
+	GetTransactionHistory(context.Context, *GetTransactionHistoryRequest) (*GetTransactionHistoryResponse, error)
+	SetLabel(context.Context, *SetLabelRequest) (*SetLabelResponse, error)
+	ListUTXOs(context.Context, *ListUTXOsRequest) (*ListUTXOsResponse, error)
+	FreezeUTXOs(context.Context, *FreezeUTXOsRequest) (*FreezeUTXOsResponse, error)
+	UnfreezeUTXOs(context.Context, *UnfreezeUTXOsRequest) (*UnfreezeUTXOsResponse, error)
 	mustEmbedUnimplementedKobrawalletdServer()
 }
 
@@ -187,6 +264,27 @@ func (UnimplementedKobrawalletdServer) Sign(context.Context, *SignRequest) (*Sig
 func (UnimplementedKobrawalletdServer) GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVersion not implemented")
 }
+func (UnimplementedKobrawalletdServer) BumpFee(context.Context, *BumpFeeRequest) (*BumpFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BumpFee not implemented")
+}
+func (UnimplementedKobrawalletdServer) BroadcastReplacement(context.Context, *BroadcastRequest) (*BroadcastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BroadcastReplacement not implemented")
+}
+func (UnimplementedKobrawalletdServer) GetTransactionHistory(context.Context, *GetTransactionHistoryRequest) (*GetTransactionHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionHistory not implemented")
+}
+func (UnimplementedKobrawalletdServer) SetLabel(context.Context, *SetLabelRequest) (*SetLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLabel not implemented")
+}
+func (UnimplementedKobrawalletdServer) ListUTXOs(context.Context, *ListUTXOsRequest) (*ListUTXOsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUTXOs not implemented")
+}
+func (UnimplementedKobrawalletdServer) FreezeUTXOs(context.Context, *FreezeUTXOsRequest) (*FreezeUTXOsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeUTXOs not implemented")
+}
+func (UnimplementedKobrawalletdServer) UnfreezeUTXOs(context.Context, *UnfreezeUTXOsRequest) (*UnfreezeUTXOsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeUTXOs not implemented")
+}
 func (UnimplementedKobrawalletdServer) mustEmbedUnimplementedKobrawalletdServer() {}
 
 // UnsafeKobrawalletdServer may be embedded to opt out of forward compatibility for this service.
@@ -380,6 +478,132 @@ func _Kobrawalletd_GetVersion_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Kobrawalletd_BumpFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BumpFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KobrawalletdServer).BumpFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kobrawalletd.kobrawalletd/BumpFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KobrawalletdServer).BumpFee(ctx, req.(*BumpFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kobrawalletd_BroadcastReplacement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BroadcastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KobrawalletdServer).BroadcastReplacement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kobrawalletd.kobrawalletd/BroadcastReplacement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KobrawalletdServer).BroadcastReplacement(ctx, req.(*BroadcastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kobrawalletd_GetTransactionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KobrawalletdServer).GetTransactionHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kobrawalletd.kobrawalletd/GetTransactionHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KobrawalletdServer).GetTransactionHistory(ctx, req.(*GetTransactionHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kobrawalletd_SetLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KobrawalletdServer).SetLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kobrawalletd.kobrawalletd/SetLabel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KobrawalletdServer).SetLabel(ctx, req.(*SetLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kobrawalletd_ListUTXOs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUTXOsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KobrawalletdServer).ListUTXOs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kobrawalletd.kobrawalletd/ListUTXOs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KobrawalletdServer).ListUTXOs(ctx, req.(*ListUTXOsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kobrawalletd_FreezeUTXOs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FreezeUTXOsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KobrawalletdServer).FreezeUTXOs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kobrawalletd.kobrawalletd/FreezeUTXOs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KobrawalletdServer).FreezeUTXOs(ctx, req.(*FreezeUTXOsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kobrawalletd_UnfreezeUTXOs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfreezeUTXOsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KobrawalletdServer).UnfreezeUTXOs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kobrawalletd.kobrawalletd/UnfreezeUTXOs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KobrawalletdServer).UnfreezeUTXOs(ctx, req.(*UnfreezeUTXOsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Kobrawalletd_ServiceDesc is the grpc.ServiceDesc for Kobrawalletd service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -423,10 +647,38 @@ var Kobrawalletd_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Sign",
 			Handler:    _Kobrawalletd_Sign_Handler,
 		},
-	        {
+		{
 			MethodName: "GetVersion",
 			Handler:    _Kobrawalletd_GetVersion_Handler,
 		},
+		{
+			MethodName: "BumpFee",
+			Handler:    _Kobrawalletd_BumpFee_Handler,
+		},
+		{
+			MethodName: "BroadcastReplacement",
+			Handler:    _Kobrawalletd_BroadcastReplacement_Handler,
+		},
+		{
+			MethodName: "GetTransactionHistory",
+			Handler:    _Kobrawalletd_GetTransactionHistory_Handler,
+		},
+		{
+			MethodName: "SetLabel",
+			Handler:    _Kobrawalletd_SetLabel_Handler,
+		},
+		{
+			MethodName: "ListUTXOs",
+			Handler:    _Kobrawalletd_ListUTXOs_Handler,
+		},
+		{
+			MethodName: "FreezeUTXOs",
+			Handler:    _Kobrawalletd_FreezeUTXOs_Handler,
+		},
+		{
+			MethodName: "UnfreezeUTXOs",
+			Handler:    _Kobrawalletd_UnfreezeUTXOs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kobrawalletd.proto",
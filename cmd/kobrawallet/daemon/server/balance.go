@@ -24,8 +24,13 @@ func (s *server) GetBalance(_ context.Context, _ *pb.GetBalanceRequest) (*pb.Get
 	}
 	daaScore := dagInfo.VirtualDAAScore
 	balancesMap := make(balancesMapType, 0)
+	var frozen uint64
 	for _, entry := range s.utxosSortedByAmount {
 		amount := entry.UTXOEntry.Amount()
+		if s.frozenOutpoints.contains(entry.Outpoint) {
+			frozen += amount
+			continue
+		}
 		address := entry.address
 		balances, ok := balancesMap[address]
 		if !ok {
@@ -62,6 +67,7 @@ func (s *server) GetBalance(_ context.Context, _ *pb.GetBalanceRequest) (*pb.Get
 		Available:       available,
 		Pending:         pending,
 		AddressBalances: addressBalances,
+		Frozen:          frozen,
 	}, nil
 }
 
@@ -0,0 +1,160 @@
+package server
+
+import (
+	"github.com/kobradag/kobrad/cmd/kobrawallet/daemon/pb"
+	"github.com/kobradag/kobrad/cmd/kobrawallet/libkobrawallet"
+	"github.com/kobradag/kobrad/cmd/kobrawallet/libkobrawallet/serialization"
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/domain/consensus/utils/consensushashing"
+	"github.com/kobradag/kobrad/domain/consensus/utils/constants"
+	"github.com/kobradag/kobrad/domain/consensus/utils/utxo"
+	"github.com/kobradag/kobrad/domain/miningmanager/mempool"
+	"github.com/kobradag/kobrad/util"
+	"github.com/pkg/errors"
+)
+
+// maxPaymentsMassPerTransaction is the mass the payment outputs of a single
+// transaction may add up to. The rest of the mass of a standard transaction
+// is left for its inputs.
+const maxPaymentsMassPerTransaction = mempool.MaximumStandardTransactionMass / 2
+
+func (s *server) paymentsFromProto(protoPayments []*pb.Payment) ([]*libkobrawallet.Payment, error) {
+	payments := make([]*libkobrawallet.Payment, len(protoPayments))
+	for i, protoPayment := range protoPayments {
+		address, err := util.DecodeAddress(protoPayment.Address, s.params.Prefix)
+		if err != nil {
+			return nil, errors.Wrapf(err, "payment %d", i)
+		}
+		if protoPayment.Amount == 0 {
+			return nil, errors.Errorf("payment %d to %s has no amount", i, protoPayment.Address)
+		}
+		payments[i] = &libkobrawallet.Payment{
+			Address: address,
+			Amount:  protoPayment.Amount,
+		}
+	}
+	return payments, nil
+}
+
+// createBatchUnsignedTransactions creates transactions that pay all of the given payments.
+// The payments are divided into chunks whose outputs fit in a standard transaction. The
+// first transaction pays the first chunk out of the wallet UTXOs, and sends the funds of
+// the rest of the chunks to the change address. Every following transaction spends the
+// change of the transaction before it, pays the next chunk, and sends the rest to the
+// change address again.
+func (s *server) createBatchUnsignedTransactions(payments []*libkobrawallet.Payment, fromAddresses []*walletAddress,
+	inputs []*pb.Outpoint, useExistingChangeAddress bool) ([][]byte, error) {
+
+	feePerInput := s.estimateFeePerInput()
+	feePerOutput := s.feePerOutput(feePerInput)
+	chunks := chunkPayments(payments, s.estimatedMassPerOutput())
+
+	// The fee of every input covers two outputs, so every chunk pays for the
+	// rest of its outputs, including its change output. The chunks after the
+	// first one also pay for the input that spends the previous change.
+	chunkAmounts := make([]uint64, len(chunks))
+	chunkFees := make([]uint64, len(chunks))
+	totalAmount := uint64(0)
+	for i, chunk := range chunks {
+		for _, payment := range chunk {
+			chunkAmounts[i] += payment.Amount
+		}
+		chunkFees[i] = feePerOutput * uint64(len(chunk)-1)
+		if i > 0 {
+			chunkFees[i] += feePerInput
+		}
+		totalAmount += chunkAmounts[i] + chunkFees[i]
+	}
+
+	selectedUTXOs, spendValue, changeLeor, err := s.selectUTXOsToSpend(inputs, totalAmount, false, feePerInput, fromAddresses)
+	if err != nil {
+		return nil, err
+	}
+
+	changeAddress, changeWalletAddress, err := s.changeAddress(useExistingChangeAddress, fromAddresses)
+	if err != nil {
+		return nil, err
+	}
+
+	firstChange := spendValue - chunkAmounts[0] - chunkFees[0] + changeLeor
+	unsignedTransaction, err := libkobrawallet.CreateUnsignedTransaction(s.keysFile.ExtendedPublicKeys,
+		s.keysFile.MinimumSignatures, withChange(chunks[0], changeAddress, firstChange), selectedUTXOs)
+	if err != nil {
+		return nil, err
+	}
+	unsignedTransactions, err := s.maybeAutoCompoundTransaction(unsignedTransaction, chunks[0], changeAddress,
+		changeWalletAddress, feePerInput, len(inputs) > 0)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := 1; i < len(chunks); i++ {
+		previousTransaction, err := serialization.DeserializePartiallySignedTransaction(
+			unsignedTransactions[len(unsignedTransactions)-1])
+		if err != nil {
+			return nil, err
+		}
+
+		// The change output always comes after the payments
+		changeIndex := len(chunks[i-1])
+		if changeIndex >= len(previousTransaction.Tx.Outputs) {
+			return nil, errors.Errorf("the transaction paying payment chunk %d has no change to pay the rest of "+
+				"the chunks from", i-1)
+		}
+		changeOutput := previousTransaction.Tx.Outputs[changeIndex]
+		required := chunkAmounts[i] + chunkFees[i]
+		if changeOutput.Value < required {
+			return nil, errors.Errorf("Insufficient funds for payment chunk %d: %f required, while only %f available",
+				i, float64(required)/constants.LeorPerKobra, float64(changeOutput.Value)/constants.LeorPerKobra)
+		}
+
+		previousChange := &libkobrawallet.UTXO{
+			Outpoint: &externalapi.DomainOutpoint{
+				TransactionID: *consensushashing.TransactionID(previousTransaction.Tx),
+				Index:         uint32(changeIndex),
+			},
+			UTXOEntry:      utxo.NewUTXOEntry(changeOutput.Value, changeOutput.ScriptPublicKey, false, constants.UnacceptedDAAScore),
+			DerivationPath: s.walletAddressPath(changeWalletAddress),
+		}
+		chainedTransaction, err := libkobrawallet.CreateUnsignedTransaction(s.keysFile.ExtendedPublicKeys,
+			s.keysFile.MinimumSignatures, withChange(chunks[i], changeAddress, changeOutput.Value-required),
+			[]*libkobrawallet.UTXO{previousChange})
+		if err != nil {
+			return nil, err
+		}
+		unsignedTransactions = append(unsignedTransactions, chainedTransaction)
+	}
+
+	return unsignedTransactions, nil
+}
+
+// chunkPayments divides the given payments into chunks whose outputs fit in
+// maxPaymentsMassPerTransaction, along with a change output
+func chunkPayments(payments []*libkobrawallet.Payment, massPerOutput uint64) [][]*libkobrawallet.Payment {
+	paymentsPerChunk := int(maxPaymentsMassPerTransaction/massPerOutput) - 1
+	if paymentsPerChunk < 1 {
+		paymentsPerChunk = 1
+	}
+
+	chunks := make([][]*libkobrawallet.Payment, 0, (len(payments)+paymentsPerChunk-1)/paymentsPerChunk)
+	for start := 0; start < len(payments); start += paymentsPerChunk {
+		end := start + paymentsPerChunk
+		if end > len(payments) {
+			end = len(payments)
+		}
+		chunks = append(chunks, payments[start:end:end])
+	}
+	return chunks
+}
+
+// withChange returns the given payments followed by a change payment, if
+// there's any change
+func withChange(payments []*libkobrawallet.Payment, changeAddress util.Address, change uint64) []*libkobrawallet.Payment {
+	if change == 0 {
+		return payments
+	}
+	return append(payments[:len(payments):len(payments)], &libkobrawallet.Payment{
+		Address: changeAddress,
+		Amount:  change,
+	})
+}
@@ -0,0 +1,321 @@
+package server
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/kobradag/kobrad/app/appmessage"
+	"github.com/kobradag/kobrad/cmd/kobrawallet/keys"
+	"github.com/kobradag/kobrad/cmd/kobrawallet/libkobrawallet"
+	"github.com/kobradag/kobrad/cmd/kobrawallet/libkobrawallet/serialization"
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/domain/consensus/utils/consensushashing"
+	"github.com/kobradag/kobrad/domain/consensus/utils/txscript"
+	"github.com/kobradag/kobrad/domain/consensus/utils/utxo"
+	"github.com/kobradag/kobrad/domain/dagconfig"
+	"github.com/kobradag/kobrad/infrastructure/network/netadapter/router"
+	netadapterserver "github.com/kobradag/kobrad/infrastructure/network/netadapter/server"
+	"github.com/kobradag/kobrad/infrastructure/network/netadapter/server/grpcserver"
+	"github.com/kobradag/kobrad/infrastructure/network/rpcclient"
+	"github.com/kobradag/kobrad/util"
+	"github.com/kobradag/kobrad/util/txmass"
+)
+
+func TestChunkPayments(t *testing.T) {
+	payments := make([]*libkobrawallet.Payment, 10)
+	for i := range payments {
+		payments[i] = &libkobrawallet.Payment{Amount: uint64(i + 1)}
+	}
+
+	// Three payments and a change output fit in a chunk
+	massPerOutput := uint64(maxPaymentsMassPerTransaction / 4)
+	chunks := chunkPayments(payments, massPerOutput)
+	expectedChunkLengths := []int{3, 3, 3, 1}
+	if len(chunks) != len(expectedChunkLengths) {
+		t.Fatalf("Expected %d chunks but got %d", len(expectedChunkLengths), len(chunks))
+	}
+	nextAmount := uint64(1)
+	for i, chunk := range chunks {
+		if len(chunk) != expectedChunkLengths[i] {
+			t.Fatalf("Expected chunk %d to have %d payments but got %d", i, expectedChunkLengths[i], len(chunk))
+		}
+		for _, payment := range chunk {
+			if payment.Amount != nextAmount {
+				t.Fatalf("Expected payment of %d but got %d", nextAmount, payment.Amount)
+			}
+			nextAmount++
+		}
+	}
+
+	// Every chunk pays at least one payment, even if its outputs are too massive
+	chunks = chunkPayments(payments, maxPaymentsMassPerTransaction)
+	if len(chunks) != len(payments) {
+		t.Fatalf("Expected %d chunks but got %d", len(payments), len(chunks))
+	}
+}
+
+func TestWithChange(t *testing.T) {
+	changeAddress, err := util.NewAddressPublicKey(make([]byte, 32), util.Bech32PrefixKobraSim)
+	if err != nil {
+		t.Fatalf("NewAddressPublicKey: %+v", err)
+	}
+
+	payments := make([]*libkobrawallet.Payment, 2, 3)
+	payments[0] = &libkobrawallet.Payment{Amount: 1}
+	payments[1] = &libkobrawallet.Payment{Amount: 2}
+
+	if withoutChange := withChange(payments, changeAddress, 0); len(withoutChange) != 2 {
+		t.Fatalf("Expected no change output but got %d outputs", len(withoutChange))
+	}
+
+	withChangeOutput := withChange(payments, changeAddress, 3)
+	if len(withChangeOutput) != 3 || withChangeOutput[2].Amount != 3 || withChangeOutput[2].Address != changeAddress {
+		t.Fatalf("Unexpected payments with change %v", withChangeOutput)
+	}
+
+	// The change output must not be written into the spare capacity of the
+	// given payments, which are shared with the caller
+	otherChange := withChange(payments, changeAddress, 4)
+	if withChangeOutput[2].Amount != 3 || otherChange[2].Amount != 4 {
+		t.Fatalf("Change outputs of the same payments overwrote each other")
+	}
+}
+
+// startFakeNode starts an RPC server that answers the requests the wallet
+// makes while creating transactions, and returns a client connected to it
+func startFakeNode(t *testing.T, feeRate float64, virtualDAAScore uint64) (*rpcclient.RPCClient, func()) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen: %+v", err)
+	}
+	address := listener.Addr().String()
+	err = listener.Close()
+	if err != nil {
+		t.Fatalf("Close: %+v", err)
+	}
+
+	rpcServer, err := grpcserver.NewRPCServer([]string{address}, 1)
+	if err != nil {
+		t.Fatalf("NewRPCServer: %+v", err)
+	}
+	rpcServer.SetOnConnectedHandler(func(connection netadapterserver.Connection) error {
+		nodeRouter := router.NewRouter("fake node")
+		incomingRoute, err := nodeRouter.AddIncomingRoute("fake node", []appmessage.MessageCommand{
+			appmessage.CmdGetInfoRequestMessage,
+			appmessage.CmdGetFeeEstimateRequestMessage,
+			appmessage.CmdGetBlockDAGInfoRequestMessage,
+		})
+		if err != nil {
+			return err
+		}
+		go func() {
+			for {
+				request, err := incomingRoute.Dequeue()
+				if err != nil {
+					return
+				}
+				var response appmessage.Message
+				switch request.Command() {
+				case appmessage.CmdGetInfoRequestMessage:
+					response = appmessage.NewGetInfoResponseMessage("", 0, "", true, true)
+				case appmessage.CmdGetFeeEstimateRequestMessage:
+					bucket := appmessage.RPCFeeRateBucket{FeeRate: feeRate}
+					response = &appmessage.GetFeeEstimateResponseMessage{Estimate: &appmessage.RPCFeeEstimate{
+						PriorityBucket: bucket,
+						NormalBucket:   bucket,
+						LowBucket:      bucket,
+					}}
+				case appmessage.CmdGetBlockDAGInfoRequestMessage:
+					response = &appmessage.GetBlockDAGInfoResponseMessage{VirtualDAAScore: virtualDAAScore}
+				}
+				err = nodeRouter.OutgoingRoute().Enqueue(response)
+				if err != nil {
+					return
+				}
+			}
+		}()
+		connection.SetOnDisconnectedHandler(nodeRouter.Close)
+		connection.Start(nodeRouter)
+		return nil
+	})
+	err = rpcServer.Start()
+	if err != nil {
+		t.Fatalf("Start: %+v", err)
+	}
+
+	client, err := rpcclient.NewRPCClient(address)
+	if err != nil {
+		t.Fatalf("NewRPCClient: %+v", err)
+	}
+	return client, func() {
+		err := client.Close()
+		if err != nil {
+			t.Fatalf("Close: %+v", err)
+		}
+		err = rpcServer.Stop()
+		if err != nil {
+			t.Fatalf("Stop: %+v", err)
+		}
+	}
+}
+
+func TestCreateBatchUnsignedTransactions(t *testing.T) {
+	params := &dagconfig.SimnetParams
+	const feeRate = 2
+	client, teardown := startFakeNode(t, feeRate, 1000)
+	defer teardown()
+
+	mnemonic, err := libkobrawallet.CreateMnemonic()
+	if err != nil {
+		t.Fatalf("CreateMnemonic: %+v", err)
+	}
+	publicKey, err := libkobrawallet.MasterPublicKeyFromMnemonic(params, mnemonic, false)
+	if err != nil {
+		t.Fatalf("MasterPublicKeyFromMnemonic: %+v", err)
+	}
+	serverInstance := &server{
+		rpcClient:        client,
+		params:           params,
+		keysFile:         &keys.File{ExtendedPublicKeys: []string{publicKey}, MinimumSignatures: 1},
+		addressSet:       make(walletAddressSet),
+		txMassCalculator: txmass.NewCalculator(params.MassPerTxByte, params.MassPerScriptPubKeyByte, params.MassPerSigOp),
+		usedOutpoints:    make(map[externalapi.DomainOutpoint]time.Time),
+		frozenOutpoints:  &frozenOutpoints{outpoints: make(map[externalapi.DomainOutpoint]struct{})},
+	}
+
+	fundingAddress := &walletAddress{index: 1, keyChain: libkobrawallet.ExternalKeychain}
+	fundingUtilAddress, err := libkobrawallet.Address(params, serverInstance.keysFile.ExtendedPublicKeys,
+		serverInstance.keysFile.MinimumSignatures, serverInstance.walletAddressPath(fundingAddress), false)
+	if err != nil {
+		t.Fatalf("Address: %+v", err)
+	}
+	fundingScriptPublicKey, err := txscript.PayToAddrScript(fundingUtilAddress)
+	if err != nil {
+		t.Fatalf("PayToAddrScript: %+v", err)
+	}
+	for i, amount := range []uint64{200_000_000_000, 100_000_000_000} {
+		serverInstance.utxosSortedByAmount = append(serverInstance.utxosSortedByAmount, &walletUTXO{
+			Outpoint: externalapi.NewDomainOutpoint(
+				externalapi.NewDomainTransactionIDFromByteArray(&[externalapi.DomainHashSize]byte{byte(i + 1)}), 0),
+			UTXOEntry: utxo.NewUTXOEntry(amount, fundingScriptPublicKey, false, 0),
+			address:   fundingAddress,
+		})
+	}
+	inputsValue := uint64(300_000_000_000)
+
+	// Enough payments for three chunks, the last of which is partial
+	massPerOutput := serverInstance.estimatedMassPerOutput()
+	paymentsPerChunk := int(maxPaymentsMassPerTransaction/massPerOutput) - 1
+	payments := make([]*libkobrawallet.Payment, 2*paymentsPerChunk+paymentsPerChunk/2)
+	for i := range payments {
+		publicKey := make([]byte, 32)
+		publicKey[0], publicKey[1] = byte(i), byte(i>>8)
+		address, err := util.NewAddressPublicKey(publicKey, params.Prefix)
+		if err != nil {
+			t.Fatalf("NewAddressPublicKey: %+v", err)
+		}
+		payments[i] = &libkobrawallet.Payment{Address: address, Amount: uint64(100_000_000 + i)}
+	}
+	chunks := chunkPayments(payments, massPerOutput)
+	if len(chunks) != 3 {
+		t.Fatalf("Expected 3 chunks but got %d", len(chunks))
+	}
+
+	unsignedTransactions, err := serverInstance.createBatchUnsignedTransactions(payments, nil, nil, true)
+	if err != nil {
+		t.Fatalf("createBatchUnsignedTransactions: %+v", err)
+	}
+	if len(unsignedTransactions) != len(chunks) {
+		t.Fatalf("Expected %d transactions but got %d", len(chunks), len(unsignedTransactions))
+	}
+
+	changeAddress, _, err := serverInstance.changeAddress(true, nil)
+	if err != nil {
+		t.Fatalf("changeAddress: %+v", err)
+	}
+	changeScriptPublicKey, err := txscript.PayToAddrScript(changeAddress)
+	if err != nil {
+		t.Fatalf("PayToAddrScript: %+v", err)
+	}
+
+	feePerInput := serverInstance.estimateFeePerInput()
+	feePerOutput := serverInstance.feePerOutput(feePerInput)
+	totalPaid := uint64(0)
+	totalFees := uint64(0)
+	var previousTransaction *serialization.PartiallySignedTransaction
+	for i, transactionBytes := range unsignedTransactions {
+		transaction, err := serialization.DeserializePartiallySignedTransaction(transactionBytes)
+		if err != nil {
+			t.Fatalf("DeserializePartiallySignedTransaction: %+v", err)
+		}
+		chunk := chunks[i]
+
+		// Every transaction pays its chunk in order, followed by the change
+		if len(transaction.Tx.Outputs) != len(chunk)+1 {
+			t.Fatalf("Expected transaction %d to have %d outputs but got %d",
+				i, len(chunk)+1, len(transaction.Tx.Outputs))
+		}
+		outputsValue := uint64(0)
+		for j, payment := range chunk {
+			output := transaction.Tx.Outputs[j]
+			scriptPublicKey, err := txscript.PayToAddrScript(payment.Address)
+			if err != nil {
+				t.Fatalf("PayToAddrScript: %+v", err)
+			}
+			if output.Value != payment.Amount || !output.ScriptPublicKey.Equal(scriptPublicKey) {
+				t.Fatalf("Output %d of transaction %d doesn't pay payment %d of its chunk", j, i, j)
+			}
+			outputsValue += output.Value
+			totalPaid += output.Value
+		}
+		changeOutput := transaction.Tx.Outputs[len(chunk)]
+		if !changeOutput.ScriptPublicKey.Equal(changeScriptPublicKey) {
+			t.Fatalf("The last output of transaction %d doesn't pay the change address", i)
+		}
+		outputsValue += changeOutput.Value
+
+		// The first transaction spends the UTXOs of the wallet, and every
+		// following one spends the change of the transaction before it
+		expectedFee := feePerOutput * uint64(len(chunk)-1)
+		inputValue := uint64(0)
+		if i == 0 {
+			if len(transaction.Tx.Inputs) != len(serverInstance.utxosSortedByAmount) {
+				t.Fatalf("Expected the first transaction to spend %d UTXOs but it spends %d",
+					len(serverInstance.utxosSortedByAmount), len(transaction.Tx.Inputs))
+			}
+			inputValue = inputsValue
+			expectedFee += feePerInput * uint64(len(transaction.Tx.Inputs))
+		} else {
+			expectedOutpoint := externalapi.DomainOutpoint{
+				TransactionID: *consensushashing.TransactionID(previousTransaction.Tx),
+				Index:         uint32(len(chunks[i-1])),
+			}
+			if len(transaction.Tx.Inputs) != 1 || transaction.Tx.Inputs[0].PreviousOutpoint != expectedOutpoint {
+				t.Fatalf("Expected transaction %d to spend only the change of transaction %d", i, i-1)
+			}
+			inputValue = previousTransaction.Tx.Outputs[len(chunks[i-1])].Value
+			expectedFee += feePerInput
+		}
+		fee := inputValue - outputsValue
+		if fee != expectedFee {
+			t.Fatalf("Expected transaction %d to pay a fee of %d but it pays %d", i, expectedFee, fee)
+		}
+		totalFees += fee
+
+		massAfterSignatures, err := serverInstance.estimateMassAfterSignatures(transaction)
+		if err != nil {
+			t.Fatalf("estimateMassAfterSignatures: %+v", err)
+		}
+		if fee < feeRate*massAfterSignatures {
+			t.Fatalf("The fee %d of transaction %d is below the fee rate for its mass %d", fee, i, massAfterSignatures)
+		}
+		previousTransaction = transaction
+	}
+
+	// Whatever isn't paid or spent on fees comes back as the last change
+	lastChange := previousTransaction.Tx.Outputs[len(chunks[len(chunks)-1])].Value
+	if inputsValue-totalPaid-totalFees != lastChange {
+		t.Fatalf("Expected a last change of %d but got %d", inputsValue-totalPaid-totalFees, lastChange)
+	}
+}
@@ -25,7 +25,24 @@ func (s *server) Broadcast(_ context.Context, request *pb.BroadcastRequest) (*pb
 	return &pb.BroadcastResponse{TxIDs: txIDs}, nil
 }
 
+func (s *server) BroadcastReplacement(_ context.Context, request *pb.BroadcastRequest) (*pb.BroadcastResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	txIDs, err := s.broadcastWith(request.Transactions, request.IsDomain, sendTransactionReplacement)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.BroadcastResponse{TxIDs: txIDs}, nil
+}
+
 func (s *server) broadcast(transactions [][]byte, isDomain bool) ([]string, error) {
+	return s.broadcastWith(transactions, isDomain, sendTransaction)
+}
+
+func (s *server) broadcastWith(transactions [][]byte, isDomain bool,
+	send func(client *rpcclient.RPCClient, tx *externalapi.DomainTransaction) (string, error)) ([]string, error) {
 
 	txIDs := make([]string, len(transactions))
 	var tx *externalapi.DomainTransaction
@@ -45,7 +62,7 @@ func (s *server) broadcast(transactions [][]byte, isDomain bool) ([]string, erro
 			}
 		}
 
-		txIDs[i], err = sendTransaction(s.rpcClient, tx)
+		txIDs[i], err = send(s.rpcClient, tx)
 		if err != nil {
 			return nil, err
 		}
@@ -66,3 +83,11 @@ func sendTransaction(client *rpcclient.RPCClient, tx *externalapi.DomainTransact
 	}
 	return submitTransactionResponse.TransactionID, nil
 }
+
+func sendTransactionReplacement(client *rpcclient.RPCClient, tx *externalapi.DomainTransaction) (string, error) {
+	submitTransactionReplacementResponse, err := client.SubmitTransactionReplacement(appmessage.DomainTransactionToRPCTransaction(tx))
+	if err != nil {
+		return "", errors.Wrapf(err, "error submitting transaction replacement")
+	}
+	return submitTransactionReplacementResponse.TransactionID, nil
+}
@@ -0,0 +1,145 @@
+package server
+
+import (
+	"context"
+	"math"
+
+	"github.com/kobradag/kobrad/app/appmessage"
+	"github.com/kobradag/kobrad/cmd/kobrawallet/daemon/pb"
+	"github.com/kobradag/kobrad/cmd/kobrawallet/libkobrawallet"
+	"github.com/kobradag/kobrad/cmd/kobrawallet/libkobrawallet/serialization"
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/domain/consensus/utils/txscript"
+	"github.com/pkg/errors"
+)
+
+func (s *server) BumpFee(_ context.Context, request *pb.BumpFeeRequest) (*pb.BumpFeeResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	unsignedTransaction, err := s.bumpFee(request.TxID, request.FeeRate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.BumpFeeResponse{UnsignedTransaction: unsignedTransaction}, nil
+}
+
+// bumpFee creates an unsigned transaction that replaces the mempool transaction with the given ID.
+// The replacement spends the same inputs and pays the same outputs, except for the change output
+// which is reduced in order to pay the higher fee.
+// If feeRate is 0, the node's priority fee rate estimate is used.
+func (s *server) bumpFee(txID string, feeRate float64) ([]byte, error) {
+	mempoolEntryResponse, err := s.rpcClient.GetMempoolEntry(txID, false, false)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not find transaction %s in the mempool", txID)
+	}
+	originalFee := mempoolEntryResponse.Entry.Fee
+	originalTransaction, err := appmessage.RPCTransactionToDomainTransaction(mempoolEntryResponse.Entry.Transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	selectedUTXOs, err := s.walletUTXOsSpentBy(originalTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	payments, changeIndex, err := s.paymentsOf(originalTransaction)
+	if err != nil {
+		return nil, err
+	}
+	if changeIndex < 0 {
+		return nil, errors.Errorf("transaction %s has no change output to pay the fee increase from", txID)
+	}
+
+	if feeRate == 0 {
+		feeEstimateResponse, err := s.rpcClient.GetFeeEstimate()
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get a fee estimate from the node")
+		}
+		feeRate = feeEstimateResponse.Estimate.PriorityBucket.FeeRate
+	}
+
+	// The mass of the transaction doesn't depend on the output amounts, so it's
+	// enough to estimate it for the original payments
+	unsignedTransaction, err := libkobrawallet.CreateUnsignedTransaction(s.keysFile.ExtendedPublicKeys,
+		s.keysFile.MinimumSignatures, payments, selectedUTXOs)
+	if err != nil {
+		return nil, err
+	}
+	partiallySignedTransaction, err := serialization.DeserializePartiallySignedTransaction(unsignedTransaction)
+	if err != nil {
+		return nil, err
+	}
+	mass, err := s.estimateMassAfterSignatures(partiallySignedTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	newFee := uint64(math.Ceil(feeRate * float64(mass)))
+	if newFee <= originalFee {
+		return nil, errors.Errorf("a fee rate of %f leor/gram results in a fee of %d leor, which does not "+
+			"exceed the current fee of %d leor of transaction %s", feeRate, newFee, originalFee, txID)
+	}
+
+	feeIncrease := newFee - originalFee
+	change := payments[changeIndex]
+	if change.Amount <= feeIncrease {
+		return nil, errors.Errorf("the change output of transaction %s (%d leor) is too small to pay "+
+			"a fee increase of %d leor", txID, change.Amount, feeIncrease)
+	}
+	change.Amount -= feeIncrease
+
+	return libkobrawallet.CreateUnsignedTransaction(s.keysFile.ExtendedPublicKeys,
+		s.keysFile.MinimumSignatures, payments, selectedUTXOs)
+}
+
+// walletUTXOsSpentBy returns the wallet UTXOs spent by the inputs of the given transaction,
+// in the same order as the inputs
+func (s *server) walletUTXOsSpentBy(transaction *externalapi.DomainTransaction) ([]*libkobrawallet.UTXO, error) {
+	utxosByOutpoint := make(map[externalapi.DomainOutpoint]*walletUTXO, len(s.utxosSortedByAmount))
+	for _, utxo := range s.utxosSortedByAmount {
+		utxosByOutpoint[*utxo.Outpoint] = utxo
+	}
+
+	selectedUTXOs := make([]*libkobrawallet.UTXO, len(transaction.Inputs))
+	for i, input := range transaction.Inputs {
+		utxo, ok := utxosByOutpoint[input.PreviousOutpoint]
+		if !ok {
+			return nil, errors.Errorf("input %d of the transaction spends %s, which is not a "+
+				"known UTXO of this wallet", i, input.PreviousOutpoint)
+		}
+		selectedUTXOs[i] = &libkobrawallet.UTXO{
+			Outpoint:       utxo.Outpoint,
+			UTXOEntry:      utxo.UTXOEntry,
+			DerivationPath: s.walletAddressPath(utxo.address),
+		}
+	}
+	return selectedUTXOs, nil
+}
+
+// paymentsOf returns the payments made by the outputs of the given transaction,
+// along with the index of the payment to this wallet's change address. If there's
+// no such payment, the returned index is -1.
+func (s *server) paymentsOf(transaction *externalapi.DomainTransaction) (
+	payments []*libkobrawallet.Payment, changeIndex int, err error) {
+
+	changeIndex = -1
+	payments = make([]*libkobrawallet.Payment, len(transaction.Outputs))
+	for i, output := range transaction.Outputs {
+		_, address, err := txscript.ExtractScriptPubKeyAddress(output.ScriptPublicKey, s.params)
+		if err != nil {
+			return nil, 0, err
+		}
+		payments[i] = &libkobrawallet.Payment{
+			Address: address,
+			Amount:  output.Value,
+		}
+
+		if walletAddr, ok := s.addressSet[address.String()]; ok && walletAddr.keyChain == libkobrawallet.InternalKeychain {
+			changeIndex = i
+		}
+	}
+	return payments, changeIndex, nil
+}
@@ -0,0 +1,185 @@
+package server
+
+import (
+	"context"
+
+	"github.com/kobradag/kobrad/cmd/kobrawallet/daemon/pb"
+	"github.com/kobradag/kobrad/cmd/kobrawallet/libkobrawallet"
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/pkg/errors"
+)
+
+func (s *server) ListUTXOs(_ context.Context, request *pb.ListUTXOsRequest) (*pb.ListUTXOsResponse, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if !s.isSynced() {
+		return nil, errors.Errorf("wallet daemon is not synced yet, %s", s.formatSyncStateReport())
+	}
+
+	var addressFilter map[string]struct{}
+	if len(request.Addresses) > 0 {
+		addressFilter = make(map[string]struct{}, len(request.Addresses))
+		for _, address := range request.Addresses {
+			addressFilter[address] = struct{}{}
+		}
+	}
+
+	dagInfo, err := s.rpcClient.GetBlockDAGInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	utxos := make([]*pb.WalletUtxo, 0, len(s.utxosSortedByAmount))
+	for _, utxo := range s.utxosSortedByAmount {
+		address, err := s.walletAddressString(utxo.address)
+		if err != nil {
+			return nil, err
+		}
+		if addressFilter != nil {
+			if _, ok := addressFilter[address]; !ok {
+				continue
+			}
+		}
+
+		utxos = append(utxos, &pb.WalletUtxo{
+			Outpoint:      libkobrawallet.DomainOutpointToKobrawalletdOutpoint(utxo.Outpoint),
+			Address:       address,
+			Amount:        utxo.UTXOEntry.Amount(),
+			BlockDaaScore: utxo.UTXOEntry.BlockDAAScore(),
+			IsCoinbase:    utxo.UTXOEntry.IsCoinbase(),
+			IsMature:      s.isUTXOSpendable(utxo, dagInfo.VirtualDAAScore),
+			IsFrozen:      s.frozenOutpoints.contains(utxo.Outpoint),
+			IsUsed:        s.isOutpointUsed(utxo.Outpoint),
+		})
+	}
+
+	return &pb.ListUTXOsResponse{Utxos: utxos}, nil
+}
+
+func (s *server) FreezeUTXOs(_ context.Context, request *pb.FreezeUTXOsRequest) (*pb.FreezeUTXOsResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	outpoints, err := outpointsFromProto(request.Outpoints)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only UTXOs of the wallet can be frozen, so that a mistyped outpoint
+	// isn't silently ignored
+	walletOutpoints := make(map[externalapi.DomainOutpoint]struct{}, len(s.utxosSortedByAmount))
+	for _, utxo := range s.utxosSortedByAmount {
+		walletOutpoints[*utxo.Outpoint] = struct{}{}
+	}
+	for _, outpoint := range outpoints {
+		if _, ok := walletOutpoints[*outpoint]; !ok {
+			return nil, errors.Errorf("%s is not a UTXO of the wallet", outpoint)
+		}
+	}
+
+	for _, outpoint := range outpoints {
+		s.frozenOutpoints.add(outpoint)
+	}
+	err = s.frozenOutpoints.save()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.FreezeUTXOsResponse{}, nil
+}
+
+func (s *server) UnfreezeUTXOs(_ context.Context, request *pb.UnfreezeUTXOsRequest) (*pb.UnfreezeUTXOsResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	outpoints, err := outpointsFromProto(request.Outpoints)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, outpoint := range outpoints {
+		s.frozenOutpoints.remove(outpoint)
+	}
+	err = s.frozenOutpoints.save()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UnfreezeUTXOsResponse{}, nil
+}
+
+// isOutpointUsed returns whether the outpoint is spent by a transaction
+// this daemon broadcast, which wasn't accepted yet
+func (s *server) isOutpointUsed(outpoint *externalapi.DomainOutpoint) bool {
+	broadcastTime, ok := s.usedOutpoints[*outpoint]
+	return ok && !s.usedOutpointHasExpired(broadcastTime)
+}
+
+// selectExplicitUTXOs selects exactly the UTXOs of the given outpoints, for
+// spending spendAmount (or all of their value, if isSendAll) out of them.
+func (s *server) selectExplicitUTXOs(outpoints []*pb.Outpoint, spendAmount uint64, isSendAll bool, feePerInput uint64) (
+	selectedUTXOs []*libkobrawallet.UTXO, totalReceived uint64, changeLeor uint64, err error) {
+
+	domainOutpoints, err := outpointsFromProto(outpoints)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	dagInfo, err := s.rpcClient.GetBlockDAGInfo()
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	utxosByOutpoint := make(map[externalapi.DomainOutpoint]*walletUTXO, len(s.utxosSortedByAmount))
+	for _, utxo := range s.utxosSortedByAmount {
+		utxosByOutpoint[*utxo.Outpoint] = utxo
+	}
+
+	selectedUTXOs = make([]*libkobrawallet.UTXO, 0, len(domainOutpoints))
+	selectedOutpoints := make(map[externalapi.DomainOutpoint]struct{}, len(domainOutpoints))
+	totalValue := uint64(0)
+	for _, outpoint := range domainOutpoints {
+		utxo, ok := utxosByOutpoint[*outpoint]
+		if !ok {
+			return nil, 0, 0, errors.Errorf("%s is not a UTXO of the wallet", outpoint)
+		}
+		if _, ok := selectedOutpoints[*outpoint]; ok {
+			return nil, 0, 0, errors.Errorf("%s is selected more than once", outpoint)
+		}
+		if s.frozenOutpoints.contains(outpoint) {
+			return nil, 0, 0, errors.Errorf("%s is frozen", outpoint)
+		}
+		if s.isOutpointUsed(outpoint) {
+			return nil, 0, 0, errors.Errorf("%s is already spent by a transaction that wasn't accepted yet", outpoint)
+		}
+		if !s.isUTXOSpendable(utxo, dagInfo.VirtualDAAScore) {
+			return nil, 0, 0, errors.Errorf("%s is a coinbase UTXO that isn't mature yet", outpoint)
+		}
+
+		selectedOutpoints[*outpoint] = struct{}{}
+		selectedUTXOs = append(selectedUTXOs, &libkobrawallet.UTXO{
+			Outpoint:       utxo.Outpoint,
+			UTXOEntry:      utxo.UTXOEntry,
+			DerivationPath: s.walletAddressPath(utxo.address),
+		})
+		totalValue += utxo.UTXOEntry.Amount()
+	}
+
+	fee := feePerInput * uint64(len(selectedUTXOs))
+	totalReceived, changeLeor, err = splitSelectedValue(totalValue, spendAmount, isSendAll, fee)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	return selectedUTXOs, totalReceived, changeLeor, nil
+}
+
+func outpointsFromProto(protoOutpoints []*pb.Outpoint) ([]*externalapi.DomainOutpoint, error) {
+	outpoints := make([]*externalapi.DomainOutpoint, len(protoOutpoints))
+	for i, protoOutpoint := range protoOutpoints {
+		outpoint, err := libkobrawallet.KobrawalletdOutpointToDomainOutpoint(protoOutpoint)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid outpoint %s:%d", protoOutpoint.TransactionId, protoOutpoint.Index)
+		}
+		outpoints[i] = outpoint
+	}
+	return outpoints, nil
+}
@@ -1,6 +1,12 @@
 package server
 
-import "github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+)
 
 type walletUTXO struct {
 	Outpoint  *externalapi.DomainOutpoint
@@ -13,3 +19,20 @@ type walletAddress struct {
 	cosignerIndex uint32
 	keyChain      uint8
 }
+
+// walletDataFilePath returns the path of the file in which the daemon keeps
+// the given kind of data of the wallet whose keys file is at keysFilePath
+func walletDataFilePath(keysFilePath string, name string) string {
+	return strings.TrimSuffix(keysFilePath, filepath.Ext(keysFilePath)) + "-" + name + ".json"
+}
+
+// writeFileAtomically replaces the file at the given path at once, so that a
+// crash while writing it doesn't lose its previous contents
+func writeFileAtomically(path string, data []byte) error {
+	temporaryPath := path + ".tmp"
+	err := os.WriteFile(temporaryPath, data, 0600)
+	if err != nil {
+		return err
+	}
+	return os.Rename(temporaryPath, path)
+}
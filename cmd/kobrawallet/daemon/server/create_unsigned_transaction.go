@@ -10,9 +10,6 @@ import (
 	"github.com/pkg/errors"
 )
 
-// TODO: Implement a better fee estimation mechanism
-const feePerInput = 10000
-
 // The minimal change amount to target in order to avoid large storage mass (see KIP9 for more details).
 // By having at least 0.2KAS in the change output we make sure that every transaction with send value >= 0.2KAS
 // should succeed (at most 50K storage mass for each output, thus overall lower than standard mass upper bound which is 100K gram)
@@ -25,7 +22,7 @@ func (s *server) CreateUnsignedTransactions(_ context.Context, request *pb.Creat
 	defer s.lock.Unlock()
 
 	unsignedTransactions, err := s.createUnsignedTransactions(request.Address, request.Amount, request.IsSendAll,
-		request.From, request.UseExistingChangeAddress)
+		request.Payments, request.From, request.Inputs, request.UseExistingChangeAddress)
 	if err != nil {
 		return nil, err
 	}
@@ -33,16 +30,14 @@ func (s *server) CreateUnsignedTransactions(_ context.Context, request *pb.Creat
 	return &pb.CreateUnsignedTransactionsResponse{UnsignedTransactions: unsignedTransactions}, nil
 }
 
-func (s *server) createUnsignedTransactions(address string, amount uint64, isSendAll bool, fromAddressesString []string, useExistingChangeAddress bool) ([][]byte, error) {
+func (s *server) createUnsignedTransactions(address string, amount uint64, isSendAll bool, batchPayments []*pb.Payment,
+	fromAddressesString []string, inputs []*pb.Outpoint, useExistingChangeAddress bool) ([][]byte, error) {
 	if !s.isSynced() {
 //my-add		return nil, errors.Errorf("wallet daemon is not synced yet, %s", s.formatSyncStateReport())
 	}
 
-	// make sure address string is correct before proceeding to a
-	// potentially long UTXO refreshment operation
-	toAddress, err := util.DecodeAddress(address, s.params.Prefix)
-	if err != nil {
-		return nil, err
+	if len(inputs) > 0 && len(fromAddressesString) > 0 {
+		return nil, errors.Errorf("from addresses can't be specified along with explicit inputs")
 	}
 
 	var fromAddresses []*walletAddress
@@ -54,13 +49,28 @@ func (s *server) createUnsignedTransactions(address string, amount uint64, isSen
 		fromAddresses = append(fromAddresses, fromAddress)
 	}
 
-	selectedUTXOs, spendValue, changeLeor, err := s.selectUTXOs(amount, isSendAll, feePerInput, fromAddresses)
+	if len(batchPayments) > 0 {
+		if address != "" || amount != 0 || isSendAll {
+			return nil, errors.Errorf("a list of payments can't be specified along with an address, an amount or send all")
+		}
+		payments, err := s.paymentsFromProto(batchPayments)
+		if err != nil {
+			return nil, err
+		}
+		return s.createBatchUnsignedTransactions(payments, fromAddresses, inputs, useExistingChangeAddress)
+	}
+
+	// make sure address string is correct before proceeding to a
+	// potentially long UTXO refreshment operation
+	toAddress, err := util.DecodeAddress(address, s.params.Prefix)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(selectedUTXOs) == 0 {
-		return nil, errors.Errorf("couldn't find funds to spend")
+	feePerInput := s.estimateFeePerInput()
+	selectedUTXOs, spendValue, changeLeor, err := s.selectUTXOsToSpend(inputs, amount, isSendAll, feePerInput, fromAddresses)
+	if err != nil {
+		return nil, err
 	}
 
 	changeAddress, changeWalletAddress, err := s.changeAddress(useExistingChangeAddress, fromAddresses)
@@ -72,26 +82,41 @@ func (s *server) createUnsignedTransactions(address string, amount uint64, isSen
 		Address: toAddress,
 		Amount:  spendValue,
 	}}
-	if changeLeor > 0 {
-		payments = append(payments, &libkobrawallet.Payment{
-			Address: changeAddress,
-			Amount:  changeLeor,
-		})
-	}
 	unsignedTransaction, err := libkobrawallet.CreateUnsignedTransaction(s.keysFile.ExtendedPublicKeys,
 		s.keysFile.MinimumSignatures,
-		payments, selectedUTXOs)
+		withChange(payments, changeAddress, changeLeor), selectedUTXOs)
 	if err != nil {
 		return nil, err
 	}
 
-	unsignedTransactions, err := s.maybeAutoCompoundTransaction(unsignedTransaction, toAddress, changeAddress, changeWalletAddress)
+	unsignedTransactions, err := s.maybeAutoCompoundTransaction(unsignedTransaction, payments, changeAddress, changeWalletAddress,
+		feePerInput, len(inputs) > 0)
 	if err != nil {
 		return nil, err
 	}
 	return unsignedTransactions, nil
 }
 
+// selectUTXOsToSpend selects the UTXOs of the given inputs if there are any, or
+// selects UTXOs of the given from addresses otherwise
+func (s *server) selectUTXOsToSpend(inputs []*pb.Outpoint, spendAmount uint64, isSendAll bool, feePerInput uint64,
+	fromAddresses []*walletAddress) (selectedUTXOs []*libkobrawallet.UTXO, totalReceived uint64, changeLeor uint64, err error) {
+
+	if len(inputs) > 0 {
+		selectedUTXOs, totalReceived, changeLeor, err = s.selectExplicitUTXOs(inputs, spendAmount, isSendAll, feePerInput)
+	} else {
+		selectedUTXOs, totalReceived, changeLeor, err = s.selectUTXOs(spendAmount, isSendAll, feePerInput, fromAddresses)
+	}
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	if len(selectedUTXOs) == 0 {
+		return nil, 0, 0, errors.Errorf("couldn't find funds to spend")
+	}
+	return selectedUTXOs, totalReceived, changeLeor, nil
+}
+
 func (s *server) selectUTXOs(spendAmount uint64, isSendAll bool, feePerInput uint64, fromAddresses []*walletAddress) (
 	selectedUTXOs []*libkobrawallet.UTXO, totalReceived uint64, changeLeor uint64, err error) {
 
@@ -106,7 +131,7 @@ func (s *server) selectUTXOs(spendAmount uint64, isSendAll bool, feePerInput uin
 
 	for _, utxo := range s.utxosSortedByAmount {
 		if (fromAddresses != nil && !walletAddressesContain(fromAddresses, utxo.address)) ||
-			!s.isUTXOSpendable(utxo, dagInfo.VirtualDAAScore) {
+			!s.isUTXOSpendable(utxo, dagInfo.VirtualDAAScore) || s.frozenOutpoints.contains(utxo.Outpoint) {
 			continue
 		}
 
@@ -139,20 +164,35 @@ func (s *server) selectUTXOs(spendAmount uint64, isSendAll bool, feePerInput uin
 	}
 
 	fee := feePerInput * uint64(len(selectedUTXOs))
+	totalReceived, changeLeor, err = splitSelectedValue(totalValue, spendAmount, isSendAll, fee)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	return selectedUTXOs, totalReceived, changeLeor, nil
+}
+
+// splitSelectedValue splits the total value of the selected UTXOs into the amount
+// the payee receives and the change, after paying the given fee
+func splitSelectedValue(totalValue uint64, spendAmount uint64, isSendAll bool, fee uint64) (
+	totalReceived uint64, changeLeor uint64, err error) {
+
 	var totalSpend uint64
 	if isSendAll {
 		totalSpend = totalValue
-		totalReceived = totalValue - fee
+		if totalSpend < fee {
+			totalSpend = fee
+		}
+		totalReceived = totalSpend - fee
 	} else {
 		totalSpend = spendAmount + fee
 		totalReceived = spendAmount
 	}
 	if totalValue < totalSpend {
-		return nil, 0, 0, errors.Errorf("Insufficient funds for send: %f required, while only %f available",
+		return 0, 0, errors.Errorf("Insufficient funds for send: %f required, while only %f available",
 			float64(totalSpend)/constants.LeorPerKobra, float64(totalValue)/constants.LeorPerKobra)
 	}
 
-	return selectedUTXOs, totalReceived, totalValue - totalSpend, nil
+	return totalReceived, totalValue - totalSpend, nil
 }
 func walletAddressesContain(addresses []*walletAddress, contain *walletAddress) bool {
 	for _, address := range addresses {
@@ -0,0 +1,98 @@
+package server
+
+import (
+	"math"
+
+	"github.com/kobradag/go-secp256k1"
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/domain/consensus/utils/subnetworks"
+)
+
+// defaultFeePerInput is the fee paid for every input when the node
+// is unable to provide a fee estimate
+const defaultFeePerInput = 10000
+
+const (
+	schnorrPublicKeySize = 32
+	ecdsaPublicKeySize   = 33
+
+	// maxScriptPublicKeySize is the size of the largest standard script public
+	// key, which is the one paying to an ECDSA public key
+	maxScriptPublicKeySize = ecdsaPublicKeySize + 2
+)
+
+// estimateFeePerInput asks the node for the current fee rate and returns the fee
+// each input should pay in order for a transaction to be included within the
+// normal estimated time frame.
+// The fee of every input covers the input itself along with the rest of a
+// typical transaction, so that the overall fee stays sufficient no matter how
+// many inputs are selected.
+func (s *server) estimateFeePerInput() uint64 {
+	feeEstimateResponse, err := s.rpcClient.GetFeeEstimate()
+	if err != nil {
+		log.Warnf("Could not get a fee estimate from the node, falling back to %d leor per input: %s",
+			defaultFeePerInput, err)
+		return defaultFeePerInput
+	}
+	feeRate := feeEstimateResponse.Estimate.NormalBucket.FeeRate
+
+	return uint64(math.Ceil(feeRate * float64(s.estimatedMassPerInput())))
+}
+
+// feePerOutput returns the fee every output should pay, at the fee rate of the
+// given fee per input, if a transaction has more outputs than the two that
+// the fee of an input already covers
+func (s *server) feePerOutput(feePerInput uint64) uint64 {
+	return uint64(math.Ceil(float64(feePerInput) * float64(s.estimatedMassPerOutput()) / float64(s.estimatedMassPerInput())))
+}
+
+// estimatedMassPerInput returns the mass of a signed transaction that has a
+// single input spending from this wallet, a payment output and a change output
+func (s *server) estimatedMassPerInput() uint64 {
+	signatureSize := secp256k1.SerializedSchnorrSignatureSize
+	publicKeySize := schnorrPublicKeySize
+	if s.keysFile.ECDSA {
+		signatureSize = secp256k1.SerializedECDSASignatureSize
+		publicKeySize = ecdsaPublicKeySize
+	}
+
+	// Every signature is pushed along with its SigHashType
+	signatureScriptSize := int(s.keysFile.MinimumSignatures) * (1 + signatureSize + 1)
+	publicKeyCount := len(s.keysFile.ExtendedPublicKeys)
+	if publicKeyCount > 1 {
+		// Multisig inputs also push their redeem script, made of the public keys
+		// along with the signature counts and the OP_CHECKMULTISIG opcode
+		redeemScriptSize := publicKeyCount*(1+publicKeySize) + 3
+		signatureScriptSize += 3 + redeemScriptSize
+	}
+
+	output := maxSizeOutput()
+	transaction := &externalapi.DomainTransaction{
+		Inputs: []*externalapi.DomainTransactionInput{{
+			SignatureScript: make([]byte, signatureScriptSize),
+			SigOpCount:      byte(publicKeyCount),
+		}},
+		Outputs:      []*externalapi.DomainTransactionOutput{output, output},
+		SubnetworkID: subnetworks.SubnetworkIDNative,
+	}
+
+	return s.txMassCalculator.CalculateTransactionMass(transaction)
+}
+
+// estimatedMassPerOutput returns the mass every output adds to a transaction
+func (s *server) estimatedMassPerOutput() uint64 {
+	transactionWithoutOutputs := &externalapi.DomainTransaction{SubnetworkID: subnetworks.SubnetworkIDNative}
+	transactionWithOutput := &externalapi.DomainTransaction{
+		Outputs:      []*externalapi.DomainTransactionOutput{maxSizeOutput()},
+		SubnetworkID: subnetworks.SubnetworkIDNative,
+	}
+
+	return s.txMassCalculator.CalculateTransactionMass(transactionWithOutput) -
+		s.txMassCalculator.CalculateTransactionMass(transactionWithoutOutputs)
+}
+
+func maxSizeOutput() *externalapi.DomainTransactionOutput {
+	return &externalapi.DomainTransactionOutput{
+		ScriptPublicKey: &externalapi.ScriptPublicKey{Script: make([]byte, maxScriptPublicKeySize)},
+	}
+}
@@ -0,0 +1,98 @@
+package server
+
+import (
+	"encoding/json"
+	"os"
+	"sort"
+
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/domain/consensus/utils/transactionid"
+	"github.com/pkg/errors"
+)
+
+type frozenOutpointJSON struct {
+	TransactionID string `json:"transactionID"`
+	Index         uint32 `json:"index"`
+}
+
+// frozenOutpoints is the set of outpoints the user reserved, which are never
+// selected for spending unless they are unfrozen first. It is kept in a file
+// next to the keys file, so that it survives restarts of the daemon.
+type frozenOutpoints struct {
+	path      string
+	outpoints map[externalapi.DomainOutpoint]struct{}
+}
+
+// frozenOutpointsFilePath returns the path of the frozen outpoints file of
+// the wallet whose keys file is at keysFilePath
+func frozenOutpointsFilePath(keysFilePath string) string {
+	return walletDataFilePath(keysFilePath, "frozen")
+}
+
+// loadFrozenOutpoints reads the frozen outpoints file at the given path, or
+// returns an empty set if there's no such file
+func loadFrozenOutpoints(path string) (*frozenOutpoints, error) {
+	frozen := &frozenOutpoints{
+		path:      path,
+		outpoints: make(map[externalapi.DomainOutpoint]struct{}),
+	}
+
+	serialized, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return frozen, nil
+		}
+		return nil, err
+	}
+
+	var outpointsJSON []*frozenOutpointJSON
+	err = json.Unmarshal(serialized, &outpointsJSON)
+	if err != nil {
+		return nil, errors.Wrapf(err, "malformed frozen outpoints file %s", path)
+	}
+	for _, outpointJSON := range outpointsJSON {
+		transactionID, err := transactionid.FromString(outpointJSON.TransactionID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "malformed frozen outpoints file %s", path)
+		}
+		frozen.outpoints[externalapi.DomainOutpoint{TransactionID: *transactionID, Index: outpointJSON.Index}] = struct{}{}
+	}
+	return frozen, nil
+}
+
+// save writes the frozen outpoints to their file, ordered so that the file
+// doesn't change if the set doesn't
+func (f *frozenOutpoints) save() error {
+	outpointsJSON := make([]*frozenOutpointJSON, 0, len(f.outpoints))
+	for outpoint := range f.outpoints {
+		outpointsJSON = append(outpointsJSON, &frozenOutpointJSON{
+			TransactionID: outpoint.TransactionID.String(),
+			Index:         outpoint.Index,
+		})
+	}
+	sort.Slice(outpointsJSON, func(i, j int) bool {
+		if outpointsJSON[i].TransactionID != outpointsJSON[j].TransactionID {
+			return outpointsJSON[i].TransactionID < outpointsJSON[j].TransactionID
+		}
+		return outpointsJSON[i].Index < outpointsJSON[j].Index
+	})
+
+	serialized, err := json.Marshal(outpointsJSON)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomically(f.path, serialized)
+}
+
+func (f *frozenOutpoints) contains(outpoint *externalapi.DomainOutpoint) bool {
+	_, ok := f.outpoints[*outpoint]
+	return ok
+}
+
+func (f *frozenOutpoints) add(outpoint *externalapi.DomainOutpoint) {
+	f.outpoints[*outpoint] = struct{}{}
+}
+
+func (f *frozenOutpoints) remove(outpoint *externalapi.DomainOutpoint) {
+	delete(f.outpoints, *outpoint)
+}
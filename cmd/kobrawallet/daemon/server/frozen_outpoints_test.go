@@ -0,0 +1,79 @@
+package server
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+)
+
+func TestFrozenOutpoints(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys-frozen.json")
+	frozen, err := loadFrozenOutpoints(path)
+	if err != nil {
+		t.Fatalf("loadFrozenOutpoints: %+v", err)
+	}
+
+	outpoint := func(transactionIDByte byte, index uint32) *externalapi.DomainOutpoint {
+		return &externalapi.DomainOutpoint{
+			TransactionID: *externalapi.NewDomainTransactionIDFromByteArray(&[externalapi.DomainHashSize]byte{transactionIDByte}),
+			Index:         index,
+		}
+	}
+
+	frozen.add(outpoint(1, 0))
+	frozen.add(outpoint(1, 1))
+	frozen.add(outpoint(2, 0))
+	frozen.remove(outpoint(1, 1))
+	err = frozen.save()
+	if err != nil {
+		t.Fatalf("save: %+v", err)
+	}
+
+	loadedFrozen, err := loadFrozenOutpoints(path)
+	if err != nil {
+		t.Fatalf("loadFrozenOutpoints: %+v", err)
+	}
+	if len(loadedFrozen.outpoints) != 2 || !loadedFrozen.contains(outpoint(1, 0)) ||
+		!loadedFrozen.contains(outpoint(2, 0)) || loadedFrozen.contains(outpoint(1, 1)) {
+
+		t.Fatalf("Unexpected loaded frozen outpoints %v", loadedFrozen.outpoints)
+	}
+}
+
+func TestSplitSelectedValue(t *testing.T) {
+	tests := []struct {
+		name                  string
+		totalValue            uint64
+		spendAmount           uint64
+		isSendAll             bool
+		fee                   uint64
+		expectedTotalReceived uint64
+		expectedChange        uint64
+		expectsError          bool
+	}{
+		{name: "with change", totalValue: 100, spendAmount: 50, fee: 10, expectedTotalReceived: 50, expectedChange: 40},
+		{name: "without change", totalValue: 60, spendAmount: 50, fee: 10, expectedTotalReceived: 50},
+		{name: "insufficient funds", totalValue: 59, spendAmount: 50, fee: 10, expectsError: true},
+		{name: "send all", totalValue: 100, isSendAll: true, fee: 10, expectedTotalReceived: 90},
+		{name: "send all below the fee", totalValue: 5, isSendAll: true, fee: 10, expectsError: true},
+	}
+
+	for _, test := range tests {
+		totalReceived, change, err := splitSelectedValue(test.totalValue, test.spendAmount, test.isSendAll, test.fee)
+		if test.expectsError {
+			if err == nil {
+				t.Errorf("%s: expected an error", test.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: splitSelectedValue: %+v", test.name, err)
+			continue
+		}
+		if totalReceived != test.expectedTotalReceived || change != test.expectedChange {
+			t.Errorf("%s: got %d received and %d change, expected %d and %d", test.name,
+				totalReceived, change, test.expectedTotalReceived, test.expectedChange)
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package server
+
+import (
+	"encoding/json"
+	"os"
+	"reflect"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// historyEntry is an accepted transaction that credited or debited
+// the addresses of the wallet
+type historyEntry struct {
+	TransactionID          string   `json:"transactionID"`
+	AcceptingBlockHash     string   `json:"acceptingBlockHash"`
+	AcceptingBlockDAAScore uint64   `json:"acceptingBlockDAAScore"`
+	Timestamp              int64    `json:"timestamp"`
+	Received               uint64   `json:"received"`
+	Sent                   uint64   `json:"sent"`
+	Addresses              []string `json:"addresses"`
+}
+
+// transactionHistory is the local record of the transactions of the wallet,
+// along with the labels the user gave to transactions and addresses. It is
+// kept in a file next to the keys file, so that it survives restarts of the
+// daemon and only new transactions have to be fetched from the node.
+type transactionHistory struct {
+	path string
+
+	// Entries are ordered by the DAA score of their accepting blocks,
+	// and then by their transaction IDs
+	Entries           []*historyEntry   `json:"entries"`
+	TransactionLabels map[string]string `json:"transactionLabels"`
+	AddressLabels     map[string]string `json:"addressLabels"`
+
+	// NumScannedIndexes is the number of address indexes the entries
+	// were collected from. Once more addresses are used, the history of
+	// the new addresses has to be fetched from the beginning.
+	NumScannedIndexes uint32 `json:"numScannedIndexes"`
+}
+
+// historyFilePath returns the path of the transaction history file of
+// the wallet whose keys file is at keysFilePath
+func historyFilePath(keysFilePath string) string {
+	return walletDataFilePath(keysFilePath, "history")
+}
+
+// loadTransactionHistory reads the transaction history file at the given
+// path, or returns an empty history if there's no such file
+func loadTransactionHistory(path string) (*transactionHistory, error) {
+	history := &transactionHistory{
+		path:              path,
+		TransactionLabels: make(map[string]string),
+		AddressLabels:     make(map[string]string),
+	}
+
+	serialized, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return history, nil
+		}
+		return nil, err
+	}
+
+	err = json.Unmarshal(serialized, history)
+	if err != nil {
+		return nil, errors.Wrapf(err, "malformed transaction history file %s", path)
+	}
+	if history.TransactionLabels == nil {
+		history.TransactionLabels = make(map[string]string)
+	}
+	if history.AddressLabels == nil {
+		history.AddressLabels = make(map[string]string)
+	}
+	return history, nil
+}
+
+// save writes the history to its file
+func (h *transactionHistory) save() error {
+	serialized, err := json.Marshal(h)
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomically(h.path, serialized)
+}
+
+// lastDAAScore returns the accepting block DAA score of the most recent
+// entry, or 0 if the history is empty
+func (h *transactionHistory) lastDAAScore() uint64 {
+	if len(h.Entries) == 0 {
+		return 0
+	}
+	return h.Entries[len(h.Entries)-1].AcceptingBlockDAAScore
+}
+
+// timestamps returns the known timestamps of the accepting blocks of the
+// entries from the given DAA score onwards, by block hash
+func (h *transactionHistory) timestamps(startDAAScore uint64) map[string]int64 {
+	timestamps := make(map[string]int64)
+	for i := len(h.Entries) - 1; i >= 0 && h.Entries[i].AcceptingBlockDAAScore >= startDAAScore; i-- {
+		timestamps[h.Entries[i].AcceptingBlockHash] = h.Entries[i].Timestamp
+	}
+	return timestamps
+}
+
+// update replaces the entries from the given DAA score onwards with the
+// given entries, which are expected to be in history order. It returns
+// whether the history changed.
+func (h *transactionHistory) update(startDAAScore uint64, entries []*historyEntry, numScannedIndexes uint32) bool {
+	firstReplacedIndex := sort.Search(len(h.Entries), func(i int) bool {
+		return h.Entries[i].AcceptingBlockDAAScore >= startDAAScore
+	})
+	replacedEntries := h.Entries[firstReplacedIndex:]
+	if numScannedIndexes == h.NumScannedIndexes && len(replacedEntries) == len(entries) &&
+		(len(entries) == 0 || reflect.DeepEqual(replacedEntries, entries)) {
+
+		return false
+	}
+
+	h.Entries = append(h.Entries[:firstReplacedIndex:firstReplacedIndex], entries...)
+	h.NumScannedIndexes = numScannedIndexes
+	return true
+}
+
+// query returns the entries of the given address, or all of the entries
+// if address is empty, from the most recent one. It skips the first offset
+// entries and returns at most limit entries, or all of them if limit is 0.
+// The total number of entries of the address is returned as well.
+func (h *transactionHistory) query(address string, offset, limit uint32) ([]*historyEntry, uint32) {
+	var matches []*historyEntry
+	for i := len(h.Entries) - 1; i >= 0; i-- {
+		entry := h.Entries[i]
+		if address != "" && !containsString(entry.Addresses, address) {
+			continue
+		}
+		matches = append(matches, entry)
+	}
+
+	total := uint32(len(matches))
+	if offset >= total {
+		return nil, total
+	}
+	matches = matches[offset:]
+	if limit != 0 && limit < uint32(len(matches)) {
+		matches = matches[:limit]
+	}
+	return matches, total
+}
+
+// setLabel sets the label of the given key in the given labels, or
+// removes it if label is empty
+func setLabel(labels map[string]string, key string, label string) {
+	if label == "" {
+		delete(labels, key)
+		return
+	}
+	labels[key] = label
+}
+
+func containsString(values []string, s string) bool {
+	for _, candidate := range values {
+		if candidate == s {
+			return true
+		}
+	}
+	return false
+}
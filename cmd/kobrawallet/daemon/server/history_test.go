@@ -0,0 +1,109 @@
+package server
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestTransactionHistory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys-history.json")
+	history, err := loadTransactionHistory(path)
+	if err != nil {
+		t.Fatalf("loadTransactionHistory: %+v", err)
+	}
+	if len(history.Entries) != 0 || history.lastDAAScore() != 0 {
+		t.Fatalf("A history that wasn't saved yet is expected to be empty")
+	}
+
+	newEntry := func(transactionID string, daaScore uint64, addresses ...string) *historyEntry {
+		return &historyEntry{
+			TransactionID:          transactionID,
+			AcceptingBlockHash:     transactionID + "-block",
+			AcceptingBlockDAAScore: daaScore,
+			Received:               daaScore,
+			Addresses:              addresses,
+		}
+	}
+
+	if !history.update(0, []*historyEntry{newEntry("a", 10, "x"), newEntry("b", 20, "y"), newEntry("c", 30, "x", "y")}, 5) {
+		t.Fatalf("Adding entries is expected to change the history")
+	}
+	if history.lastDAAScore() != 30 {
+		t.Fatalf("Unexpected last DAA score %d", history.lastDAAScore())
+	}
+
+	// Fetching the same entries again doesn't change anything
+	if history.update(15, []*historyEntry{newEntry("b", 20, "y"), newEntry("c", 30, "x", "y")}, 5) {
+		t.Fatalf("Replacing entries with equal ones is not expected to change the history")
+	}
+
+	// A reorg replaces c with d, and leaves the entries before the start DAA score alone
+	if !history.update(15, []*historyEntry{newEntry("b", 20, "y"), newEntry("d", 25, "y")}, 5) {
+		t.Fatalf("Replacing entries is expected to change the history")
+	}
+	assertTransactionIDs := func(entries []*historyEntry, expected ...string) {
+		t.Helper()
+		transactionIDs := make([]string, len(entries))
+		for i, entry := range entries {
+			transactionIDs[i] = entry.TransactionID
+		}
+		if !reflect.DeepEqual(transactionIDs, expected) {
+			t.Fatalf("Unexpected transactions %v, expected %v", transactionIDs, expected)
+		}
+	}
+	assertTransactionIDs(history.Entries, "a", "b", "d")
+
+	entries, total := history.query("", 0, 0)
+	assertTransactionIDs(entries, "d", "b", "a")
+	if total != 3 {
+		t.Fatalf("Unexpected total %d", total)
+	}
+	entries, _ = history.query("", 1, 1)
+	assertTransactionIDs(entries, "b")
+	entries, total = history.query("x", 0, 0)
+	assertTransactionIDs(entries, "a")
+	if total != 1 {
+		t.Fatalf("Unexpected total %d", total)
+	}
+	entries, total = history.query("y", 5, 1)
+	if len(entries) != 0 || total != 2 {
+		t.Fatalf("Unexpected query result %v out of %d", entries, total)
+	}
+
+	if timestamps := history.timestamps(20); len(timestamps) != 2 {
+		t.Fatalf("Unexpected timestamps %v", timestamps)
+	}
+
+	setLabel(history.TransactionLabels, "a", "rent")
+	setLabel(history.AddressLabels, "x", "savings")
+	setLabel(history.AddressLabels, "y", "shop")
+	setLabel(history.AddressLabels, "y", "")
+	err = history.save()
+	if err != nil {
+		t.Fatalf("save: %+v", err)
+	}
+
+	loadedHistory, err := loadTransactionHistory(path)
+	if err != nil {
+		t.Fatalf("loadTransactionHistory: %+v", err)
+	}
+	if !reflect.DeepEqual(loadedHistory, history) {
+		t.Fatalf("The loaded history %+v is different from the saved one %+v", loadedHistory, history)
+	}
+	if !reflect.DeepEqual(loadedHistory.AddressLabels, map[string]string{"x": "savings"}) {
+		t.Fatalf("Unexpected address labels %v", loadedHistory.AddressLabels)
+	}
+
+	// Once more addresses are scanned, the history is replaced even if no entry changed
+	if !history.update(0, history.Entries, 6) {
+		t.Fatalf("Scanning more addresses is expected to change the history")
+	}
+}
+
+func TestHistoryFilePath(t *testing.T) {
+	path := historyFilePath(filepath.Join("wallets", "keys.json"))
+	if path != filepath.Join("wallets", "keys-history.json") {
+		t.Fatalf("Unexpected history file path %s", path)
+	}
+}
@@ -0,0 +1,225 @@
+package server
+
+import (
+	"sort"
+
+	"github.com/kobradag/kobrad/app/appmessage"
+	"github.com/kobradag/kobrad/cmd/kobrawallet/libkobrawallet"
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/pkg/errors"
+)
+
+// numIndexesToSubscribeAhead is the number of address indexes after the last
+// used one whose UTXO changes are notified, so that funds that are sent to
+// addresses that were handed out but weren't used yet are noticed.
+const numIndexesToSubscribeAhead = 1000
+
+// onUTXOsChanged is called by the RPC client for every UTXOs changed
+// notification. The notifications are applied later by the sync loop, so
+// that it remains the only writer of the UTXO set, and so that the RPC
+// client isn't blocked meanwhile.
+func (s *server) onUTXOsChanged(notification *appmessage.UTXOsChangedNotificationMessage) {
+	s.pendingNotificationsLock.Lock()
+	s.pendingNotifications = append(s.pendingNotifications, notification)
+	s.pendingNotificationsLock.Unlock()
+
+	s.forceSync()
+}
+
+// onReconnected is called by the RPC client after it reconnects to the
+// node. The node forgets the notification registrations of the previous
+// connection, and changes might have been missed while disconnected.
+func (s *server) onReconnected() {
+	s.isReconnected.Store(true)
+	s.requestRescan()
+}
+
+// requestRescan makes the sync loop rebuild the UTXO set from scratch. It's
+// also called when the node overrides its UTXO set with the UTXO set of a
+// pruning point, since no UTXO changes are notified for that.
+func (s *server) requestRescan() {
+	select {
+	case s.rescanChan <- struct{}{}:
+	default:
+		// A rescan is already pending
+	}
+}
+
+func (s *server) takePendingNotifications() []*appmessage.UTXOsChangedNotificationMessage {
+	s.pendingNotificationsLock.Lock()
+	defer s.pendingNotificationsLock.Unlock()
+
+	notifications := s.pendingNotifications
+	s.pendingNotifications = nil
+	return notifications
+}
+
+// registerForNotifications registers for pruning point UTXO set override
+// notifications, or renews the registration after a reconnection, in which
+// case the addresses have to be subscribed to again as well.
+func (s *server) registerForNotifications() error {
+	if !s.areNotificationsRegistered {
+		err := s.backgroundRPCClient.RegisterPruningPointUTXOSetNotifications(s.requestRescan)
+		if err != nil {
+			return err
+		}
+		s.backgroundRPCClient.SetOnReconnectedHandler(s.onReconnected)
+		s.areNotificationsRegistered = true
+		return nil
+	}
+
+	if !s.isReconnected.Swap(false) {
+		return nil
+	}
+	s.lock.Lock()
+	s.numSubscribedIndexes = 0
+	s.subscribedAddresses = make(walletAddressSet)
+	s.lock.Unlock()
+	return s.backgroundRPCClient.RenewPruningPointUTXOSetNotifications()
+}
+
+// subscribeToNewAddresses makes sure that the UTXO changes of all the addresses
+// up to numIndexesToSubscribeAhead indexes after the last used one are notified.
+// If fetchUTXOs is set, the UTXOs that the newly subscribed addresses already
+// have are added to the UTXO set, which may reveal more used addresses.
+func (s *server) subscribeToNewAddresses(fetchUTXOs bool) error {
+	for {
+		s.lock.RLock()
+		start := s.numSubscribedIndexes
+		end := s.maxUsedIndex() + 1 + numIndexesToSubscribeAhead
+		s.lock.RUnlock()
+		if end <= start {
+			return nil
+		}
+
+		addresses, err := s.addressesToQuery(start, end)
+		if err != nil {
+			return err
+		}
+		if !s.isUTXOsChangedListenerStarted {
+			err = s.backgroundRPCClient.RegisterForUTXOsChangedNotifications(addresses.strings(), s.onUTXOsChanged)
+			if err != nil {
+				return err
+			}
+			s.isUTXOsChangedListenerStarted = true
+		} else {
+			err = s.backgroundRPCClient.AddUTXOsChangedNotificationAddresses(addresses.strings())
+			if err != nil {
+				return err
+			}
+		}
+
+		s.lock.Lock()
+		for address, walletAddress := range addresses {
+			s.subscribedAddresses[address] = walletAddress
+		}
+		s.numSubscribedIndexes = end
+		if end > s.nextSyncStartIndex {
+			s.nextSyncStartIndex = end
+		}
+		s.lock.Unlock()
+
+		if !fetchUTXOs {
+			return nil
+		}
+
+		// The new addresses weren't watched until now, so the UTXOs they
+		// already have are fetched once
+		getUTXOsByAddressesResponse, err := s.backgroundRPCClient.GetUTXOsByAddresses(addresses.strings())
+		if err != nil {
+			return err
+		}
+		err = s.applyUTXOChanges([]*appmessage.UTXOsChangedNotificationMessage{
+			{Added: getUTXOsByAddressesResponse.Entries},
+		})
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// applyUTXOChanges applies the given notifications to the UTXO set, and marks
+// the addresses that received UTXOs as used
+func (s *server) applyUTXOChanges(notifications []*appmessage.UTXOsChangedNotificationMessage) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	utxosByOutpoint := make(map[externalapi.DomainOutpoint]*walletUTXO, len(s.utxosSortedByAmount))
+	for _, utxo := range s.utxosSortedByAmount {
+		utxosByOutpoint[*utxo.Outpoint] = utxo
+	}
+
+	receivingAddresses, err := applyUTXOChangesToSet(utxosByOutpoint, notifications, s.subscribedAddresses)
+	if err != nil {
+		return err
+	}
+	utxos := make([]*walletUTXO, 0, len(utxosByOutpoint))
+	for _, utxo := range utxosByOutpoint {
+		utxos = append(utxos, utxo)
+	}
+	sort.Slice(utxos, func(i, j int) bool { return utxos[i].UTXOEntry.Amount() > utxos[j].UTXOEntry.Amount() })
+	s.utxosSortedByAmount = utxos
+
+	lastUsedExternalIndex := s.keysFile.LastUsedExternalIndex()
+	lastUsedInternalIndex := s.keysFile.LastUsedInternalIndex()
+	for addressString, walletAddress := range receivingAddresses {
+		s.addressSet[addressString] = walletAddress
+
+		if walletAddress.keyChain == libkobrawallet.ExternalKeychain {
+			if walletAddress.index > lastUsedExternalIndex {
+				lastUsedExternalIndex = walletAddress.index
+			}
+			continue
+		}
+		if walletAddress.index > lastUsedInternalIndex {
+			lastUsedInternalIndex = walletAddress.index
+		}
+	}
+
+	err = s.keysFile.SetLastUsedExternalIndex(lastUsedExternalIndex)
+	if err != nil {
+		return err
+	}
+	return s.keysFile.SetLastUsedInternalIndex(lastUsedInternalIndex)
+}
+
+// applyUTXOChangesToSet applies the given notifications, in order, to the
+// given UTXOs by outpoint. It returns the addresses that received UTXOs.
+func applyUTXOChangesToSet(utxosByOutpoint map[externalapi.DomainOutpoint]*walletUTXO,
+	notifications []*appmessage.UTXOsChangedNotificationMessage, addresses walletAddressSet) (walletAddressSet, error) {
+
+	receivingAddresses := make(walletAddressSet)
+	for _, notification := range notifications {
+		for _, entry := range notification.Removed {
+			outpoint, err := appmessage.RPCOutpointToDomainOutpoint(entry.Outpoint)
+			if err != nil {
+				return nil, err
+			}
+			delete(utxosByOutpoint, *outpoint)
+		}
+
+		for _, entry := range notification.Added {
+			address, ok := addresses[entry.Address]
+			if !ok {
+				return nil, errors.Errorf("Got a UTXO of address %s even though it wasn't subscribed to", entry.Address)
+			}
+
+			outpoint, err := appmessage.RPCOutpointToDomainOutpoint(entry.Outpoint)
+			if err != nil {
+				return nil, err
+			}
+			utxoEntry, err := appmessage.RPCUTXOEntryToUTXOEntry(entry.UTXOEntry)
+			if err != nil {
+				return nil, err
+			}
+
+			utxosByOutpoint[*outpoint] = &walletUTXO{
+				Outpoint:  outpoint,
+				UTXOEntry: utxoEntry,
+				address:   address,
+			}
+			receivingAddresses[entry.Address] = address
+		}
+	}
+	return receivingAddresses, nil
+}
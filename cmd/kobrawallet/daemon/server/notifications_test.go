@@ -0,0 +1,65 @@
+package server
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kobradag/kobrad/app/appmessage"
+	"github.com/kobradag/kobrad/cmd/kobrawallet/libkobrawallet"
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+)
+
+func TestApplyUTXOChangesToSet(t *testing.T) {
+	addresses := walletAddressSet{
+		"address0": {index: 0, keyChain: libkobrawallet.ExternalKeychain},
+		"address1": {index: 1, keyChain: libkobrawallet.InternalKeychain},
+	}
+
+	entry := func(address string, transactionIDByte byte, amount uint64) *appmessage.UTXOsByAddressesEntry {
+		transactionID := externalapi.NewDomainTransactionIDFromByteArray(&[externalapi.DomainHashSize]byte{transactionIDByte})
+		return &appmessage.UTXOsByAddressesEntry{
+			Address:  address,
+			Outpoint: &appmessage.RPCOutpoint{TransactionID: transactionID.String()},
+			UTXOEntry: &appmessage.RPCUTXOEntry{
+				Amount:          amount,
+				ScriptPublicKey: &appmessage.RPCScriptPublicKey{Script: "51"},
+			},
+		}
+	}
+
+	utxosByOutpoint := make(map[externalapi.DomainOutpoint]*walletUTXO)
+	receivingAddresses, err := applyUTXOChangesToSet(utxosByOutpoint, []*appmessage.UTXOsChangedNotificationMessage{
+		{Added: []*appmessage.UTXOsByAddressesEntry{entry("address0", 1, 10), entry("address0", 2, 20)}},
+		// A UTXO that is added and then removed by a later notification
+		// must not remain in the set
+		{Added: []*appmessage.UTXOsByAddressesEntry{entry("address1", 3, 30)}},
+		{
+			Removed: []*appmessage.UTXOsByAddressesEntry{entry("address0", 1, 10), entry("address1", 3, 30)},
+			Added:   []*appmessage.UTXOsByAddressesEntry{entry("address1", 4, 40)},
+		},
+	}, addresses)
+	if err != nil {
+		t.Fatalf("applyUTXOChangesToSet: %+v", err)
+	}
+
+	if len(utxosByOutpoint) != 2 {
+		t.Fatalf("Expected 2 UTXOs but got %d", len(utxosByOutpoint))
+	}
+	amounts := make(map[uint64]*walletAddress)
+	for _, utxo := range utxosByOutpoint {
+		amounts[utxo.UTXOEntry.Amount()] = utxo.address
+	}
+	if amounts[20] != addresses["address0"] || amounts[40] != addresses["address1"] {
+		t.Fatalf("Unexpected UTXOs %v", amounts)
+	}
+	if len(receivingAddresses) != 2 || receivingAddresses["address0"] == nil || receivingAddresses["address1"] == nil {
+		t.Fatalf("Unexpected receiving addresses %v", receivingAddresses)
+	}
+
+	_, err = applyUTXOChangesToSet(utxosByOutpoint, []*appmessage.UTXOsChangedNotificationMessage{
+		{Added: []*appmessage.UTXOsByAddressesEntry{entry("address2", 5, 50)}},
+	}, addresses)
+	if err == nil || !strings.Contains(err.Error(), "wasn't subscribed to") {
+		t.Fatalf("Expected an error for a UTXO of an unsubscribed address, but got %v", err)
+	}
+}
@@ -9,6 +9,7 @@ import (
         "sync/atomic"
 	"time"
 
+	"github.com/kobradag/kobrad/app/appmessage"
 	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
 
 	"github.com/kobradag/kobrad/util/txmass"
@@ -44,6 +45,23 @@ type server struct {
 	txMassCalculator                *txmass.Calculator
 	usedOutpoints                   map[externalapi.DomainOutpoint]time.Time
 	firstSyncDone                   atomic.Bool
+	history                         *transactionHistory
+	frozenOutpoints                 *frozenOutpoints
+	isHistoryUnavailable            atomic.Bool
+	isRefreshingHistory             atomic.Bool
+	lastHistoryRefresh              time.Time
+
+	// The UTXO set is kept up to date by the UTXOs changed notifications
+	// of the subscribed addresses, which are the addresses of the first
+	// numSubscribedIndexes indexes
+	subscribedAddresses           walletAddressSet
+	numSubscribedIndexes          uint32
+	pendingNotifications          []*appmessage.UTXOsChangedNotificationMessage
+	pendingNotificationsLock      sync.Mutex
+	rescanChan                    chan struct{}
+	areNotificationsRegistered    bool
+	isUTXOsChangedListenerStarted bool
+	isReconnected                 atomic.Bool
 
 	isLogFinalProgressLineShown bool
 	maxUsedAddressesForLog      uint32
@@ -56,7 +74,7 @@ type server struct {
 const MaxDaemonSendMsgSize = 100_000_000
 
 // Start starts the kobrawalletd server
-func Start(params *dagconfig.Params, listen, rpcServer string, keysFilePath string, profile string, timeout uint32) error {
+func Start(params *dagconfig.Params, listen, rpcServer string, rpcAuthorization string, keysFilePath string, profile string, timeout uint32) error {
 	initLog(defaultLogFile, defaultErrLogFile)
 
 	defer panics.HandlePanic(log, "MAIN", nil)
@@ -74,11 +92,11 @@ func Start(params *dagconfig.Params, listen, rpcServer string, keysFilePath stri
 	log.Infof("Listening to TCP on %s", listen)
 
 	log.Infof("Connecting to a node at %s...", rpcServer)
-	rpcClient, err := connectToRPC(params, rpcServer, timeout)
+	rpcClient, err := connectToRPC(params, rpcServer, rpcAuthorization, timeout)
 	if err != nil {
 		return (errors.Wrapf(err, "Error connecting to RPC server %s", rpcServer))
 	}
-	backgroundRPCClient, err := connectToRPC(params, rpcServer, timeout)
+	backgroundRPCClient, err := connectToRPC(params, rpcServer, rpcAuthorization, timeout)
 	if err != nil {
 		return (errors.Wrapf(err, "Error making a second connection to RPC server %s", rpcServer))
 	}
@@ -93,6 +111,19 @@ func Start(params *dagconfig.Params, listen, rpcServer string, keysFilePath stri
 	if err != nil {
 		return err
 	}
+	history, err := loadTransactionHistory(historyFilePath(keysFile.Path()))
+	if err != nil {
+		return err
+	}
+
+	frozenOutpoints, err := loadFrozenOutpoints(frozenOutpointsFilePath(keysFile.Path()))
+	if err != nil {
+		return err
+	}
+
+	if keysFile.IsWatchOnly() {
+		log.Infof("The wallet is watch-only: transactions can be created but not signed")
+	}
 
 	dagInfo, err := rpcClient.GetBlockDAGInfo()
 	if err != nil {
@@ -115,8 +146,12 @@ func Start(params *dagconfig.Params, listen, rpcServer string, keysFilePath stri
 		shutdown:                    make(chan struct{}),
 		forceSyncChan:               make(chan struct{}),
 		addressSet:                  make(walletAddressSet),
+		subscribedAddresses:         make(walletAddressSet),
+		rescanChan:                  make(chan struct{}, 1),
 		txMassCalculator:            txmass.NewCalculator(params.MassPerTxByte, params.MassPerScriptPubKeyByte, params.MassPerSigOp),
 		usedOutpoints:               map[externalapi.DomainOutpoint]time.Time{},
+		history:                     history,
+		frozenOutpoints:             frozenOutpoints,
 		isLogFinalProgressLineShown: false,
 		maxUsedAddressesForLog:      0,
 		maxProcessedAddressesForLog: 0,
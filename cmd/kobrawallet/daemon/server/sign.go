@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 
+	"github.com/kobradag/kobrad/cmd/kobrawallet/keys"
 	"github.com/kobradag/kobrad/cmd/kobrawallet/libkobrawallet"
 
 	"github.com/kobradag/kobrad/cmd/kobrawallet/daemon/pb"
@@ -20,6 +21,10 @@ func (s *server) Sign(_ context.Context, request *pb.SignRequest) (*pb.SignRespo
 }
 
 func (s *server) signTransactions(unsignedTransactions [][]byte, password string) ([][]byte, error) {
+	if s.keysFile.IsWatchOnly() {
+		return nil, keys.ErrWatchOnly
+	}
+
 	mnemonics, err := s.keysFile.DecryptMnemonics(password)
 	if err != nil {
 		return nil, err
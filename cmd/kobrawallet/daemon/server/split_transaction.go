@@ -18,16 +18,19 @@ import (
 // transaction.
 // If it is - the transaction is split into multiple transactions, each with a portion of the inputs and a single output
 // into a change address.
-// An additional `mergeTransaction` is generated - which merges the outputs of the above splits into a single output
-// paying to the original transaction's payee.
-func (s *server) maybeAutoCompoundTransaction(transactionBytes []byte, toAddress util.Address,
-	changeAddress util.Address, changeWalletAddress *walletAddress) ([][]byte, error) {
+// An additional `mergeTransaction` is generated - which merges the outputs of the above splits and pays the
+// original transaction's payments.
+// If the inputs of the transaction were chosen explicitly by the caller, no other UTXOs are added to pay for the
+// fees of the splits.
+func (s *server) maybeAutoCompoundTransaction(transactionBytes []byte, payments []*libkobrawallet.Payment,
+	changeAddress util.Address, changeWalletAddress *walletAddress, feePerInput uint64, areInputsExplicit bool) ([][]byte, error) {
 	transaction, err := serialization.DeserializePartiallySignedTransaction(transactionBytes)
 	if err != nil {
 		return nil, err
 	}
 
-	splitTransactions, err := s.maybeSplitAndMergeTransaction(transaction, toAddress, changeAddress, changeWalletAddress)
+	splitTransactions, err := s.maybeSplitAndMergeTransaction(transaction, payments, changeAddress, changeWalletAddress,
+		feePerInput, areInputsExplicit)
 	if err != nil {
 		return nil, err
 	}
@@ -44,21 +47,31 @@ func (s *server) maybeAutoCompoundTransaction(transactionBytes []byte, toAddress
 func (s *server) mergeTransaction(
 	splitTransactions []*serialization.PartiallySignedTransaction,
 	originalTransaction *serialization.PartiallySignedTransaction,
-	toAddress util.Address,
+	payments []*libkobrawallet.Payment,
 	changeAddress util.Address,
 	changeWalletAddress *walletAddress,
+	feePerInput uint64,
+	areInputsExplicit bool,
 ) (*serialization.PartiallySignedTransaction, error) {
 	numOutputs := len(originalTransaction.Tx.Outputs)
-	if numOutputs > 2 || numOutputs == 0 {
-		// This is a sanity check to make sure originalTransaction has either 1 or 2 outputs:
-		// 1. For the payment itself
+	if numOutputs != len(payments) && numOutputs != len(payments)+1 {
+		// This is a sanity check to make sure originalTransaction has an output:
+		// 1. For each of the payments
 		// 2. (optional) for change
-		return nil, errors.Errorf("original transaction has %d outputs, while 1 or 2 are expected",
-			len(originalTransaction.Tx.Outputs))
+		return nil, errors.Errorf("original transaction has %d outputs, while %d or %d are expected",
+			len(originalTransaction.Tx.Outputs), len(payments), len(payments)+1)
 	}
 
 	totalValue := uint64(0)
-	sentValue := originalTransaction.Tx.Outputs[0].Value
+	sentValue := uint64(0)
+	for _, payment := range payments {
+		sentValue += payment.Amount
+	}
+	// The fee of the inputs covers two outputs, so the rest of the outputs,
+	// including the change, are paid for out of the sent value
+	if len(payments) > 1 {
+		sentValue += s.feePerOutput(feePerInput) * uint64(len(payments)-1)
+	}
 	utxos := make([]*libkobrawallet.UTXO, len(splitTransactions))
 	for i, splitTransaction := range splitTransactions {
 		output := splitTransaction.Tx.Outputs[0]
@@ -76,8 +89,12 @@ func (s *server) mergeTransaction(
 
 	if totalValue < sentValue {
 		// sometimes the fees from compound transactions make the total output higher than what's available from selected
-		// utxos, in such cases - find one more UTXO and use it.
-		additionalUTXOs, totalValueAdded, err := s.moreUTXOsForMergeTransaction(utxos, sentValue-totalValue)
+		// utxos, in such cases - find one more UTXO and use it, unless the caller chose the exact inputs to spend.
+		if areInputsExplicit {
+			return nil, errors.Errorf("Insufficient funds for merge transaction: the given inputs are %f short of "+
+				"paying for the transactions that merge them", float64(sentValue-totalValue)/constants.LeorPerKobra)
+		}
+		additionalUTXOs, totalValueAdded, err := s.moreUTXOsForMergeTransaction(utxos, sentValue-totalValue, feePerInput)
 		if err != nil {
 			return nil, err
 		}
@@ -85,19 +102,8 @@ func (s *server) mergeTransaction(
 		totalValue += totalValueAdded
 	}
 
-	payments := []*libkobrawallet.Payment{{
-		Address: toAddress,
-		Amount:  sentValue,
-	}}
-	if totalValue > sentValue {
-		payments = append(payments, &libkobrawallet.Payment{
-			Address: changeAddress,
-			Amount:  totalValue - sentValue,
-		})
-	}
-
 	mergeTransactionBytes, err := libkobrawallet.CreateUnsignedTransaction(s.keysFile.ExtendedPublicKeys,
-		s.keysFile.MinimumSignatures, payments, utxos)
+		s.keysFile.MinimumSignatures, withChange(payments, changeAddress, totalValue-sentValue), utxos)
 	if err != nil {
 		return nil, err
 	}
@@ -105,8 +111,9 @@ func (s *server) mergeTransaction(
 	return serialization.DeserializePartiallySignedTransaction(mergeTransactionBytes)
 }
 
-func (s *server) maybeSplitAndMergeTransaction(transaction *serialization.PartiallySignedTransaction, toAddress util.Address,
-	changeAddress util.Address, changeWalletAddress *walletAddress) ([]*serialization.PartiallySignedTransaction, error) {
+func (s *server) maybeSplitAndMergeTransaction(transaction *serialization.PartiallySignedTransaction, payments []*libkobrawallet.Payment,
+	changeAddress util.Address, changeWalletAddress *walletAddress, feePerInput uint64,
+	areInputsExplicit bool) ([]*serialization.PartiallySignedTransaction, error) {
 
 	transactionMass, err := s.estimateMassAfterSignatures(transaction)
 	if err != nil {
@@ -117,7 +124,7 @@ func (s *server) maybeSplitAndMergeTransaction(transaction *serialization.Partia
 		return []*serialization.PartiallySignedTransaction{transaction}, nil
 	}
 
-	splitCount, inputCountPerSplit, err := s.splitAndInputPerSplitCounts(transaction, transactionMass, changeAddress)
+	splitCount, inputCountPerSplit, err := s.splitAndInputPerSplitCounts(transaction, transactionMass, changeAddress, feePerInput)
 	if err != nil {
 		return nil, err
 	}
@@ -127,19 +134,21 @@ func (s *server) maybeSplitAndMergeTransaction(transaction *serialization.Partia
 		startIndex := i * inputCountPerSplit
 		endIndex := startIndex + inputCountPerSplit
 		var err error
-		splitTransactions[i], err = s.createSplitTransaction(transaction, changeAddress, startIndex, endIndex)
+		splitTransactions[i], err = s.createSplitTransaction(transaction, changeAddress, startIndex, endIndex, feePerInput)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	if len(splitTransactions) > 1 {
-		mergeTransaction, err := s.mergeTransaction(splitTransactions, transaction, toAddress, changeAddress, changeWalletAddress)
+		mergeTransaction, err := s.mergeTransaction(splitTransactions, transaction, payments, changeAddress, changeWalletAddress,
+			feePerInput, areInputsExplicit)
 		if err != nil {
 			return nil, err
 		}
 		// Recursion will be 2-3 iterations deep even in the rarest` cases, so considered safe..
-		splitMergeTransaction, err := s.maybeSplitAndMergeTransaction(mergeTransaction, toAddress, changeAddress, changeWalletAddress)
+		splitMergeTransaction, err := s.maybeSplitAndMergeTransaction(mergeTransaction, payments, changeAddress, changeWalletAddress,
+			feePerInput, areInputsExplicit)
 		if err != nil {
 			return nil, err
 		}
@@ -152,7 +161,7 @@ func (s *server) maybeSplitAndMergeTransaction(transaction *serialization.Partia
 
 // splitAndInputPerSplitCounts calculates the number of splits to create, and the number of inputs to assign per split.
 func (s *server) splitAndInputPerSplitCounts(transaction *serialization.PartiallySignedTransaction, transactionMass uint64,
-	changeAddress util.Address) (splitCount, inputsPerSplitCount int, err error) {
+	changeAddress util.Address, feePerInput uint64) (splitCount, inputsPerSplitCount int, err error) {
 
 	// Create a dummy transaction which is a clone of the original transaction, but without inputs,
 	// to calculate how much mass do all the inputs have
@@ -172,7 +181,7 @@ func (s *server) splitAndInputPerSplitCounts(transaction *serialization.Partiall
 
 	// Create another dummy transaction, this time one similar to the split transactions we wish to generate,
 	// but with 0 inputs, to calculate how much mass for inputs do we have available in the split transactions
-	splitTransactionWithoutInputs, err := s.createSplitTransaction(transaction, changeAddress, 0, 0)
+	splitTransactionWithoutInputs, err := s.createSplitTransaction(transaction, changeAddress, 0, 0, feePerInput)
 	if err != nil {
 		return 0, 0, err
 	}
@@ -190,7 +199,7 @@ func (s *server) splitAndInputPerSplitCounts(transaction *serialization.Partiall
 }
 
 func (s *server) createSplitTransaction(transaction *serialization.PartiallySignedTransaction,
-	changeAddress util.Address, startIndex int, endIndex int) (*serialization.PartiallySignedTransaction, error) {
+	changeAddress util.Address, startIndex int, endIndex int, feePerInput uint64) (*serialization.PartiallySignedTransaction, error) {
 
 	selectedUTXOs := make([]*libkobrawallet.UTXO, 0, endIndex-startIndex)
 	totalLeor := uint64(0)
@@ -248,7 +257,8 @@ func (s *server) estimateMassAfterSignatures(transaction *serialization.Partiall
 	return s.txMassCalculator.CalculateTransactionMass(transactionWithSignatures), nil
 }
 
-func (s *server) moreUTXOsForMergeTransaction(alreadySelectedUTXOs []*libkobrawallet.UTXO, requiredAmount uint64) (
+func (s *server) moreUTXOsForMergeTransaction(alreadySelectedUTXOs []*libkobrawallet.UTXO, requiredAmount uint64,
+	feePerInput uint64) (
 	additionalUTXOs []*libkobrawallet.UTXO, totalValueAdded uint64, err error) {
 
 	dagInfo, err := s.rpcClient.GetBlockDAGInfo()
@@ -265,7 +275,7 @@ func (s *server) moreUTXOsForMergeTransaction(alreadySelectedUTXOs []*libkobrawa
 		if _, ok := alreadySelectedUTXOsMap[*utxo.Outpoint]; ok {
 			continue
 		}
-		if !s.isUTXOSpendable(utxo, dagInfo.VirtualDAAScore) {
+		if !s.isUTXOSpendable(utxo, dagInfo.VirtualDAAScore) || s.frozenOutpoints.contains(utxo.Outpoint) {
 			continue
 		}
 		additionalUTXOs = append(additionalUTXOs, &libkobrawallet.UTXO{
@@ -2,6 +2,7 @@ package server
 
 import (
 	"testing"
+	"time"
 
 	"github.com/kobradag/kobrad/cmd/kobrawallet/libkobrawallet/serialization"
 
@@ -12,6 +13,7 @@ import (
 
 	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
 	"github.com/kobradag/kobrad/domain/consensus/utils/consensushashing"
+	"github.com/kobradag/kobrad/domain/consensus/utils/subnetworks"
 	"github.com/kobradag/kobrad/domain/consensus/utils/txscript"
 	"github.com/kobradag/kobrad/domain/consensus/utils/utxo"
 
@@ -150,3 +152,90 @@ func testEstimateMassIncreaseForSignaturesSetUp(t *testing.T, consensusConfig *c
 
 	return unsignedTransaction, mnemonics, params, teardown
 }
+
+func TestMergeTransactionWithExplicitInputs(t *testing.T) {
+	params := &dagconfig.SimnetParams
+	client, teardown := startFakeNode(t, 1, 1000)
+	defer teardown()
+
+	mnemonic, err := libkobrawallet.CreateMnemonic()
+	if err != nil {
+		t.Fatalf("CreateMnemonic: %+v", err)
+	}
+	publicKey, err := libkobrawallet.MasterPublicKeyFromMnemonic(params, mnemonic, false)
+	if err != nil {
+		t.Fatalf("MasterPublicKeyFromMnemonic: %+v", err)
+	}
+	serverInstance := &server{
+		rpcClient:        client,
+		params:           params,
+		keysFile:         &keys.File{ExtendedPublicKeys: []string{publicKey}, MinimumSignatures: 1},
+		addressSet:       make(walletAddressSet),
+		txMassCalculator: txmass.NewCalculator(params.MassPerTxByte, params.MassPerScriptPubKeyByte, params.MassPerSigOp),
+		usedOutpoints:    make(map[externalapi.DomainOutpoint]time.Time),
+		frozenOutpoints:  &frozenOutpoints{outpoints: make(map[externalapi.DomainOutpoint]struct{})},
+	}
+
+	changeAddress, changeWalletAddress, err := serverInstance.changeAddress(true, nil)
+	if err != nil {
+		t.Fatalf("changeAddress: %+v", err)
+	}
+	changeScriptPublicKey, err := txscript.PayToAddrScript(changeAddress)
+	if err != nil {
+		t.Fatalf("PayToAddrScript: %+v", err)
+	}
+
+	// A wallet UTXO that isn't one of the inputs of the transaction
+	otherOutpoint := externalapi.NewDomainOutpoint(
+		externalapi.NewDomainTransactionIDFromByteArray(&[externalapi.DomainHashSize]byte{1}), 0)
+	serverInstance.utxosSortedByAmount = []*walletUTXO{{
+		Outpoint:  otherOutpoint,
+		UTXOEntry: utxo.NewUTXOEntry(100_000_000_000, changeScriptPublicKey, false, 0),
+		address:   changeWalletAddress,
+	}}
+
+	// The outputs of the splits fall short of the payment once the fees of
+	// spending them are paid
+	splitTransactions := make([]*serialization.PartiallySignedTransaction, 2)
+	for i := range splitTransactions {
+		splitTransactions[i] = &serialization.PartiallySignedTransaction{Tx: &externalapi.DomainTransaction{
+			Outputs: []*externalapi.DomainTransactionOutput{{
+				Value:           uint64(1_000_000 + i),
+				ScriptPublicKey: changeScriptPublicKey,
+			}},
+			SubnetworkID: subnetworks.SubnetworkIDNative,
+		}}
+	}
+	payments := []*libkobrawallet.Payment{{Address: changeAddress, Amount: 2_000_000}}
+	originalTransaction := &serialization.PartiallySignedTransaction{Tx: &externalapi.DomainTransaction{
+		Outputs: []*externalapi.DomainTransactionOutput{{
+			Value:           payments[0].Amount,
+			ScriptPublicKey: changeScriptPublicKey,
+		}},
+		SubnetworkID: subnetworks.SubnetworkIDNative,
+	}}
+	const feePerInput = 1000
+
+	// Without explicit inputs, the merge transaction adds a wallet UTXO to cover the shortfall
+	mergeTransaction, err := serverInstance.mergeTransaction(splitTransactions, originalTransaction, payments,
+		changeAddress, changeWalletAddress, feePerInput, false)
+	if err != nil {
+		t.Fatalf("mergeTransaction: %+v", err)
+	}
+	spendsOtherOutpoint := false
+	for _, input := range mergeTransaction.Tx.Inputs {
+		if input.PreviousOutpoint == *otherOutpoint {
+			spendsOtherOutpoint = true
+		}
+	}
+	if !spendsOtherOutpoint {
+		t.Fatalf("Expected the merge transaction to spend an additional wallet UTXO")
+	}
+
+	// With explicit inputs, it fails instead of spending anything else
+	_, err = serverInstance.mergeTransaction(splitTransactions, originalTransaction, payments,
+		changeAddress, changeWalletAddress, feePerInput, true)
+	if err == nil {
+		t.Fatalf("Expected mergeTransaction to fail rather than spend inputs that weren't given")
+	}
+}
@@ -7,6 +7,8 @@ import (
 
 	"github.com/kobradag/kobrad/cmd/kobrawallet/libkobrawallet"
 	"github.com/kobradag/kobrad/app/appmessage"
+	"github.com/kobradag/kobrad/domain/addressindex"
+	"github.com/kobradag/kobrad/infrastructure/network/rpcclient"
 	"github.com/pkg/errors"
 )
 
@@ -26,12 +28,7 @@ func (s *server) syncLoop() error {
 	ticker := time.NewTicker(time.Second)
 	defer ticker.Stop()
 
-	err := s.collectRecentAddresses()
-	if err != nil {
-		return err
-	}
-
-	err = s.refreshUTXOs()
+	err := s.rescan()
 	if err != nil {
 		return err
 	}
@@ -39,10 +36,24 @@ func (s *server) syncLoop() error {
 	s.firstSyncDone.Store(true)
 	log.Infof("Wallet is synced and ready for operation")
 
+	isRescanNeeded := false
 	for {
 		select {
 		case <-ticker.C:
 		case <-s.forceSyncChan:
+		case <-s.rescanChan:
+			isRescanNeeded = true
+		}
+
+		if isRescanNeeded {
+			err := s.rescan()
+			if err != nil {
+				// The UTXO set might be missing changes until the rescan
+				// succeeds, so it's retried on the next tick
+				log.Warnf("Failed to rescan the wallet: %s", err)
+				continue
+			}
+			isRescanNeeded = false
 		}
 
 		err := s.sync()
@@ -52,22 +63,65 @@ func (s *server) syncLoop() error {
 	}
 }
 
-func (s *server) sync() error {
-	err := s.collectFarAddresses()
+// rescan rebuilds the UTXO set of the wallet from scratch. It's done on
+// startup, and whenever UTXO changes might have been missed: after the RPC
+// client reconnects to the node, and after the node overrides its UTXO set
+// with the UTXO set of a pruning point. Between rescans, the UTXO set is
+// kept up to date by UTXOs changed notifications.
+func (s *server) rescan() error {
+	err := s.registerForNotifications()
+	if err != nil {
+		return err
+	}
+	err = s.subscribeToNewAddresses(false)
 	if err != nil {
 		return err
 	}
+
+	// The changes that were notified until now are reflected in the UTXO
+	// set that is fetched below. The ones that are notified from now on
+	// are applied on top of it, which is harmless even if some of them are
+	// already reflected in it, since they are applied in order.
+	s.takePendingNotifications()
+
 	err = s.collectRecentAddresses()
 	if err != nil {
 		return err
 	}
+	err = s.subscribeToNewAddresses(false)
+	if err != nil {
+		return err
+	}
 	return s.refreshUTXOs()
 }
 
-const (
-	numIndexesToQueryForFarAddresses    = 100
-	numIndexesToQueryForRecentAddresses = 1000
-)
+func (s *server) sync() error {
+	syncStart := time.Now()
+	notifications := s.takePendingNotifications()
+	if len(notifications) > 0 {
+		err := s.applyUTXOChanges(notifications)
+		if err != nil {
+			return err
+		}
+	}
+
+	// New addresses might have been used or handed out since the last sync
+	err := s.subscribeToNewAddresses(true)
+	if err != nil {
+		return err
+	}
+
+	// All the changes that were notified before the sync started were
+	// applied, so the UTXO set is as fresh as a refresh that started then
+	s.lock.Lock()
+	s.completeRefresh(syncStart)
+	s.lock.Unlock()
+
+	s.refreshTransactionHistoryInBackground()
+	return nil
+}
+
+const numIndexesToQueryForRecentAddresses = 1000
 
 // addressesToQuery scans the addresses in the given range. Because
 // each cosigner in a multisig has its own unique path for generating
@@ -95,20 +149,6 @@ func (s *server) addressesToQuery(start, end uint32) (walletAddressSet, error) {
 	return addresses, nil
 }
 
-// collectFarAddresses collects numIndexesToQueryForFarAddresses addresses
-// from the last point it stopped in the previous call.
-func (s *server) collectFarAddresses() error {
-	s.lock.Lock()
-	defer s.lock.Unlock()
-	err := s.collectAddresses(s.nextSyncStartIndex, s.nextSyncStartIndex+numIndexesToQueryForFarAddresses)
-	if err != nil {
-		return err
-	}
-
-	s.nextSyncStartIndex += numIndexesToQueryForFarAddresses
-	return nil
-}
-
 func (s *server) maxUsedIndexWithLock() uint32 {
 	s.lock.RLock()
 	defer s.lock.RUnlock()
@@ -265,17 +305,23 @@ func (s *server) updateUTXOSet(entries []*appmessage.UTXOsByAddressesEntry, memp
 
 	sort.Slice(utxos, func(i, j int) bool { return utxos[i].UTXOEntry.Amount() > utxos[j].UTXOEntry.Amount() })
 	s.lock.Lock()
-	s.startTimeOfLastCompletedRefresh = refreshStart
 	s.utxosSortedByAmount = utxos
-        // Cleanup expired used outpoints to avoid a memory leak
+	s.completeRefresh(refreshStart)
+	s.lock.Unlock()
+
+	return nil
+}
+
+// completeRefresh records that the UTXO set reflects the state of the node
+// as of refreshStart
+func (s *server) completeRefresh(refreshStart time.Time) {
+	s.startTimeOfLastCompletedRefresh = refreshStart
+	// Cleanup expired used outpoints to avoid a memory leak
 	for outpoint, broadcastTime := range s.usedOutpoints {
 		if s.usedOutpointHasExpired(broadcastTime) {
 			delete(s.usedOutpoints, outpoint)
 		}
 	}
-	s.lock.Unlock()
-
-	return nil
 }
 
 func (s *server) refreshUTXOs() error {
@@ -299,6 +345,146 @@ func (s *server) refreshUTXOs() error {
 
 	return s.updateUTXOSet(getUTXOsByAddressesResponse.Entries, mempoolEntriesByAddresses.Entries, refreshStart)
 }
+const (
+	// historyRefreshInterval is the minimal time between two refreshes of the
+	// transaction history
+	historyRefreshInterval = 10 * time.Second
+
+	// historyReorgWindow is the range of DAA scores, below the most recent
+	// history entry, that is fetched again on every refresh. This replaces the
+	// entries of blocks that were reorged out of the selected chain.
+	historyReorgWindow = 1000
+
+	historyPageSize = 1000
+)
+
+// refreshTransactionHistoryInBackground starts a refresh of the transaction
+// history, unless one is already running. Building the history may take many
+// requests to the node, so it's done outside of the sync loop, which would
+// otherwise hold back the UTXO changes meanwhile.
+func (s *server) refreshTransactionHistoryInBackground() {
+	if s.isHistoryUnavailable.Load() || time.Since(s.lastHistoryRefresh) < historyRefreshInterval {
+		return
+	}
+	if !s.isRefreshingHistory.CompareAndSwap(false, true) {
+		return
+	}
+	s.lastHistoryRefresh = time.Now()
+
+	spawn("refreshTransactionHistory", func() {
+		defer s.isRefreshingHistory.Store(false)
+
+		// The history is not essential for operating the wallet, so failing to
+		// refresh it doesn't stop the daemon
+		err := s.refreshTransactionHistory()
+		if err != nil {
+			log.Warnf("Failed to refresh the transaction history: %s", err)
+		}
+	})
+}
+
+// refreshTransactionHistory fetches the transactions of the wallet addresses
+// that were accepted since the last refresh from the node's address index, and
+// adds them to the local history.
+func (s *server) refreshTransactionHistory() error {
+	// Every address up to the last used index is queried, including addresses
+	// that were emptied since, because their past transactions are still part
+	// of the history.
+	s.lock.RLock()
+	numScannedIndexes := s.maxUsedIndex() + 1
+	addresses, err := s.addressesToQuery(0, numScannedIndexes)
+	if err != nil {
+		s.lock.RUnlock()
+		return err
+	}
+	startDAAScore := uint64(0)
+	lastDAAScore := s.history.lastDAAScore()
+	if s.history.NumScannedIndexes == numScannedIndexes && lastDAAScore > historyReorgWindow {
+		startDAAScore = lastDAAScore - historyReorgWindow
+	}
+	timestamps := s.history.timestamps(startDAAScore)
+	s.lock.RUnlock()
+
+	entries, err := s.fetchTransactionHistory(addresses.strings(), startDAAScore, timestamps)
+	if err != nil {
+		if errors.Is(err, rpcclient.ErrAddressIndexUnavailable) {
+			log.Warnf("The node runs without --addressindex, so the transaction history is unavailable")
+			s.isHistoryUnavailable.Store(true)
+			return nil
+		}
+		return err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if !s.history.update(startDAAScore, entries, numScannedIndexes) {
+		return nil
+	}
+	return s.history.save()
+}
+
+// fetchTransactionHistory returns the history of the given addresses from the
+// given DAA score onwards, with an entry per transaction. timestamps holds the
+// already known timestamps of blocks, by hash.
+func (s *server) fetchTransactionHistory(addresses []string, startDAAScore uint64,
+	timestamps map[string]int64) ([]*historyEntry, error) {
+
+	cursor := ""
+	if startDAAScore > 0 {
+		cursor = (&addressindex.HistoryPosition{AcceptingBlockDAAScore: startDAAScore}).String()
+	}
+
+	var entries []*historyEntry
+	entriesByTransactionID := make(map[string]*historyEntry)
+	for {
+		response, err := s.backgroundRPCClient.GetTransactionsByAddresses(addresses, cursor, historyPageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		// The node returns an entry per transaction and address, ordered by
+		// transaction, so the entries of a transaction are merged together
+		for _, rpcEntry := range response.Entries {
+			entry, ok := entriesByTransactionID[rpcEntry.TransactionID]
+			if !ok {
+				entry = &historyEntry{
+					TransactionID:          rpcEntry.TransactionID,
+					AcceptingBlockHash:     rpcEntry.AcceptingBlockHash,
+					AcceptingBlockDAAScore: rpcEntry.AcceptingBlockDAAScore,
+				}
+				entriesByTransactionID[rpcEntry.TransactionID] = entry
+				entries = append(entries, entry)
+			}
+			entry.Received += rpcEntry.Received
+			entry.Sent += rpcEntry.Sent
+			entry.Addresses = append(entry.Addresses, rpcEntry.Address)
+		}
+
+		if response.NextCursor == "" {
+			break
+		}
+		cursor = response.NextCursor
+	}
+
+	for _, entry := range entries {
+		sort.Strings(entry.Addresses)
+
+		timestamp, ok := timestamps[entry.AcceptingBlockHash]
+		if !ok {
+			getBlockResponse, err := s.backgroundRPCClient.GetBlock(entry.AcceptingBlockHash, false)
+			if err != nil {
+				return nil, err
+			}
+			timestamp = getBlockResponse.Block.Header.Timestamp
+			timestamps[entry.AcceptingBlockHash] = timestamp
+		}
+		entry.Timestamp = timestamp
+	}
+
+	return entries, nil
+}
+
 func (s *server) forceSync() {
 	// Technically if two callers check the `if` simultaneously they will both spawn a
 	// goroutine, but we don't care about the small redundancy in such a rare case.
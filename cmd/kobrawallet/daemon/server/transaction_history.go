@@ -0,0 +1,78 @@
+package server
+
+import (
+	"context"
+
+	"github.com/kobradag/kobrad/cmd/kobrawallet/daemon/pb"
+	"github.com/kobradag/kobrad/domain/consensus/utils/transactionid"
+	"github.com/kobradag/kobrad/util"
+	"github.com/pkg/errors"
+)
+
+func (s *server) GetTransactionHistory(_ context.Context, request *pb.GetTransactionHistoryRequest) (
+	*pb.GetTransactionHistoryResponse, error) {
+
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if s.isHistoryUnavailable.Load() {
+		return nil, errors.New("the transaction history is unavailable because the node runs without --addressindex")
+	}
+
+	entries, total := s.history.query(request.Address, request.Offset, request.Limit)
+	pbEntries := make([]*pb.TransactionHistoryEntry, len(entries))
+	for i, entry := range entries {
+		addresses := make([]*pb.TransactionHistoryAddress, len(entry.Addresses))
+		for j, address := range entry.Addresses {
+			addresses[j] = &pb.TransactionHistoryAddress{
+				Address: address,
+				Label:   s.history.AddressLabels[address],
+			}
+		}
+
+		pbEntries[i] = &pb.TransactionHistoryEntry{
+			TxID:                   entry.TransactionID,
+			AcceptingBlockHash:     entry.AcceptingBlockHash,
+			AcceptingBlockDaaScore: entry.AcceptingBlockDAAScore,
+			Timestamp:              entry.Timestamp,
+			Received:               entry.Received,
+			Sent:                   entry.Sent,
+			Label:                  s.history.TransactionLabels[entry.TransactionID],
+			Addresses:              addresses,
+		}
+	}
+
+	return &pb.GetTransactionHistoryResponse{
+		Entries: pbEntries,
+		Total:   total,
+	}, nil
+}
+
+func (s *server) SetLabel(_ context.Context, request *pb.SetLabelRequest) (*pb.SetLabelResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if (request.TxID == "") == (request.Address == "") {
+		return nil, errors.New("exactly one of a transaction ID and an address must be labeled")
+	}
+
+	if request.TxID != "" {
+		transactionID, err := transactionid.FromString(request.TxID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid transaction ID %s", request.TxID)
+		}
+		setLabel(s.history.TransactionLabels, transactionID.String(), request.Label)
+	} else {
+		address, err := util.DecodeAddress(request.Address, s.params.Prefix)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid address %s", request.Address)
+		}
+		setLabel(s.history.AddressLabels, address.String(), request.Label)
+	}
+
+	err := s.history.save()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SetLabelResponse{}, nil
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/kobradag/kobrad/cmd/kobrawallet/libkobrawallet"
+	"github.com/pkg/errors"
+)
+
+func finalize(conf *finalizeConfig) error {
+	if conf.Transaction == "" && conf.TransactionFile == "" {
+		return errors.Errorf("Either --transaction or --transaction-file is required")
+	}
+	if conf.Transaction != "" && conf.TransactionFile != "" {
+		return errors.Errorf("Both --transaction and --transaction-file cannot be passed at the same time")
+	}
+
+	transactionsHex := conf.Transaction
+	if conf.TransactionFile != "" {
+		transactionHexBytes, err := ioutil.ReadFile(conf.TransactionFile)
+		if err != nil {
+			return errors.Wrapf(err, "Could not read hex from %s", conf.TransactionFile)
+		}
+		transactionsHex = strings.TrimSpace(string(transactionHexBytes))
+	}
+	transactions, err := decodeTransactionsFromHex(transactionsHex)
+	if err != nil {
+		return err
+	}
+
+	finalizedTransactions := make([][]byte, len(transactions))
+	for i, transaction := range transactions {
+		finalizedTransactions[i], err = libkobrawallet.FinalizePSKT(transaction)
+		if err != nil {
+			return errors.Wrapf(err, "Could not finalize transaction #%d", i+1)
+		}
+	}
+
+	fmt.Fprintln(os.Stderr, "The transaction is finalized and ready to broadcast")
+	fmt.Println(encodeTransactionsToHex(finalizedTransactions))
+	return nil
+}
@@ -28,6 +28,9 @@ var (
 // LastVersion is the most up to date file format version
 const LastVersion = 1
 
+// ErrWatchOnly is returned when a watch-only wallet is asked to sign
+var ErrWatchOnly = errors.New("the wallet is watch-only and has no private keys to sign with")
+
 func defaultKeysFile(netParams *dagconfig.Params) string {
 	return filepath.Join(defaultAppDir, netParams.Name, "keys.json")
 }
@@ -205,6 +208,12 @@ func (d *File) LastUsedInternalIndex() uint32 {
 	return d.lastUsedInternalIndex
 }
 
+// IsWatchOnly returns whether the wallet was created only out of extended
+// public keys, so it can't sign transactions
+func (d *File) IsWatchOnly() bool {
+	return len(d.EncryptedMnemonics) == 0
+}
+
 // DecryptMnemonics asks the user to enter the password for the private keys and
 // returns the decrypted private keys.
 func (d *File) DecryptMnemonics(password string) ([]string, error) {
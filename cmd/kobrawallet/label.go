@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kobradag/kobrad/cmd/kobrawallet/daemon/client"
+	"github.com/kobradag/kobrad/cmd/kobrawallet/daemon/pb"
+)
+
+func label(conf *labelConfig) error {
+	daemonClient, tearDown, err := client.Connect(conf.DaemonAddress)
+	if err != nil {
+		return err
+	}
+	defer tearDown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), daemonTimeout)
+	defer cancel()
+
+	_, err = daemonClient.SetLabel(ctx, &pb.SetLabelRequest{
+		TxID:    conf.TxID,
+		Address: conf.Address,
+		Label:   conf.Label,
+	})
+	if err != nil {
+		return err
+	}
+
+	if conf.Label == "" {
+		fmt.Println("Label removed")
+	} else {
+		fmt.Println("Label set")
+	}
+	return nil
+}
@@ -80,3 +80,18 @@ func versionFromParams(params *dagconfig.Params) ([4]byte, error) {
 
 	return [4]byte{}, errors.Errorf("unknown network %s", params.Name)
 }
+
+func publicVersionFromParams(params *dagconfig.Params) ([4]byte, error) {
+	switch params.Name {
+	case dagconfig.MainnetParams.Name:
+		return bip32.KobraMainnetPublic, nil
+	case dagconfig.TestnetParams.Name:
+		return bip32.KobraTestnetPublic, nil
+	case dagconfig.DevnetParams.Name:
+		return bip32.KobradevnetPublic, nil
+	case dagconfig.SimnetParams.Name:
+		return bip32.KobraSimnetPublic, nil
+	}
+
+	return [4]byte{}, errors.Errorf("unknown network %s", params.Name)
+}
@@ -59,3 +59,23 @@ func AppMessageUTXOToKobrawalletdUTXO(appUTXOsByAddressesEntry *appmessage.UTXOs
 		},
 	}
 }
+
+// KobrawalletdOutpointToDomainOutpoint converts a pb.Outpoint to an externalapi.DomainOutpoint
+func KobrawalletdOutpointToDomainOutpoint(outpoint *pb.Outpoint) (*externalapi.DomainOutpoint, error) {
+	transactionID, err := transactionid.FromString(outpoint.TransactionId)
+	if err != nil {
+		return nil, err
+	}
+	return &externalapi.DomainOutpoint{
+		TransactionID: *transactionID,
+		Index:         outpoint.Index,
+	}, nil
+}
+
+// DomainOutpointToKobrawalletdOutpoint converts an externalapi.DomainOutpoint to a pb.Outpoint
+func DomainOutpointToKobrawalletdOutpoint(outpoint *externalapi.DomainOutpoint) *pb.Outpoint {
+	return &pb.Outpoint{
+		TransactionId: outpoint.TransactionID.String(),
+		Index:         outpoint.Index,
+	}
+}
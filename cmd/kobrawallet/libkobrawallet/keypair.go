@@ -167,3 +167,25 @@ func MinimumCosignerIndex(cosignerExtendedPublicKeys, allExtendedPublicKeys []st
 
 	return min, nil
 }
+
+// ValidateExtendedPublicKey returns an error if the given string isn't an extended public key of the given network
+func ValidateExtendedPublicKey(params *dagconfig.Params, extendedPublicKey string) error {
+	extendedKey, err := bip32.DeserializeExtendedKey(extendedPublicKey)
+	if err != nil {
+		return errors.Wrapf(err, "%s is invalid extended public key", extendedPublicKey)
+	}
+
+	if extendedKey.IsPrivate() {
+		return errors.Errorf("%s is an extended private key rather than an extended public key", extendedPublicKey)
+	}
+
+	version, err := publicVersionFromParams(params)
+	if err != nil {
+		return err
+	}
+	if extendedKey.Version != version {
+		return errors.Errorf("%s is not an extended public key of %s", extendedPublicKey, params.Name)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package libkobrawallet_test
+
+import (
+	"testing"
+
+	"github.com/kobradag/kobrad/cmd/kobrawallet/libkobrawallet"
+	"github.com/kobradag/kobrad/cmd/kobrawallet/libkobrawallet/bip32"
+	"github.com/kobradag/kobrad/domain/dagconfig"
+)
+
+func TestValidateExtendedPublicKey(t *testing.T) {
+	mnemonic, err := libkobrawallet.CreateMnemonic()
+	if err != nil {
+		t.Fatalf("CreateMnemonic: %+v", err)
+	}
+
+	extendedPublicKey, err := libkobrawallet.MasterPublicKeyFromMnemonic(&dagconfig.SimnetParams, mnemonic, false)
+	if err != nil {
+		t.Fatalf("MasterPublicKeyFromMnemonic: %+v", err)
+	}
+	err = libkobrawallet.ValidateExtendedPublicKey(&dagconfig.SimnetParams, extendedPublicKey)
+	if err != nil {
+		t.Fatalf("ValidateExtendedPublicKey: %+v", err)
+	}
+
+	err = libkobrawallet.ValidateExtendedPublicKey(&dagconfig.MainnetParams, extendedPublicKey)
+	if err == nil {
+		t.Fatalf("A simnet extended public key is unexpectedly valid on mainnet")
+	}
+
+	extendedPrivateKey, err := bip32.NewMasterWithPath(make([]byte, 32), bip32.KobraSimnetPrivate, "m/44'/111111'/0'")
+	if err != nil {
+		t.Fatalf("NewMasterWithPath: %+v", err)
+	}
+	err = libkobrawallet.ValidateExtendedPublicKey(&dagconfig.SimnetParams, extendedPrivateKey.String())
+	if err == nil {
+		t.Fatalf("An extended private key is unexpectedly valid")
+	}
+
+	err = libkobrawallet.ValidateExtendedPublicKey(&dagconfig.SimnetParams, "not a key")
+	if err == nil {
+		t.Fatalf("An invalid string is unexpectedly valid")
+	}
+}
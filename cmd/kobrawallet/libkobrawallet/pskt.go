@@ -0,0 +1,422 @@
+package libkobrawallet
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/kobradag/kobrad/cmd/kobrawallet/libkobrawallet/bip32"
+	"github.com/kobradag/kobrad/cmd/kobrawallet/libkobrawallet/serialization"
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/domain/consensus/utils/consensushashing"
+	"github.com/kobradag/kobrad/domain/consensus/utils/txscript"
+	"github.com/kobradag/kobrad/domain/consensus/utils/utxo"
+	"github.com/kobradag/kobrad/domain/dagconfig"
+	"github.com/pkg/errors"
+)
+
+const (
+	schnorrPublicKeySize = 32
+	ecdsaPublicKeySize   = 33
+)
+
+// PartiallySignedTransactionToPSKT converts a partially signed transaction in the wallet's own
+// format to a serialized PSKT.
+// Whether the inputs are spent by ECDSA or Schnorr keys is found from the scripts of the spent UTXOs.
+func PartiallySignedTransactionToPSKT(partiallySignedTransactionBytes []byte) ([]byte, error) {
+	partiallySignedTransaction, err := serialization.DeserializePartiallySignedTransaction(partiallySignedTransactionBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	pskt, err := partiallySignedTransactionToPSKT(partiallySignedTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	return serialization.SerializePSKT(pskt)
+}
+
+func partiallySignedTransactionToPSKT(partiallySignedTransaction *serialization.PartiallySignedTransaction) (
+	*serialization.PSKT, error) {
+
+	tx := partiallySignedTransaction.Tx.Clone()
+	inputs := make([]*serialization.PSKTInput, len(partiallySignedTransaction.PartiallySignedInputs))
+	for i, partiallySignedInput := range partiallySignedTransaction.PartiallySignedInputs {
+		isMultisig := len(partiallySignedInput.PubKeySignaturePairs) > 1
+		ecdsa, err := isPartiallySignedInputECDSA(partiallySignedInput)
+		if err != nil {
+			return nil, errors.Wrapf(err, "input %d", i)
+		}
+
+		var redeemScript []byte
+		if isMultisig {
+			redeemScript, err = partiallySignedInputMultisigRedeemScript(partiallySignedInput, ecdsa)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		derivationPath := defaultPath(isMultisig) + strings.TrimPrefix(partiallySignedInput.DerivationPath, "m")
+		bip32Derivations := make([]*serialization.PSKTBip32Derivation, len(partiallySignedInput.PubKeySignaturePairs))
+		var partialSignatures []*serialization.PSKTPartialSignature
+		for j, pair := range partiallySignedInput.PubKeySignaturePairs {
+			publicKey, err := serializedPublicKey(pair.ExtendedPublicKey, ecdsa)
+			if err != nil {
+				return nil, err
+			}
+
+			bip32Derivations[j] = &serialization.PSKTBip32Derivation{
+				PublicKey:         publicKey,
+				ExtendedPublicKey: pair.ExtendedPublicKey,
+				DerivationPath:    derivationPath,
+			}
+			if pair.Signature != nil {
+				partialSignatures = append(partialSignatures, &serialization.PSKTPartialSignature{
+					PublicKey: publicKey,
+					Signature: pair.Signature,
+				})
+			}
+		}
+
+		prevOutput := partiallySignedInput.PrevOutput
+		inputs[i] = &serialization.PSKTInput{
+			UTXOEntry: utxo.NewUTXOEntry(
+				prevOutput.Value,
+				prevOutput.ScriptPublicKey,
+				false, // The wallet's format doesn't keep this value, and it's irrelevant for the signature
+				0,     // The wallet's format doesn't keep this value, and it's irrelevant for the signature
+			),
+			SigHashType:       consensushashing.SigHashAll,
+			RedeemScript:      redeemScript,
+			MinimumSignatures: partiallySignedInput.MinimumSignatures,
+			PartialSignatures: partialSignatures,
+			Bip32Derivations:  bip32Derivations,
+		}
+		tx.Inputs[i].UTXOEntry = inputs[i].UTXOEntry
+		tx.Inputs[i].SigOpCount = byte(len(partiallySignedInput.PubKeySignaturePairs))
+		tx.Inputs[i].SignatureScript = nil
+	}
+
+	return &serialization.PSKT{
+		Version: serialization.PSKTVersion,
+		Tx:      tx,
+		Inputs:  inputs,
+	}, nil
+}
+
+// isPartiallySignedInputECDSA returns whether the given input is spent by ECDSA keys, by checking which
+// kind of keys the script public key of its UTXO pays to
+func isPartiallySignedInputECDSA(partiallySignedInput *serialization.PartiallySignedInput) (bool, error) {
+	scriptPublicKey := partiallySignedInput.PrevOutput.ScriptPublicKey
+	switch txscript.GetScriptClass(scriptPublicKey.Script) {
+	case txscript.PubKeyTy:
+		return false, nil
+	case txscript.PubKeyECDSATy:
+		return true, nil
+	case txscript.ScriptHashTy:
+		for _, ecdsa := range []bool{false, true} {
+			redeemScript, err := partiallySignedInputMultisigRedeemScript(partiallySignedInput, ecdsa)
+			if err != nil {
+				return false, err
+			}
+			redeemScriptHash, err := txscript.PayToScriptHashScript(redeemScript)
+			if err != nil {
+				return false, err
+			}
+			if bytes.Equal(redeemScriptHash, scriptPublicKey.Script) {
+				return ecdsa, nil
+			}
+		}
+		return false, errors.Errorf("the script public key doesn't pay to the multisig of the input's keys")
+	default:
+		return false, errors.Errorf("the script public key isn't a P2PK or a P2SH script")
+	}
+}
+
+func serializedPublicKey(extendedPublicKey string, ecdsa bool) ([]byte, error) {
+	extendedKey, err := bip32.DeserializeExtendedKey(extendedPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	return serializedPublicKeyOfExtendedKey(extendedKey, ecdsa)
+}
+
+func serializedPublicKeyOfExtendedKey(extendedKey *bip32.ExtendedKey, ecdsa bool) ([]byte, error) {
+	publicKey, err := extendedKey.PublicKey()
+	if err != nil {
+		return nil, err
+	}
+
+	if ecdsa {
+		serializedECDSAPublicKey, err := publicKey.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		return serializedECDSAPublicKey[:], nil
+	}
+
+	schnorrPublicKey, err := publicKey.ToSchnorr()
+	if err != nil {
+		return nil, err
+	}
+	serializedSchnorrPublicKey, err := schnorrPublicKey.Serialize()
+	if err != nil {
+		return nil, err
+	}
+	return serializedSchnorrPublicKey[:], nil
+}
+
+// SignPSKT signs the inputs of the given serialized PSKT with the keys of the given mnemonics, wherever one of
+// its BIP32 derivations belongs to them. The public key size of the derivation determines whether an ECDSA
+// or a Schnorr signature is made.
+//
+// Inputs are signed with the sighash type the PSKT requests for them. Any sighash type other than SigHashAll
+// makes a signature that doesn't commit to the whole transaction, which whoever created the PSKT could abuse,
+// so it's refused unless allowAnySigHashType is set.
+func SignPSKT(params *dagconfig.Params, mnemonics []string, serializedPSKT []byte, allowAnySigHashType bool) (
+	[]byte, error) {
+
+	pskt, err := serialization.DeserializePSKT(serializedPSKT)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, mnemonic := range mnemonics {
+		err = signPSKT(params, mnemonic, pskt, allowAnySigHashType)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return serialization.SerializePSKT(pskt)
+}
+
+func signPSKT(params *dagconfig.Params, mnemonic string, pskt *serialization.PSKT, allowAnySigHashType bool) error {
+	masterKey, err := extendedKeyFromMnemonicAndPath(mnemonic, "m", params)
+	if err != nil {
+		return err
+	}
+
+	sighashReusedValues := &consensushashing.SighashReusedValues{}
+	belongsToMnemonic := false
+	for i, input := range pskt.Inputs {
+		if input.IsFinalized() {
+			continue
+		}
+
+		for _, derivation := range input.Bip32Derivations {
+			ecdsa := len(derivation.PublicKey) == ecdsaPublicKeySize
+			if !ecdsa && len(derivation.PublicKey) != schnorrPublicKeySize {
+				return errors.Errorf("input %d has a public key of unexpected size %d", i, len(derivation.PublicKey))
+			}
+
+			derivedKey, err := masterKey.DeriveFromPath(derivation.DerivationPath)
+			if err != nil {
+				return err
+			}
+			publicKey, err := serializedPublicKeyOfExtendedKey(derivedKey, ecdsa)
+			if err != nil {
+				return err
+			}
+			if !bytes.Equal(publicKey, derivation.PublicKey) {
+				continue
+			}
+
+			belongsToMnemonic = true
+			if input.PartialSignature(publicKey) != nil {
+				continue
+			}
+			if input.SigHashType != consensushashing.SigHashAll && !allowAnySigHashType {
+				return errors.Errorf("input %d requests the sighash type %d, whose signature doesn't commit "+
+					"to the whole transaction", i, input.SigHashType)
+			}
+			signature, err := rawTxInSignature(derivedKey, pskt.Tx, i, input.SigHashType, sighashReusedValues, ecdsa)
+			if err != nil {
+				return err
+			}
+			input.PartialSignatures = append(input.PartialSignatures, &serialization.PSKTPartialSignature{
+				PublicKey: publicKey,
+				Signature: signature,
+			})
+		}
+	}
+
+	if !belongsToMnemonic {
+		return errors.Errorf("Public key doesn't match any of the transaction public keys")
+	}
+
+	return nil
+}
+
+// CombinePSKTs merges the signatures and derivations of several serialized PSKTs of the same transaction,
+// each possibly signed by different parties, into a single serialized PSKT.
+func CombinePSKTs(serializedPSKTs [][]byte) ([]byte, error) {
+	if len(serializedPSKTs) == 0 {
+		return nil, errors.Errorf("no PSKTs to combine")
+	}
+
+	combined, err := serialization.DeserializePSKT(serializedPSKTs[0])
+	if err != nil {
+		return nil, err
+	}
+	combinedTransactionID := consensushashing.TransactionID(combined.Tx)
+
+	for _, serializedPSKT := range serializedPSKTs[1:] {
+		pskt, err := serialization.DeserializePSKT(serializedPSKT)
+		if err != nil {
+			return nil, err
+		}
+		transactionID := consensushashing.TransactionID(pskt.Tx)
+		if !transactionID.Equal(combinedTransactionID) {
+			return nil, errors.Errorf("can't combine PSKTs of different transactions %s and %s",
+				combinedTransactionID, transactionID)
+		}
+
+		for i, input := range pskt.Inputs {
+			combineInput(combined.Inputs[i], input)
+		}
+	}
+
+	return serialization.SerializePSKT(combined)
+}
+
+func combineInput(combined, other *serialization.PSKTInput) {
+	if !combined.IsFinalized() && other.IsFinalized() {
+		combined.FinalSignatureScript = other.FinalSignatureScript
+	}
+
+	for _, partialSignature := range other.PartialSignatures {
+		if combined.PartialSignature(partialSignature.PublicKey) == nil {
+			combined.PartialSignatures = append(combined.PartialSignatures, partialSignature)
+		}
+	}
+
+	for _, derivation := range other.Bip32Derivations {
+		found := false
+		for _, combinedDerivation := range combined.Bip32Derivations {
+			if bytes.Equal(combinedDerivation.PublicKey, derivation.PublicKey) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			combined.Bip32Derivations = append(combined.Bip32Derivations, derivation)
+		}
+	}
+
+	if len(combined.RedeemScript) == 0 {
+		combined.RedeemScript = other.RedeemScript
+	}
+}
+
+// IsPSKTFullySigned returns whether all the inputs of the given serialized PSKT have the signatures they
+// require, and it can be finalized.
+func IsPSKTFullySigned(serializedPSKT []byte) (bool, error) {
+	pskt, err := serialization.DeserializePSKT(serializedPSKT)
+	if err != nil {
+		return false, err
+	}
+
+	for _, input := range pskt.Inputs {
+		if input.IsFinalized() {
+			continue
+		}
+		_, err := psktInputSignatureScript(input)
+		if err != nil {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// FinalizePSKT builds the final signature script of every input of the given serialized PSKT out of its
+// partial signatures. It fails if any of the inputs is missing signatures.
+func FinalizePSKT(serializedPSKT []byte) ([]byte, error) {
+	pskt, err := serialization.DeserializePSKT(serializedPSKT)
+	if err != nil {
+		return nil, err
+	}
+
+	err = finalizePSKT(pskt)
+	if err != nil {
+		return nil, err
+	}
+
+	return serialization.SerializePSKT(pskt)
+}
+
+func finalizePSKT(pskt *serialization.PSKT) error {
+	for i, input := range pskt.Inputs {
+		if input.IsFinalized() {
+			continue
+		}
+		signatureScript, err := psktInputSignatureScript(input)
+		if err != nil {
+			return errors.Wrapf(err, "input %d", i)
+		}
+		input.FinalSignatureScript = signatureScript
+	}
+	return nil
+}
+
+func psktInputSignatureScript(input *serialization.PSKTInput) ([]byte, error) {
+	if len(input.RedeemScript) == 0 {
+		if len(input.PartialSignatures) == 0 {
+			return nil, errors.Errorf("missing signature")
+		}
+		if len(input.PartialSignatures) > 1 {
+			return nil, errors.Errorf("an input without a redeem script can't have %d signatures",
+				len(input.PartialSignatures))
+		}
+		return txscript.NewScriptBuilder().AddData(input.PartialSignatures[0].Signature).Script()
+	}
+
+	// The signatures have to be in the order of their public keys in the redeem script
+	pushedData, err := txscript.PushedData(input.RedeemScript)
+	if err != nil {
+		return nil, err
+	}
+	scriptBuilder := txscript.NewScriptBuilder()
+	signatureCount := uint32(0)
+	for _, data := range pushedData {
+		if signatureCount == input.MinimumSignatures {
+			break
+		}
+		if len(data) != schnorrPublicKeySize && len(data) != ecdsaPublicKeySize {
+			continue
+		}
+		partialSignature := input.PartialSignature(data)
+		if partialSignature == nil {
+			continue
+		}
+		scriptBuilder.AddData(partialSignature.Signature)
+		signatureCount++
+	}
+	if signatureCount < input.MinimumSignatures {
+		return nil, errors.Errorf("missing %d signatures", input.MinimumSignatures-signatureCount)
+	}
+
+	scriptBuilder.AddData(input.RedeemScript)
+	return scriptBuilder.Script()
+}
+
+// ExtractPSKTTransaction returns the signed transaction of the given serialized PSKT. All of its inputs
+// must be finalized.
+func ExtractPSKTTransaction(serializedPSKT []byte) (*externalapi.DomainTransaction, error) {
+	pskt, err := serialization.DeserializePSKT(serializedPSKT)
+	if err != nil {
+		return nil, err
+	}
+
+	return extractPSKTTransaction(pskt)
+}
+
+func extractPSKTTransaction(pskt *serialization.PSKT) (*externalapi.DomainTransaction, error) {
+	tx := pskt.Tx.Clone()
+	for i, input := range pskt.Inputs {
+		if !input.IsFinalized() {
+			return nil, errors.Errorf("input %d isn't finalized", i)
+		}
+		tx.Inputs[i].SignatureScript = input.FinalSignatureScript
+	}
+	return tx, nil
+}
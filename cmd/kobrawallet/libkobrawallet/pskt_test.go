@@ -0,0 +1,191 @@
+package libkobrawallet_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/kobradag/kobrad/cmd/kobrawallet/libkobrawallet"
+	"github.com/kobradag/kobrad/cmd/kobrawallet/libkobrawallet/serialization"
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/domain/consensus/utils/consensushashing"
+	"github.com/kobradag/kobrad/domain/consensus/utils/txscript"
+	"github.com/kobradag/kobrad/domain/consensus/utils/utxo"
+	"github.com/kobradag/kobrad/domain/dagconfig"
+)
+
+func TestPSKT(t *testing.T) {
+	params := &dagconfig.SimnetParams
+	forSchnorrAndECDSA(t, func(t *testing.T, ecdsa bool) {
+		const numKeys = 3
+		const minimumSignatures = 2
+		mnemonics := make([]string, numKeys)
+		publicKeys := make([]string, numKeys)
+		for i := 0; i < numKeys; i++ {
+			var err error
+			mnemonics[i], err = libkobrawallet.CreateMnemonic()
+			if err != nil {
+				t.Fatalf("CreateMnemonic: %+v", err)
+			}
+
+			publicKeys[i], err = libkobrawallet.MasterPublicKeyFromMnemonic(params, mnemonics[i], true)
+			if err != nil {
+				t.Fatalf("MasterPublicKeyFromMnemonic: %+v", err)
+			}
+		}
+
+		path := "m/1/2/3"
+		address, err := libkobrawallet.Address(params, publicKeys, minimumSignatures, path, ecdsa)
+		if err != nil {
+			t.Fatalf("Address: %+v", err)
+		}
+		scriptPublicKey, err := txscript.PayToAddrScript(address)
+		if err != nil {
+			t.Fatalf("PayToAddrScript: %+v", err)
+		}
+
+		selectedUTXOs := []*libkobrawallet.UTXO{
+			{
+				Outpoint: &externalapi.DomainOutpoint{
+					TransactionID: *externalapi.NewDomainTransactionIDFromByteArray(&[externalapi.DomainHashSize]byte{1}),
+					Index:         0,
+				},
+				UTXOEntry:      utxo.NewUTXOEntry(1000, scriptPublicKey, false, 0),
+				DerivationPath: path,
+			},
+		}
+		unsignedTransaction, err := libkobrawallet.CreateUnsignedTransaction(publicKeys, minimumSignatures,
+			[]*libkobrawallet.Payment{{Address: address, Amount: 10}}, selectedUTXOs)
+		if err != nil {
+			t.Fatalf("CreateUnsignedTransaction: %+v", err)
+		}
+
+		unsignedPSKT, err := libkobrawallet.PartiallySignedTransactionToPSKT(unsignedTransaction)
+		if err != nil {
+			t.Fatalf("PartiallySignedTransactionToPSKT: %+v", err)
+		}
+		if !serialization.IsPSKT(unsignedPSKT) || serialization.IsPSKT(unsignedTransaction) {
+			t.Fatalf("IsPSKT doesn't tell PSKTs apart from the wallet's own format")
+		}
+
+		pskt, err := serialization.DeserializePSKT(unsignedPSKT)
+		if err != nil {
+			t.Fatalf("DeserializePSKT: %+v", err)
+		}
+		if len(pskt.Inputs) != 1 || len(pskt.Inputs[0].Bip32Derivations) != numKeys ||
+			pskt.Inputs[0].MinimumSignatures != minimumSignatures || len(pskt.Inputs[0].RedeemScript) == 0 ||
+			!pskt.Inputs[0].UTXOEntry.ScriptPublicKey().Equal(scriptPublicKey) {
+
+			t.Fatalf("Unexpected PSKT input %+v", pskt.Inputs[0])
+		}
+		reserializedPSKT, err := serialization.SerializePSKT(pskt)
+		if err != nil {
+			t.Fatalf("SerializePSKT: %+v", err)
+		}
+		if !bytes.Equal(reserializedPSKT, unsignedPSKT) {
+			t.Fatalf("Serializing a deserialized PSKT changed it")
+		}
+
+		_, err = libkobrawallet.FinalizePSKT(unsignedPSKT)
+		if err == nil || !strings.Contains(err.Error(), "missing 2 signatures") {
+			t.Fatalf("Unexpectedly finalized an unsigned PSKT: %v", err)
+		}
+
+		// Each party signs a copy of its own, and the copies are combined
+		signedCopies := make([][]byte, minimumSignatures)
+		for i := range signedCopies {
+			signedCopies[i], err = libkobrawallet.SignPSKT(params, mnemonics[i+1:i+2], unsignedPSKT, false)
+			if err != nil {
+				t.Fatalf("SignPSKT: %+v", err)
+			}
+			isFullySigned, err := libkobrawallet.IsPSKTFullySigned(signedCopies[i])
+			if err != nil {
+				t.Fatalf("IsPSKTFullySigned: %+v", err)
+			}
+			if isFullySigned {
+				t.Fatalf("A PSKT signed by a single party is not expected to be fully signed")
+			}
+		}
+
+		otherMnemonic, err := libkobrawallet.CreateMnemonic()
+		if err != nil {
+			t.Fatalf("CreateMnemonic: %+v", err)
+		}
+		_, err = libkobrawallet.SignPSKT(params, []string{otherMnemonic}, unsignedPSKT, false)
+		if err == nil {
+			t.Fatalf("Unexpectedly signed a PSKT with an unrelated mnemonic")
+		}
+
+		// A PSKT that requests signatures which don't commit to its outputs
+		// is only signed when that's explicitly allowed
+		for _, sigHashType := range []consensushashing.SigHashType{
+			consensushashing.SigHashNone, consensushashing.SigHashAll | consensushashing.SigHashAnyOneCanPay} {
+
+			pskt.Inputs[0].SigHashType = sigHashType
+			otherSigHashTypePSKT, err := serialization.SerializePSKT(pskt)
+			if err != nil {
+				t.Fatalf("SerializePSKT: %+v", err)
+			}
+			_, err = libkobrawallet.SignPSKT(params, mnemonics[:1], otherSigHashTypePSKT, false)
+			if err == nil || !strings.Contains(err.Error(), "sighash type") {
+				t.Fatalf("Expected signing with sighash type %d to be refused, but got: %v", sigHashType, err)
+			}
+			_, err = libkobrawallet.SignPSKT(params, mnemonics[:1], otherSigHashTypePSKT, true)
+			if err != nil {
+				t.Fatalf("SignPSKT with sighash type %d allowed: %+v", sigHashType, err)
+			}
+		}
+
+		combinedPSKT, err := libkobrawallet.CombinePSKTs(signedCopies)
+		if err != nil {
+			t.Fatalf("CombinePSKTs: %+v", err)
+		}
+		isFullySigned, err := libkobrawallet.IsPSKTFullySigned(combinedPSKT)
+		if err != nil {
+			t.Fatalf("IsPSKTFullySigned: %+v", err)
+		}
+		if !isFullySigned {
+			t.Fatalf("The combined PSKT is expected to be fully signed")
+		}
+
+		_, err = libkobrawallet.ExtractPSKTTransaction(combinedPSKT)
+		if err == nil {
+			t.Fatalf("Unexpectedly extracted a transaction out of a PSKT that isn't finalized")
+		}
+
+		finalizedPSKT, err := libkobrawallet.FinalizePSKT(combinedPSKT)
+		if err != nil {
+			t.Fatalf("FinalizePSKT: %+v", err)
+		}
+		tx, err := libkobrawallet.ExtractPSKTTransaction(finalizedPSKT)
+		if err != nil {
+			t.Fatalf("ExtractPSKTTransaction: %+v", err)
+		}
+
+		engine, err := txscript.NewEngine(scriptPublicKey, tx, 0, txscript.ScriptNoFlags, txscript.NewSigCache(10),
+			txscript.NewSigCacheECDSA(10), &consensushashing.SighashReusedValues{})
+		if err != nil {
+			t.Fatalf("NewEngine: %+v", err)
+		}
+		err = engine.Execute()
+		if err != nil {
+			t.Fatalf("The extracted transaction doesn't pass script validation: %+v", err)
+		}
+
+		// A PSKT of another transaction can't be combined with these
+		selectedUTXOs[0].Outpoint.Index = 1
+		otherTransaction, err := libkobrawallet.CreateUnsignedTransaction(publicKeys, minimumSignatures,
+			[]*libkobrawallet.Payment{{Address: address, Amount: 10}}, selectedUTXOs)
+		if err != nil {
+			t.Fatalf("CreateUnsignedTransaction: %+v", err)
+		}
+		otherPSKT, err := libkobrawallet.PartiallySignedTransactionToPSKT(otherTransaction)
+		if err != nil {
+			t.Fatalf("PartiallySignedTransactionToPSKT: %+v", err)
+		}
+		_, err = libkobrawallet.CombinePSKTs([][]byte{signedCopies[0], otherPSKT})
+		if err == nil {
+			t.Fatalf("Unexpectedly combined PSKTs of different transactions")
+		}
+	})
+}
@@ -0,0 +1,584 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.12.3
+// source: pskt.proto
+
+package protoserialization
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PartiallySignedKobraTransaction (PSKT) is the container in which a
+// transaction is passed between the parties that sign it. Serialized PSKTs
+// start with the magic bytes "pskt" 0xff, followed by this message. See
+// cmd/kobrawallet/PSKT.md for the full specification.
+type PartiallySignedKobraTransaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// tx is the unsigned transaction. The signature scripts of its inputs are
+	// always empty.
+	Tx *TransactionMessage `protobuf:"bytes,2,opt,name=tx,proto3" json:"tx,omitempty"`
+	// inputs has an entry for each of the inputs of tx, in the same order
+	Inputs []*PsktInput `protobuf:"bytes,3,rep,name=inputs,proto3" json:"inputs,omitempty"`
+}
+
+func (x *PartiallySignedKobraTransaction) Reset() {
+	*x = PartiallySignedKobraTransaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pskt_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PartiallySignedKobraTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartiallySignedKobraTransaction) ProtoMessage() {}
+
+func (x *PartiallySignedKobraTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_pskt_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartiallySignedKobraTransaction.ProtoReflect.Descriptor instead.
+func (*PartiallySignedKobraTransaction) Descriptor() ([]byte, []int) {
+	return file_pskt_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PartiallySignedKobraTransaction) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *PartiallySignedKobraTransaction) GetTx() *TransactionMessage {
+	if x != nil {
+		return x.Tx
+	}
+	return nil
+}
+
+func (x *PartiallySignedKobraTransaction) GetInputs() []*PsktInput {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+type PsktInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UtxoEntry   *PsktUtxoEntry `protobuf:"bytes,1,opt,name=utxoEntry,proto3" json:"utxoEntry,omitempty"`
+	SighashType uint32         `protobuf:"varint,2,opt,name=sighashType,proto3" json:"sighashType,omitempty"`
+	// redeemScript is the script whose hash the UTXO pays to, if it pays to a
+	// script hash
+	RedeemScript      []byte                  `protobuf:"bytes,3,opt,name=redeemScript,proto3" json:"redeemScript,omitempty"`
+	MinimumSignatures uint32                  `protobuf:"varint,4,opt,name=minimumSignatures,proto3" json:"minimumSignatures,omitempty"`
+	PartialSignatures []*PsktPartialSignature `protobuf:"bytes,5,rep,name=partialSignatures,proto3" json:"partialSignatures,omitempty"`
+	Bip32Derivations  []*PsktBip32Derivation  `protobuf:"bytes,6,rep,name=bip32Derivations,proto3" json:"bip32Derivations,omitempty"`
+	// finalSignatureScript is set once the input has all of the signatures
+	// it requires
+	FinalSignatureScript []byte `protobuf:"bytes,7,opt,name=finalSignatureScript,proto3" json:"finalSignatureScript,omitempty"`
+}
+
+func (x *PsktInput) Reset() {
+	*x = PsktInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pskt_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PsktInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PsktInput) ProtoMessage() {}
+
+func (x *PsktInput) ProtoReflect() protoreflect.Message {
+	mi := &file_pskt_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PsktInput.ProtoReflect.Descriptor instead.
+func (*PsktInput) Descriptor() ([]byte, []int) {
+	return file_pskt_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PsktInput) GetUtxoEntry() *PsktUtxoEntry {
+	if x != nil {
+		return x.UtxoEntry
+	}
+	return nil
+}
+
+func (x *PsktInput) GetSighashType() uint32 {
+	if x != nil {
+		return x.SighashType
+	}
+	return 0
+}
+
+func (x *PsktInput) GetRedeemScript() []byte {
+	if x != nil {
+		return x.RedeemScript
+	}
+	return nil
+}
+
+func (x *PsktInput) GetMinimumSignatures() uint32 {
+	if x != nil {
+		return x.MinimumSignatures
+	}
+	return 0
+}
+
+func (x *PsktInput) GetPartialSignatures() []*PsktPartialSignature {
+	if x != nil {
+		return x.PartialSignatures
+	}
+	return nil
+}
+
+func (x *PsktInput) GetBip32Derivations() []*PsktBip32Derivation {
+	if x != nil {
+		return x.Bip32Derivations
+	}
+	return nil
+}
+
+func (x *PsktInput) GetFinalSignatureScript() []byte {
+	if x != nil {
+		return x.FinalSignatureScript
+	}
+	return nil
+}
+
+type PsktUtxoEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount          uint64           `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	ScriptPublicKey *ScriptPublicKey `protobuf:"bytes,2,opt,name=scriptPublicKey,proto3" json:"scriptPublicKey,omitempty"`
+	BlockDaaScore   uint64           `protobuf:"varint,3,opt,name=blockDaaScore,proto3" json:"blockDaaScore,omitempty"`
+	IsCoinbase      bool             `protobuf:"varint,4,opt,name=isCoinbase,proto3" json:"isCoinbase,omitempty"`
+}
+
+func (x *PsktUtxoEntry) Reset() {
+	*x = PsktUtxoEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pskt_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PsktUtxoEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PsktUtxoEntry) ProtoMessage() {}
+
+func (x *PsktUtxoEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_pskt_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PsktUtxoEntry.ProtoReflect.Descriptor instead.
+func (*PsktUtxoEntry) Descriptor() ([]byte, []int) {
+	return file_pskt_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PsktUtxoEntry) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PsktUtxoEntry) GetScriptPublicKey() *ScriptPublicKey {
+	if x != nil {
+		return x.ScriptPublicKey
+	}
+	return nil
+}
+
+func (x *PsktUtxoEntry) GetBlockDaaScore() uint64 {
+	if x != nil {
+		return x.BlockDaaScore
+	}
+	return 0
+}
+
+func (x *PsktUtxoEntry) GetIsCoinbase() bool {
+	if x != nil {
+		return x.IsCoinbase
+	}
+	return false
+}
+
+type PsktPartialSignature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicKey []byte `protobuf:"bytes,1,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *PsktPartialSignature) Reset() {
+	*x = PsktPartialSignature{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pskt_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PsktPartialSignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PsktPartialSignature) ProtoMessage() {}
+
+func (x *PsktPartialSignature) ProtoReflect() protoreflect.Message {
+	mi := &file_pskt_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PsktPartialSignature.ProtoReflect.Descriptor instead.
+func (*PsktPartialSignature) Descriptor() ([]byte, []int) {
+	return file_pskt_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PsktPartialSignature) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *PsktPartialSignature) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type PsktBip32Derivation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicKey []byte `protobuf:"bytes,1,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	// extendedPublicKey is the extended public key at derivationPath
+	ExtendedPublicKey string `protobuf:"bytes,2,opt,name=extendedPublicKey,proto3" json:"extendedPublicKey,omitempty"`
+	// derivationPath is the path of publicKey from the master key of its
+	// wallet
+	DerivationPath string `protobuf:"bytes,3,opt,name=derivationPath,proto3" json:"derivationPath,omitempty"`
+}
+
+func (x *PsktBip32Derivation) Reset() {
+	*x = PsktBip32Derivation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pskt_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PsktBip32Derivation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PsktBip32Derivation) ProtoMessage() {}
+
+func (x *PsktBip32Derivation) ProtoReflect() protoreflect.Message {
+	mi := &file_pskt_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PsktBip32Derivation.ProtoReflect.Descriptor instead.
+func (*PsktBip32Derivation) Descriptor() ([]byte, []int) {
+	return file_pskt_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PsktBip32Derivation) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *PsktBip32Derivation) GetExtendedPublicKey() string {
+	if x != nil {
+		return x.ExtendedPublicKey
+	}
+	return ""
+}
+
+func (x *PsktBip32Derivation) GetDerivationPath() string {
+	if x != nil {
+		return x.DerivationPath
+	}
+	return ""
+}
+
+var File_pskt_proto protoreflect.FileDescriptor
+
+var file_pskt_proto_rawDesc = []byte{
+	0x0a, 0x0a, 0x70, 0x73, 0x6b, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x1a, 0x0c, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xaa,
+	0x01, 0x0a, 0x1f, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x6c, 0x79, 0x53, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x4b, 0x6f, 0x62, 0x72, 0x61, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x02,
+	0x74, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x02, 0x74, 0x78, 0x12, 0x35, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x73, 0x6b, 0x74, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x22, 0xa1, 0x03, 0x0a, 0x09,
+	0x50, 0x73, 0x6b, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x3f, 0x0a, 0x09, 0x75, 0x74, 0x78,
+	0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x50, 0x73, 0x6b, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x09, 0x75, 0x74, 0x78, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x69,
+	0x67, 0x68, 0x61, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0b, 0x73, 0x69, 0x67, 0x68, 0x61, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c,
+	0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0c, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6d, 0x69, 0x6e,
+	0x69, 0x6d, 0x75, 0x6d, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x56,
+	0x0a, 0x11, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50,
+	0x73, 0x6b, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x52, 0x11, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x53, 0x0a, 0x10, 0x62, 0x69, 0x70, 0x33, 0x32, 0x44,
+	0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x73, 0x6b, 0x74, 0x42, 0x69, 0x70, 0x33, 0x32, 0x44,
+	0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x62, 0x69, 0x70, 0x33, 0x32,
+	0x44, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x66,
+	0x69, 0x6e, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x53, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x14, 0x66, 0x69, 0x6e, 0x61, 0x6c,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x22,
+	0xbc, 0x01, 0x0a, 0x0d, 0x50, 0x73, 0x6b, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4d, 0x0a, 0x0f, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x0f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x44, 0x61, 0x61, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x61, 0x61, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x69, 0x73, 0x43, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x43, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x22, 0x52,
+	0x0a, 0x14, 0x50, 0x73, 0x6b, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x22, 0x89, 0x01, 0x0a, 0x13, 0x50, 0x73, 0x6b, 0x74, 0x42, 0x69, 0x70, 0x33, 0x32,
+	0x44, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x11, 0x65, 0x78, 0x74, 0x65,
+	0x6e, 0x64, 0x65, 0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x64, 0x65, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x64, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x42, 0x5c,
+	0x5a, 0x5a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x6f, 0x62,
+	0x72, 0x61, 0x64, 0x61, 0x67, 0x2f, 0x6b, 0x6f, 0x62, 0x72, 0x61, 0x64, 0x2f, 0x63, 0x6d, 0x64,
+	0x2f, 0x6b, 0x6f, 0x62, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f, 0x6c, 0x69, 0x62,
+	0x6b, 0x6f, 0x62, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f, 0x73, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pskt_proto_rawDescOnce sync.Once
+	file_pskt_proto_rawDescData = file_pskt_proto_rawDesc
+)
+
+func file_pskt_proto_rawDescGZIP() []byte {
+	file_pskt_proto_rawDescOnce.Do(func() {
+		file_pskt_proto_rawDescData = protoimpl.X.CompressGZIP(file_pskt_proto_rawDescData)
+	})
+	return file_pskt_proto_rawDescData
+}
+
+var file_pskt_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_pskt_proto_goTypes = []interface{}{
+	(*PartiallySignedKobraTransaction)(nil), // 0: protoserialization.PartiallySignedKobraTransaction
+	(*PsktInput)(nil),                       // 1: protoserialization.PsktInput
+	(*PsktUtxoEntry)(nil),                   // 2: protoserialization.PsktUtxoEntry
+	(*PsktPartialSignature)(nil),            // 3: protoserialization.PsktPartialSignature
+	(*PsktBip32Derivation)(nil),             // 4: protoserialization.PsktBip32Derivation
+	(*TransactionMessage)(nil),              // 5: protoserialization.TransactionMessage
+	(*ScriptPublicKey)(nil),                 // 6: protoserialization.ScriptPublicKey
+}
+var file_pskt_proto_depIdxs = []int32{
+	5, // 0: protoserialization.PartiallySignedKobraTransaction.tx:type_name -> protoserialization.TransactionMessage
+	1, // 1: protoserialization.PartiallySignedKobraTransaction.inputs:type_name -> protoserialization.PsktInput
+	2, // 2: protoserialization.PsktInput.utxoEntry:type_name -> protoserialization.PsktUtxoEntry
+	3, // 3: protoserialization.PsktInput.partialSignatures:type_name -> protoserialization.PsktPartialSignature
+	4, // 4: protoserialization.PsktInput.bip32Derivations:type_name -> protoserialization.PsktBip32Derivation
+	6, // 5: protoserialization.PsktUtxoEntry.scriptPublicKey:type_name -> protoserialization.ScriptPublicKey
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_pskt_proto_init() }
+func file_pskt_proto_init() {
+	if File_pskt_proto != nil {
+		return
+	}
+	file_wallet_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_pskt_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PartiallySignedKobraTransaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pskt_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PsktInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pskt_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PsktUtxoEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pskt_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PsktPartialSignature); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pskt_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PsktBip32Derivation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pskt_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pskt_proto_goTypes,
+		DependencyIndexes: file_pskt_proto_depIdxs,
+		MessageInfos:      file_pskt_proto_msgTypes,
+	}.Build()
+	File_pskt_proto = out.File
+	file_pskt_proto_rawDesc = nil
+	file_pskt_proto_goTypes = nil
+	file_pskt_proto_depIdxs = nil
+}
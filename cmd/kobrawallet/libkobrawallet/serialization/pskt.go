@@ -0,0 +1,283 @@
+package serialization
+
+import (
+	"bytes"
+	"math"
+
+	"github.com/kobradag/kobrad/cmd/kobrawallet/libkobrawallet/serialization/protoserialization"
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/domain/consensus/utils/consensushashing"
+	"github.com/kobradag/kobrad/domain/consensus/utils/utxo"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// PSKTVersion is the version of the PSKT format this wallet reads and writes
+const PSKTVersion = 1
+
+// psktMagic are the bytes every serialized PSKT starts with
+var psktMagic = []byte{'p', 's', 'k', 't', 0xff}
+
+// PSKT is a Partially Signed Kobra Transaction: a transaction along with
+// everything needed to sign it, collect the signatures of all the parties
+// that sign it and finalize it, in a format other software can read.
+type PSKT struct {
+	Version uint32
+	Tx      *externalapi.DomainTransaction
+	Inputs  []*PSKTInput
+}
+
+// PSKTInput contains the signing data of the respective input of a PSKT
+type PSKTInput struct {
+	UTXOEntry            externalapi.UTXOEntry
+	SigHashType          consensushashing.SigHashType
+	RedeemScript         []byte
+	MinimumSignatures    uint32
+	PartialSignatures    []*PSKTPartialSignature
+	Bip32Derivations     []*PSKTBip32Derivation
+	FinalSignatureScript []byte
+}
+
+// PSKTPartialSignature is the signature of a PSKT input by one public key
+type PSKTPartialSignature struct {
+	PublicKey []byte
+	Signature []byte
+}
+
+// PSKTBip32Derivation describes how a key that may sign a PSKT input is
+// derived from the master key of its wallet
+type PSKTBip32Derivation struct {
+	PublicKey         []byte
+	ExtendedPublicKey string
+	DerivationPath    string
+}
+
+// IsFinalized returns whether the input has its final signature script
+func (input *PSKTInput) IsFinalized() bool {
+	return len(input.FinalSignatureScript) > 0
+}
+
+// PartialSignature returns the partial signature of the given public key, or
+// nil if it didn't sign the input yet
+func (input *PSKTInput) PartialSignature(publicKey []byte) *PSKTPartialSignature {
+	for _, partialSignature := range input.PartialSignatures {
+		if bytes.Equal(partialSignature.PublicKey, publicKey) {
+			return partialSignature
+		}
+	}
+	return nil
+}
+
+// Clone creates a deep-clone of this PSKT
+func (pskt *PSKT) Clone() *PSKT {
+	clone := &PSKT{
+		Version: pskt.Version,
+		Tx:      pskt.Tx.Clone(),
+		Inputs:  make([]*PSKTInput, len(pskt.Inputs)),
+	}
+	for i, input := range pskt.Inputs {
+		clone.Inputs[i] = input.Clone()
+	}
+	return clone
+}
+
+// Clone creates a deep-clone of this PSKTInput
+func (input *PSKTInput) Clone() *PSKTInput {
+	clone := &PSKTInput{
+		UTXOEntry:            input.UTXOEntry,
+		SigHashType:          input.SigHashType,
+		RedeemScript:         cloneBytes(input.RedeemScript),
+		MinimumSignatures:    input.MinimumSignatures,
+		PartialSignatures:    make([]*PSKTPartialSignature, len(input.PartialSignatures)),
+		Bip32Derivations:     make([]*PSKTBip32Derivation, len(input.Bip32Derivations)),
+		FinalSignatureScript: cloneBytes(input.FinalSignatureScript),
+	}
+	for i, partialSignature := range input.PartialSignatures {
+		clone.PartialSignatures[i] = &PSKTPartialSignature{
+			PublicKey: cloneBytes(partialSignature.PublicKey),
+			Signature: cloneBytes(partialSignature.Signature),
+		}
+	}
+	for i, derivation := range input.Bip32Derivations {
+		clone.Bip32Derivations[i] = &PSKTBip32Derivation{
+			PublicKey:         cloneBytes(derivation.PublicKey),
+			ExtendedPublicKey: derivation.ExtendedPublicKey,
+			DerivationPath:    derivation.DerivationPath,
+		}
+	}
+	return clone
+}
+
+func cloneBytes(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+	clone := make([]byte, len(b))
+	copy(clone, b)
+	return clone
+}
+
+// IsPSKT returns whether the given bytes are a serialized PSKT
+func IsPSKT(serialized []byte) bool {
+	return bytes.HasPrefix(serialized, psktMagic)
+}
+
+// SerializePSKT serializes a PSKT
+func SerializePSKT(pskt *PSKT) ([]byte, error) {
+	protoPSKT, err := psktToProto(pskt)
+	if err != nil {
+		return nil, err
+	}
+	serializedProto, err := proto.Marshal(protoPSKT)
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte{}, psktMagic...), serializedProto...), nil
+}
+
+// DeserializePSKT deserializes a byte slice into a PSKT
+func DeserializePSKT(serialized []byte) (*PSKT, error) {
+	if !IsPSKT(serialized) {
+		return nil, errors.Errorf("not a PSKT: missing the PSKT magic bytes")
+	}
+
+	protoPSKT := &protoserialization.PartiallySignedKobraTransaction{}
+	err := proto.Unmarshal(serialized[len(psktMagic):], protoPSKT)
+	if err != nil {
+		return nil, err
+	}
+
+	return psktFromProto(protoPSKT)
+}
+
+func psktFromProto(protoPSKT *protoserialization.PartiallySignedKobraTransaction) (*PSKT, error) {
+	if protoPSKT.Version != PSKTVersion {
+		return nil, errors.Errorf("PSKT version %d is not supported", protoPSKT.Version)
+	}
+	if protoPSKT.Tx == nil {
+		return nil, errors.Errorf("PSKT has no transaction")
+	}
+
+	tx, err := transactionFromProto(protoPSKT.Tx)
+	if err != nil {
+		return nil, err
+	}
+	if len(protoPSKT.Inputs) != len(tx.Inputs) {
+		return nil, errors.Errorf("PSKT has %d inputs but its transaction has %d", len(protoPSKT.Inputs), len(tx.Inputs))
+	}
+
+	inputs := make([]*PSKTInput, len(protoPSKT.Inputs))
+	for i, protoInput := range protoPSKT.Inputs {
+		inputs[i], err = psktInputFromProto(protoInput)
+		if err != nil {
+			return nil, errors.Wrapf(err, "PSKT input %d", i)
+		}
+		tx.Inputs[i].UTXOEntry = inputs[i].UTXOEntry
+	}
+
+	return &PSKT{
+		Version: protoPSKT.Version,
+		Tx:      tx,
+		Inputs:  inputs,
+	}, nil
+}
+
+func psktToProto(pskt *PSKT) (*protoserialization.PartiallySignedKobraTransaction, error) {
+	if len(pskt.Inputs) != len(pskt.Tx.Inputs) {
+		return nil, errors.Errorf("PSKT has %d inputs but its transaction has %d", len(pskt.Inputs), len(pskt.Tx.Inputs))
+	}
+
+	protoInputs := make([]*protoserialization.PsktInput, len(pskt.Inputs))
+	for i, input := range pskt.Inputs {
+		if input.UTXOEntry == nil {
+			return nil, errors.Errorf("PSKT input %d has no UTXO entry", i)
+		}
+		protoInputs[i] = psktInputToProto(input)
+	}
+
+	return &protoserialization.PartiallySignedKobraTransaction{
+		Version: pskt.Version,
+		Tx:      transactionToProto(pskt.Tx),
+		Inputs:  protoInputs,
+	}, nil
+}
+
+func psktInputFromProto(protoInput *protoserialization.PsktInput) (*PSKTInput, error) {
+	if protoInput.UtxoEntry == nil || protoInput.UtxoEntry.ScriptPublicKey == nil {
+		return nil, errors.Errorf("missing UTXO entry")
+	}
+	if protoInput.SighashType > math.MaxUint8 {
+		return nil, errors.Errorf("sighash type %d is too big to be a uint8", protoInput.SighashType)
+	}
+
+	scriptPublicKey, err := scriptPublicKeyFromProto(protoInput.UtxoEntry.ScriptPublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	partialSignatures := make([]*PSKTPartialSignature, len(protoInput.PartialSignatures))
+	for i, protoPartialSignature := range protoInput.PartialSignatures {
+		partialSignatures[i] = &PSKTPartialSignature{
+			PublicKey: protoPartialSignature.PublicKey,
+			Signature: protoPartialSignature.Signature,
+		}
+	}
+
+	bip32Derivations := make([]*PSKTBip32Derivation, len(protoInput.Bip32Derivations))
+	for i, protoDerivation := range protoInput.Bip32Derivations {
+		bip32Derivations[i] = &PSKTBip32Derivation{
+			PublicKey:         protoDerivation.PublicKey,
+			ExtendedPublicKey: protoDerivation.ExtendedPublicKey,
+			DerivationPath:    protoDerivation.DerivationPath,
+		}
+	}
+
+	return &PSKTInput{
+		UTXOEntry: utxo.NewUTXOEntry(
+			protoInput.UtxoEntry.Amount,
+			scriptPublicKey,
+			protoInput.UtxoEntry.IsCoinbase,
+			protoInput.UtxoEntry.BlockDaaScore,
+		),
+		SigHashType:          consensushashing.SigHashType(protoInput.SighashType),
+		RedeemScript:         protoInput.RedeemScript,
+		MinimumSignatures:    protoInput.MinimumSignatures,
+		PartialSignatures:    partialSignatures,
+		Bip32Derivations:     bip32Derivations,
+		FinalSignatureScript: protoInput.FinalSignatureScript,
+	}, nil
+}
+
+func psktInputToProto(input *PSKTInput) *protoserialization.PsktInput {
+	protoPartialSignatures := make([]*protoserialization.PsktPartialSignature, len(input.PartialSignatures))
+	for i, partialSignature := range input.PartialSignatures {
+		protoPartialSignatures[i] = &protoserialization.PsktPartialSignature{
+			PublicKey: partialSignature.PublicKey,
+			Signature: partialSignature.Signature,
+		}
+	}
+
+	protoDerivations := make([]*protoserialization.PsktBip32Derivation, len(input.Bip32Derivations))
+	for i, derivation := range input.Bip32Derivations {
+		protoDerivations[i] = &protoserialization.PsktBip32Derivation{
+			PublicKey:         derivation.PublicKey,
+			ExtendedPublicKey: derivation.ExtendedPublicKey,
+			DerivationPath:    derivation.DerivationPath,
+		}
+	}
+
+	return &protoserialization.PsktInput{
+		UtxoEntry: &protoserialization.PsktUtxoEntry{
+			Amount:          input.UTXOEntry.Amount(),
+			ScriptPublicKey: scriptPublicKeyToProto(input.UTXOEntry.ScriptPublicKey()),
+			BlockDaaScore:   input.UTXOEntry.BlockDAAScore(),
+			IsCoinbase:      input.UTXOEntry.IsCoinbase(),
+		},
+		SighashType:          uint32(input.SigHashType),
+		RedeemScript:         input.RedeemScript,
+		MinimumSignatures:    input.MinimumSignatures,
+		PartialSignatures:    protoPartialSignatures,
+		Bip32Derivations:     protoDerivations,
+		FinalSignatureScript: input.FinalSignatureScript,
+	}
+}
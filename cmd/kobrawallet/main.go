@@ -13,18 +13,34 @@ func main() {
 		err = balance(config.(*balanceConfig))
 	case sendSubCmd:
 		err = send(config.(*sendConfig))
+	case bumpFeeSubCmd:
+		err = bumpFee(config.(*bumpFeeConfig))
 	case createUnsignedTransactionSubCmd:
 		err = createUnsignedTransaction(config.(*createUnsignedTransactionConfig))
 	case signSubCmd:
 		err = sign(config.(*signConfig))
 	case broadcastSubCmd:
 		err = broadcast(config.(*broadcastConfig))
+	case combineSubCmd:
+		err = combine(config.(*combineConfig))
+	case finalizeSubCmd:
+		err = finalize(config.(*finalizeConfig))
 	case parseSubCmd:
 		err = parse(config.(*parseConfig))
 	case showAddressesSubCmd:
 		err = showAddresses(config.(*showAddressesConfig))
 	case newAddressSubCmd:
 		err = newAddress(config.(*newAddressConfig))
+	case transactionHistorySubCmd:
+		err = transactionHistory(config.(*transactionHistoryConfig))
+	case labelSubCmd:
+		err = label(config.(*labelConfig))
+	case listUTXOsSubCmd:
+		err = listUTXOs(config.(*listUTXOsConfig))
+	case freezeSubCmd:
+		err = freeze(config.(*freezeConfig))
+	case unfreezeSubCmd:
+		err = unfreeze(config.(*unfreezeConfig))
 	case dumpUnencryptedDataSubCmd:
 		err = dumpUnencryptedData(config.(*dumpUnencryptedDataConfig))
 	case startDaemonSubCmd:
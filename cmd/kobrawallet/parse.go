@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"strings"
 
+	"github.com/kobradag/kobrad/cmd/kobrawallet/libkobrawallet"
 	"github.com/kobradag/kobrad/cmd/kobrawallet/libkobrawallet/serialization"
 	"github.com/kobradag/kobrad/domain/consensus/utils/consensushashing"
 	"github.com/kobradag/kobrad/domain/consensus/utils/constants"
@@ -35,32 +36,46 @@ func parse(conf *parseConfig) error {
 		return err
 	}
 	for i, transaction := range transactions {
-
-		partiallySignedTransaction, err := serialization.DeserializePartiallySignedTransaction(transaction)
+		if !serialization.IsPSKT(transaction) {
+			transaction, err = libkobrawallet.PartiallySignedTransactionToPSKT(transaction)
+			if err != nil {
+				return err
+			}
+		}
+		pskt, err := serialization.DeserializePSKT(transaction)
 		if err != nil {
 			return err
 		}
 
-		fmt.Printf("Transaction #%d ID: \t%s\n", i+1, consensushashing.TransactionID(partiallySignedTransaction.Tx))
+		fmt.Printf("Transaction #%d ID: \t%s\n", i+1, consensushashing.TransactionID(pskt.Tx))
 		fmt.Println()
 
 		allInputLeor := uint64(0)
-		for index, input := range partiallySignedTransaction.Tx.Inputs {
-			partiallySignedInput := partiallySignedTransaction.PartiallySignedInputs[index]
+		for index, input := range pskt.Tx.Inputs {
+			psktInput := pskt.Inputs[index]
 
 			if conf.Verbose {
-				fmt.Printf("Input %d: \tOutpoint: %s:%d \tAmount: %.2f Kobra\n", index, input.PreviousOutpoint.TransactionID,
-					input.PreviousOutpoint.Index, float64(partiallySignedInput.PrevOutput.Value)/float64(constants.LeorPerKobra))
+				signatureStatus := "finalized"
+				if !psktInput.IsFinalized() {
+					minimumSignatures := psktInput.MinimumSignatures
+					if minimumSignatures == 0 {
+						minimumSignatures = 1
+					}
+					signatureStatus = fmt.Sprintf("%d/%d signatures", len(psktInput.PartialSignatures), minimumSignatures)
+				}
+				fmt.Printf("Input %d: \tOutpoint: %s:%d \tAmount: %.2f Kobra \tSigned: %s\n", index,
+					input.PreviousOutpoint.TransactionID, input.PreviousOutpoint.Index,
+					float64(psktInput.UTXOEntry.Amount())/float64(constants.LeorPerKobra), signatureStatus)
 			}
 
-			allInputLeor += partiallySignedInput.PrevOutput.Value
+			allInputLeor += psktInput.UTXOEntry.Amount()
 		}
 		if conf.Verbose {
 			fmt.Println()
 		}
 
 		allOutputLeor := uint64(0)
-		for index, output := range partiallySignedTransaction.Tx.Outputs {
+		for index, output := range pskt.Tx.Outputs {
 			scriptPublicKeyType, scriptPublicKeyAddress, err := txscript.ExtractScriptPubKeyAddress(output.ScriptPublicKey, conf.ActiveNetParams)
 			if err != nil {
 				return err
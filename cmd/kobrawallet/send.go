@@ -20,6 +20,10 @@ func send(conf *sendConfig) error {
 		return err
 	}
 
+	if keysFile.IsWatchOnly() {
+		return errors.Wrap(keys.ErrWatchOnly, "Cannot use 'send' command")
+	}
+
 	if len(keysFile.ExtendedPublicKeys) > len(keysFile.EncryptedMnemonics) {
 		return errors.Errorf("Cannot use 'send' command for multisig wallet without all of the keys")
 	}
@@ -34,19 +38,32 @@ func send(conf *sendConfig) error {
 	defer cancel()
 
 	var sendAmountLeor uint64
-	if !conf.IsSendAll {
+	var payments []*pb.Payment
+	if conf.PaymentsFile != "" {
+		payments, err = readPaymentsFile(conf.PaymentsFile)
+		if err != nil {
+			return err
+		}
+	} else if !conf.IsSendAll {
 		sendAmountLeor, err = utils.KobraToLeor(conf.SendAmount)
 		if err != nil {
 			return err
 		}
 	}
 
+	inputs, err := parseOutpoints(conf.UTXOs)
+	if err != nil {
+		return err
+	}
+
 	createUnsignedTransactionsResponse, err :=
 		daemonClient.CreateUnsignedTransactions(ctx, &pb.CreateUnsignedTransactionsRequest{
 			From:                     conf.FromAddresses,
+			Inputs:                   inputs,
 			Address:                  conf.ToAddress,
 			Amount:                   sendAmountLeor,
 			IsSendAll:                conf.IsSendAll,
+			Payments:                 payments,
 			UseExistingChangeAddress: conf.UseExistingChangeAddress,
 		})
 	if err != nil {
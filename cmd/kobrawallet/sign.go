@@ -8,6 +8,7 @@ import (
 
 	"github.com/kobradag/kobrad/cmd/kobrawallet/keys"
 	"github.com/kobradag/kobrad/cmd/kobrawallet/libkobrawallet"
+	"github.com/kobradag/kobrad/cmd/kobrawallet/libkobrawallet/serialization"
 	"github.com/pkg/errors"
 )
 
@@ -24,6 +25,10 @@ func sign(conf *signConfig) error {
 		return err
 	}
 
+	if keysFile.IsWatchOnly() {
+		return keys.ErrWatchOnly
+	}
+
 	if len(conf.Password) == 0 {
 		conf.Password = keys.GetPassword("Password:")
 	}
@@ -45,10 +50,19 @@ func sign(conf *signConfig) error {
 		return err
 	}
 
+	// Transactions in the wallet's own format are still accepted, but are signed as PSKTs
 	updatedPartiallySignedTransactions := make([][]byte, len(partiallySignedTransactions))
 	for i, partiallySignedTransaction := range partiallySignedTransactions {
+		if !serialization.IsPSKT(partiallySignedTransaction) {
+			partiallySignedTransaction, err = libkobrawallet.PartiallySignedTransactionToPSKT(partiallySignedTransaction)
+			if err != nil {
+				return err
+			}
+		}
+
 		updatedPartiallySignedTransactions[i], err =
-			libkobrawallet.Sign(conf.NetParams(), privateKeys, partiallySignedTransaction, keysFile.ECDSA)
+			libkobrawallet.SignPSKT(conf.NetParams(), privateKeys, partiallySignedTransaction,
+				conf.AllowAnySigHashType)
 		if err != nil {
 			return err
 		}
@@ -57,7 +71,7 @@ func sign(conf *signConfig) error {
 	areAllTransactionsFullySigned := true
 	for _, updatedPartiallySignedTransaction := range updatedPartiallySignedTransactions {
 		// This is somewhat redundant to check all transactions, but we do that just-in-case
-		isFullySigned, err := libkobrawallet.IsTransactionFullySigned(updatedPartiallySignedTransaction)
+		isFullySigned, err := libkobrawallet.IsPSKTFullySigned(updatedPartiallySignedTransaction)
 		if err != nil {
 			return err
 		}
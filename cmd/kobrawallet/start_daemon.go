@@ -3,5 +3,5 @@ package main
 import "github.com/kobradag/kobrad/cmd/kobrawallet/daemon/server"
 
 func startDaemon(conf *startDaemonConfig) error {
-	return server.Start(conf.NetParams(), conf.Listen, conf.RPCServer, conf.KeysFile, conf.Profile, conf.Timeout)
+	return server.Start(conf.NetParams(), conf.Listen, conf.RPCServer, conf.RPCAuthorization(), conf.KeysFile, conf.Profile, conf.Timeout)
 }
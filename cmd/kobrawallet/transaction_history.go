@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kobradag/kobrad/cmd/kobrawallet/daemon/client"
+	"github.com/kobradag/kobrad/cmd/kobrawallet/daemon/pb"
+	"github.com/kobradag/kobrad/cmd/kobrawallet/utils"
+)
+
+const defaultTransactionHistoryLimit = 20
+
+func transactionHistory(conf *transactionHistoryConfig) error {
+	daemonClient, tearDown, err := client.Connect(conf.DaemonAddress)
+	if err != nil {
+		return err
+	}
+	defer tearDown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), daemonTimeout)
+	defer cancel()
+
+	limit := conf.Limit
+	if limit == 0 && !conf.CSV {
+		limit = defaultTransactionHistoryLimit
+	}
+	response, err := daemonClient.GetTransactionHistory(ctx, &pb.GetTransactionHistoryRequest{
+		Address: conf.Address,
+		Offset:  conf.Offset,
+		Limit:   limit,
+	})
+	if err != nil {
+		return err
+	}
+
+	if conf.CSV {
+		return printTransactionHistoryCSV(response.Entries)
+	}
+
+	if len(response.Entries) == 0 {
+		fmt.Printf("No transactions to show (%d transactions overall)\n", response.Total)
+		return nil
+	}
+
+	fmt.Println("Date                 Transaction ID                                                             Amount, KODA  Label")
+	fmt.Println("-----------------------------------------------------------------------------------------------------------------------")
+	for _, entry := range response.Entries {
+		fmt.Printf("%s  %s  %20s  %s\n", formatHistoryTimestamp(entry.Timestamp), entry.TxID,
+			formatNetAmount(entry.Received, entry.Sent), entry.Label)
+		for _, address := range entry.Addresses {
+			if address.Label != "" {
+				fmt.Printf("                     %s (%s)\n", address.Address, address.Label)
+			} else {
+				fmt.Printf("                     %s\n", address.Address)
+			}
+		}
+	}
+	fmt.Println("-----------------------------------------------------------------------------------------------------------------------")
+
+	first := conf.Offset + 1
+	last := conf.Offset + uint32(len(response.Entries))
+	fmt.Printf("Transactions %d-%d out of %d", first, last, response.Total)
+	if last < response.Total {
+		fmt.Printf(" (use --offset %d to show the next ones)", last)
+	}
+	fmt.Println()
+
+	return nil
+}
+
+func printTransactionHistoryCSV(entries []*pb.TransactionHistoryEntry) error {
+	writer := csv.NewWriter(os.Stdout)
+	err := writer.Write([]string{"date", "transaction_id", "accepting_block_hash", "accepting_block_daa_score",
+		"received", "sent", "amount", "label", "addresses", "address_labels"})
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		addresses := make([]string, len(entry.Addresses))
+		addressLabels := make([]string, len(entry.Addresses))
+		for i, address := range entry.Addresses {
+			addresses[i] = address.Address
+			addressLabels[i] = address.Label
+		}
+
+		err := writer.Write([]string{
+			formatHistoryTimestamp(entry.Timestamp),
+			entry.TxID,
+			entry.AcceptingBlockHash,
+			strconv.FormatUint(entry.AcceptingBlockDaaScore, 10),
+			strings.TrimSpace(utils.FormatKobra(entry.Received)),
+			strings.TrimSpace(utils.FormatKobra(entry.Sent)),
+			formatNetAmount(entry.Received, entry.Sent),
+			entry.Label,
+			strings.Join(addresses, ";"),
+			strings.Join(addressLabels, ";"),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func formatHistoryTimestamp(timestamp int64) string {
+	return time.UnixMilli(timestamp).UTC().Format("2006-01-02 15:04:05")
+}
+
+// formatNetAmount formats the change of the wallet balance by a transaction
+// that received and sent the given amounts
+func formatNetAmount(received, sent uint64) string {
+	switch {
+	case received > sent:
+		return "+" + strings.TrimSpace(utils.FormatKobra(received-sent))
+	case sent > received:
+		return "-" + strings.TrimSpace(utils.FormatKobra(sent-received))
+	default:
+		return "0"
+	}
+}
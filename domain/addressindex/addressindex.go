@@ -0,0 +1,268 @@
+package addressindex
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/kobradag/kobrad/domain"
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/domain/consensus/utils/consensushashing"
+	"github.com/kobradag/kobrad/infrastructure/db/database"
+	"github.com/kobradag/kobrad/infrastructure/logger"
+)
+
+// AddressIndex maintains an index between scriptPublicKeys and the
+// accepted transactions that credited or debited them
+type AddressIndex struct {
+	domain domain.Domain
+	store  *addressIndexStore
+
+	mutex sync.Mutex
+}
+
+// New creates a new address index.
+//
+// NOTE: While this is called no new blocks can be added to the consensus.
+func New(domain domain.Domain, database database.Database) (*AddressIndex, error) {
+	addressIndex := &AddressIndex{
+		domain: domain,
+		store:  newAddressIndexStore(database),
+	}
+	isSynced, err := addressIndex.isSynced()
+	if err != nil {
+		return nil, err
+	}
+
+	if !isSynced {
+		err := addressIndex.Reset()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return addressIndex, nil
+}
+
+// Reset deletes the whole address index and resyncs it from consensus.
+func (ai *AddressIndex) Reset() error {
+	ai.mutex.Lock()
+	defer ai.mutex.Unlock()
+	log.Infof("Starting address index reset")
+
+	err := ai.store.deleteAll()
+	if err != nil {
+		return err
+	}
+
+	virtualInfo, err := ai.domain.Consensus().GetVirtualInfo()
+	if err != nil {
+		return err
+	}
+
+	pruningPoint, err := ai.domain.Consensus().PruningPoint()
+	if err != nil {
+		return err
+	}
+
+	// Acceptance data is only kept for blocks above the pruning point,
+	// so that's where the index starts
+	chainPath, err := ai.domain.Consensus().GetVirtualSelectedParentChainFromBlock(pruningPoint)
+	if err != nil {
+		return err
+	}
+
+	const step = 1000
+	for position := 0; position < len(chainPath.Added); position += step {
+		end := position + step
+		if end > len(chainPath.Added) {
+			end = len(chainPath.Added)
+		}
+		// We use chunks in order to avoid blocking consensus for too long
+		chainBlocksChunk := chainPath.Added[position:end]
+		entries := make([]*HistoryEntry, 0)
+		err := ai.forEachHistoryEntry(chainBlocksChunk, func(entry *HistoryEntry) {
+			entries = append(entries, entry)
+		})
+		if err != nil {
+			return err
+		}
+
+		err = ai.store.addAndCommitHistoryEntriesWithoutTransaction(entries)
+		if err != nil {
+			return err
+		}
+	}
+
+	// This has to be done last to mark that the reset went smoothly and no reset has to be called next time.
+	err = ai.store.updateAndCommitVirtualParentsWithoutTransaction(virtualInfo.ParentHashes)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Finished address index reset")
+	return nil
+}
+
+func (ai *AddressIndex) isSynced() (bool, error) {
+	addressIndexVirtualParents, err := ai.store.getVirtualParents()
+	if err != nil {
+		if database.IsNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	virtualInfo, err := ai.domain.Consensus().GetVirtualInfo()
+	if err != nil {
+		return false, err
+	}
+
+	return externalapi.HashesEqual(virtualInfo.ParentHashes, addressIndexVirtualParents), nil
+}
+
+// Update updates the address index with the given DAG selected parent chain changes
+func (ai *AddressIndex) Update(virtualChangeSet *externalapi.VirtualChangeSet) error {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "AddressIndex.Update")
+	defer onEnd()
+
+	ai.mutex.Lock()
+	defer ai.mutex.Unlock()
+
+	chainChanges := virtualChangeSet.VirtualSelectedParentChainChanges
+	log.Tracef("Updating address index with VirtualSelectedParentChainChanges: %+v", chainChanges)
+
+	if chainChanges != nil {
+		err := ai.forEachHistoryEntry(chainChanges.Removed, ai.store.remove)
+		if err != nil {
+			return err
+		}
+
+		err = ai.forEachHistoryEntry(chainChanges.Added, ai.store.add)
+		if err != nil {
+			return err
+		}
+	}
+
+	ai.store.updateVirtualParents(virtualChangeSet.VirtualParents)
+
+	return ai.store.commit()
+}
+
+// History returns up to limit entries of the merged history of the given
+// scriptPublicKeys, starting at the given position, or at the beginning of
+// their history if it's nil. The returned position is the position of the
+// entry that follows the returned ones, or nil if there are no more entries.
+func (ai *AddressIndex) History(scriptPublicKeys []*externalapi.ScriptPublicKey, start *HistoryPosition,
+	limit int) ([]*HistoryEntry, *HistoryPosition, error) {
+
+	onEnd := logger.LogAndMeasureExecutionTime(log, "AddressIndex.History")
+	defer onEnd()
+
+	ai.mutex.Lock()
+	defer ai.mutex.Unlock()
+
+	var startDAAScore uint64
+	var startTransactionID *externalapi.DomainTransactionID
+	if start != nil {
+		startDAAScore = start.AcceptingBlockDAAScore
+		startTransactionID = &start.TransactionID
+	}
+
+	type positionedEntry struct {
+		position *HistoryPosition
+		entry    *HistoryEntry
+	}
+	positionedEntries := make([]*positionedEntry, 0)
+	for i, scriptPublicKey := range scriptPublicKeys {
+		// A scriptPublicKey has at most one entry per transaction, so at most
+		// one of its entries may precede start. One more entry than the limit
+		// is required in order to know the position of the next page.
+		entries, err := ai.store.getHistoryEntries(scriptPublicKey, startDAAScore, startTransactionID, limit+2)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, entry := range entries {
+			position := &HistoryPosition{
+				AcceptingBlockDAAScore: entry.AcceptingBlockDAAScore,
+				TransactionID:          *entry.TransactionID,
+				ScriptPublicKeyIndex:   uint32(i),
+			}
+			if start != nil && position.Less(start) {
+				continue
+			}
+			positionedEntries = append(positionedEntries, &positionedEntry{position: position, entry: entry})
+		}
+	}
+
+	sort.Slice(positionedEntries, func(i, j int) bool {
+		return positionedEntries[i].position.Less(positionedEntries[j].position)
+	})
+
+	var next *HistoryPosition
+	if len(positionedEntries) > limit {
+		next = positionedEntries[limit].position
+		positionedEntries = positionedEntries[:limit]
+	}
+	entries := make([]*HistoryEntry, len(positionedEntries))
+	for i, positionedEntry := range positionedEntries {
+		entries[i] = positionedEntry.entry
+	}
+	return entries, next, nil
+}
+
+// forEachHistoryEntry calls the callback with the history entries of every
+// scriptPublicKey that was credited or debited by a transaction accepted by
+// the given chain blocks
+func (ai *AddressIndex) forEachHistoryEntry(chainBlockHashes []*externalapi.DomainHash,
+	callback func(entry *HistoryEntry)) error {
+
+	chainBlocksAcceptanceData, err := ai.domain.Consensus().GetBlocksAcceptanceData(chainBlockHashes)
+	if err != nil {
+		return err
+	}
+
+	for i, chainBlockHash := range chainBlockHashes {
+		chainBlockHeader, err := ai.domain.Consensus().GetBlockHeader(chainBlockHash)
+		if err != nil {
+			return err
+		}
+
+		for _, blockAcceptanceData := range chainBlocksAcceptanceData[i] {
+			for _, transactionAcceptanceData := range blockAcceptanceData.TransactionAcceptanceData {
+				if !transactionAcceptanceData.IsAccepted {
+					continue
+				}
+
+				transactionID := consensushashing.TransactionID(transactionAcceptanceData.Transaction)
+				entries := make(map[string]*HistoryEntry)
+				entryOf := func(scriptPublicKey *externalapi.ScriptPublicKey) *HistoryEntry {
+					key := scriptPublicKey.String()
+					entry, ok := entries[key]
+					if !ok {
+						entry = &HistoryEntry{
+							ScriptPublicKey:        scriptPublicKey,
+							TransactionID:          transactionID,
+							IncludingBlockHash:     blockAcceptanceData.BlockHash,
+							AcceptingBlockHash:     chainBlockHash,
+							AcceptingBlockDAAScore: chainBlockHeader.DAAScore(),
+						}
+						entries[key] = entry
+					}
+					return entry
+				}
+
+				for _, output := range transactionAcceptanceData.Transaction.Outputs {
+					entryOf(output.ScriptPublicKey).Received += output.Value
+				}
+				for _, utxoEntry := range transactionAcceptanceData.TransactionInputUTXOEntries {
+					entryOf(utxoEntry.ScriptPublicKey()).Sent += utxoEntry.Amount()
+				}
+
+				for _, entry := range entries {
+					callback(entry)
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,7 @@
+package addressindex
+
+import (
+	"github.com/kobradag/kobrad/infrastructure/logger"
+)
+
+var log = logger.RegisterSubSystem("ADIN")
@@ -0,0 +1,44 @@
+package addressindex
+
+import (
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+)
+
+// HistoryEntry is the data the address index keeps per transaction that
+// credited or debited a scriptPublicKey
+type HistoryEntry struct {
+	ScriptPublicKey        *externalapi.ScriptPublicKey
+	TransactionID          *externalapi.DomainTransactionID
+	IncludingBlockHash     *externalapi.DomainHash
+	AcceptingBlockHash     *externalapi.DomainHash
+	AcceptingBlockDAAScore uint64
+
+	// Received is the sum of the outputs of the transaction that pay
+	// to the scriptPublicKey
+	Received uint64
+
+	// Sent is the sum of the outputs of the scriptPublicKey that the
+	// transaction spends
+	Sent uint64
+}
+
+// HistoryPosition is a position in the merged history of a list of
+// scriptPublicKeys. The merged history is ordered by the DAA score of
+// the accepting blocks of the entries, then by their transaction IDs
+// and then by the positions of their scriptPublicKeys in the list.
+type HistoryPosition struct {
+	AcceptingBlockDAAScore uint64
+	TransactionID          externalapi.DomainTransactionID
+	ScriptPublicKeyIndex   uint32
+}
+
+// Less returns whether this position comes before the given one
+func (hp *HistoryPosition) Less(other *HistoryPosition) bool {
+	if hp.AcceptingBlockDAAScore != other.AcceptingBlockDAAScore {
+		return hp.AcceptingBlockDAAScore < other.AcceptingBlockDAAScore
+	}
+	if !hp.TransactionID.Equal(&other.TransactionID) {
+		return hp.TransactionID.Less(&other.TransactionID)
+	}
+	return hp.ScriptPublicKeyIndex < other.ScriptPublicKeyIndex
+}
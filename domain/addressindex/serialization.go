@@ -0,0 +1,154 @@
+package addressindex
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/pkg/errors"
+)
+
+// The DAA score is serialized in big endian so that the keys of the history
+// of a scriptPublicKey are ordered by it
+const serializedHistoryKeySize = 8 + externalapi.DomainHashSize
+
+func serializeHistoryKey(acceptingBlockDAAScore uint64, transactionID *externalapi.DomainTransactionID) []byte {
+	serialized := make([]byte, serializedHistoryKeySize)
+	binary.BigEndian.PutUint64(serialized[:8], acceptingBlockDAAScore)
+	copy(serialized[8:], transactionID.ByteSlice())
+	return serialized
+}
+
+func deserializeHistoryKey(serialized []byte) (acceptingBlockDAAScore uint64,
+	transactionID *externalapi.DomainTransactionID, err error) {
+
+	if len(serialized) != serializedHistoryKeySize {
+		return 0, nil, errors.Wrapf(io.ErrUnexpectedEOF, "unexpected length %d while deserializing "+
+			"a history key", len(serialized))
+	}
+
+	acceptingBlockDAAScore = binary.BigEndian.Uint64(serialized[:8])
+	transactionID, err = externalapi.NewDomainTransactionIDFromByteSlice(serialized[8:])
+	if err != nil {
+		return 0, nil, err
+	}
+	return acceptingBlockDAAScore, transactionID, nil
+}
+
+const serializedHistoryEntrySize = 2*externalapi.DomainHashSize + 8 + 8
+
+// serializeHistoryEntry serializes the parts of the given entry
+// that aren't a part of its key
+func serializeHistoryEntry(entry *HistoryEntry) []byte {
+	serialized := make([]byte, serializedHistoryEntrySize)
+	copy(serialized[:externalapi.DomainHashSize], entry.IncludingBlockHash.ByteSlice())
+	copy(serialized[externalapi.DomainHashSize:2*externalapi.DomainHashSize], entry.AcceptingBlockHash.ByteSlice())
+	binary.LittleEndian.PutUint64(serialized[2*externalapi.DomainHashSize:], entry.Received)
+	binary.LittleEndian.PutUint64(serialized[2*externalapi.DomainHashSize+8:], entry.Sent)
+	return serialized
+}
+
+func deserializeHistoryEntry(scriptPublicKey *externalapi.ScriptPublicKey, serializedKey []byte,
+	serialized []byte) (*HistoryEntry, error) {
+
+	acceptingBlockDAAScore, transactionID, err := deserializeHistoryKey(serializedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(serialized) != serializedHistoryEntrySize {
+		return nil, errors.Wrapf(io.ErrUnexpectedEOF, "unexpected length %d while deserializing "+
+			"a history entry", len(serialized))
+	}
+	includingBlockHash, err := externalapi.NewDomainHashFromByteSlice(serialized[:externalapi.DomainHashSize])
+	if err != nil {
+		return nil, err
+	}
+	acceptingBlockHash, err := externalapi.NewDomainHashFromByteSlice(
+		serialized[externalapi.DomainHashSize : 2*externalapi.DomainHashSize])
+	if err != nil {
+		return nil, err
+	}
+
+	return &HistoryEntry{
+		ScriptPublicKey:        scriptPublicKey,
+		TransactionID:          transactionID,
+		IncludingBlockHash:     includingBlockHash,
+		AcceptingBlockHash:     acceptingBlockHash,
+		AcceptingBlockDAAScore: acceptingBlockDAAScore,
+		Received:               binary.LittleEndian.Uint64(serialized[2*externalapi.DomainHashSize:]),
+		Sent:                   binary.LittleEndian.Uint64(serialized[2*externalapi.DomainHashSize+8:]),
+	}, nil
+}
+
+const serializedHistoryPositionSize = serializedHistoryKeySize + 4
+
+// String returns the position as an opaque hex string, which can be
+// parsed back with NewHistoryPositionFromString
+func (hp *HistoryPosition) String() string {
+	serialized := make([]byte, serializedHistoryPositionSize)
+	copy(serialized, serializeHistoryKey(hp.AcceptingBlockDAAScore, &hp.TransactionID))
+	binary.BigEndian.PutUint32(serialized[serializedHistoryKeySize:], hp.ScriptPublicKeyIndex)
+	return hex.EncodeToString(serialized)
+}
+
+// NewHistoryPositionFromString parses a position that was
+// formatted by HistoryPosition.String
+func NewHistoryPositionFromString(positionString string) (*HistoryPosition, error) {
+	serialized, err := hex.DecodeString(positionString)
+	if err != nil {
+		return nil, errors.Wrapf(err, "malformed history position %s", positionString)
+	}
+	if len(serialized) != serializedHistoryPositionSize {
+		return nil, errors.Errorf("malformed history position %s: unexpected length %d",
+			positionString, len(serialized))
+	}
+
+	acceptingBlockDAAScore, transactionID, err := deserializeHistoryKey(serialized[:serializedHistoryKeySize])
+	if err != nil {
+		return nil, err
+	}
+	return &HistoryPosition{
+		AcceptingBlockDAAScore: acceptingBlockDAAScore,
+		TransactionID:          *transactionID,
+		ScriptPublicKeyIndex:   binary.BigEndian.Uint32(serialized[serializedHistoryKeySize:]),
+	}, nil
+}
+
+const hashesLengthSize = 8
+
+func serializeHashes(hashes []*externalapi.DomainHash) []byte {
+	serializedHashes := make([]byte, hashesLengthSize+externalapi.DomainHashSize*len(hashes))
+	binary.LittleEndian.PutUint64(serializedHashes[:hashesLengthSize], uint64(len(hashes)))
+	for i, hash := range hashes {
+		start := hashesLengthSize + externalapi.DomainHashSize*i
+		end := start + externalapi.DomainHashSize
+		copy(serializedHashes[start:end], hash.ByteSlice())
+	}
+	return serializedHashes
+}
+
+func deserializeHashes(serializedHashes []byte) ([]*externalapi.DomainHash, error) {
+	if len(serializedHashes) < hashesLengthSize {
+		return nil, errors.Wrapf(io.ErrUnexpectedEOF, "unexpected EOF while deserializing hashes")
+	}
+	length := binary.LittleEndian.Uint64(serializedHashes[:hashesLengthSize])
+	hashes := make([]*externalapi.DomainHash, length)
+	for i := uint64(0); i < length; i++ {
+		start := hashesLengthSize + externalapi.DomainHashSize*i
+		end := start + externalapi.DomainHashSize
+
+		if end > uint64(len(serializedHashes)) {
+			return nil, errors.Wrapf(io.ErrUnexpectedEOF, "unexpected EOF while deserializing hashes")
+		}
+
+		var err error
+		hashes[i], err = externalapi.NewDomainHashFromByteSlice(serializedHashes[start:end])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return hashes, nil
+}
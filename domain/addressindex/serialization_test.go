@@ -0,0 +1,114 @@
+package addressindex
+
+import (
+	"io"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/pkg/errors"
+)
+
+func Test_serializeHistoryEntry(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	scriptPublicKey := &externalapi.ScriptPublicKey{Script: []byte{1, 2, 3}, Version: 0}
+
+	for i := 0; i < 32; i++ {
+		var transactionIDBytes, includingBlockHashBytes, acceptingBlockHashBytes [externalapi.DomainHashSize]byte
+		r.Read(transactionIDBytes[:])
+		r.Read(includingBlockHashBytes[:])
+		r.Read(acceptingBlockHashBytes[:])
+		entry := &HistoryEntry{
+			ScriptPublicKey:        scriptPublicKey,
+			TransactionID:          externalapi.NewDomainTransactionIDFromByteArray(&transactionIDBytes),
+			IncludingBlockHash:     externalapi.NewDomainHashFromByteArray(&includingBlockHashBytes),
+			AcceptingBlockHash:     externalapi.NewDomainHashFromByteArray(&acceptingBlockHashBytes),
+			AcceptingBlockDAAScore: r.Uint64(),
+			Received:               r.Uint64(),
+			Sent:                   r.Uint64(),
+		}
+
+		result, err := deserializeHistoryEntry(scriptPublicKey,
+			serializeHistoryKey(entry.AcceptingBlockDAAScore, entry.TransactionID), serializeHistoryEntry(entry))
+		if err != nil {
+			t.Fatalf("Failed deserializing history entry: %v", err)
+		}
+		if !reflect.DeepEqual(result, entry) {
+			t.Fatalf("Expected history entry %+v but got %+v", entry, result)
+		}
+	}
+}
+
+func Test_deserializeHistoryEntryFailure(t *testing.T) {
+	entry := &HistoryEntry{
+		TransactionID:      externalapi.NewDomainTransactionIDFromByteArray(&[externalapi.DomainHashSize]byte{1}),
+		IncludingBlockHash: externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{2}),
+		AcceptingBlockHash: externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{3}),
+	}
+	serializedKey := serializeHistoryKey(entry.AcceptingBlockDAAScore, entry.TransactionID)
+	serialized := serializeHistoryEntry(entry)
+
+	_, err := deserializeHistoryEntry(nil, serializedKey, serialized[:len(serialized)-1])
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("Expected error to be EOF, instead got: %v", err)
+	}
+	_, err = deserializeHistoryEntry(nil, serializedKey[:len(serializedKey)-1], serialized)
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("Expected error to be EOF, instead got: %v", err)
+	}
+}
+
+func TestHistoryPositionOrder(t *testing.T) {
+	transactionID := func(b byte) externalapi.DomainTransactionID {
+		return *externalapi.NewDomainTransactionIDFromByteArray(&[externalapi.DomainHashSize]byte{b})
+	}
+
+	// The positions are sorted
+	positions := []*HistoryPosition{
+		{AcceptingBlockDAAScore: 1, TransactionID: transactionID(2), ScriptPublicKeyIndex: 3},
+		{AcceptingBlockDAAScore: 2, TransactionID: transactionID(1), ScriptPublicKeyIndex: 3},
+		{AcceptingBlockDAAScore: 2, TransactionID: transactionID(2), ScriptPublicKeyIndex: 0},
+		{AcceptingBlockDAAScore: 2, TransactionID: transactionID(2), ScriptPublicKeyIndex: 1},
+		{AcceptingBlockDAAScore: 256, TransactionID: transactionID(0), ScriptPublicKeyIndex: 0},
+	}
+	for i, position := range positions {
+		for j, other := range positions {
+			if position.Less(other) != (i < j) {
+				t.Fatalf("Expected %+v.Less(%+v) to be %t", position, other, i < j)
+			}
+		}
+
+		// The serialized history keys must have the same order as the
+		// positions, since the history is iterated in key order
+		if i > 0 {
+			previous := positions[i-1]
+			if string(serializeHistoryKey(previous.AcceptingBlockDAAScore, &previous.TransactionID)) >
+				string(serializeHistoryKey(position.AcceptingBlockDAAScore, &position.TransactionID)) {
+				t.Fatalf("Expected the history key of %+v to precede the history key of %+v", previous, position)
+			}
+		}
+	}
+}
+
+func TestHistoryPositionString(t *testing.T) {
+	position := &HistoryPosition{
+		AcceptingBlockDAAScore: 12345,
+		TransactionID:          *externalapi.NewDomainTransactionIDFromByteArray(&[externalapi.DomainHashSize]byte{7, 8, 9}),
+		ScriptPublicKeyIndex:   3,
+	}
+	result, err := NewHistoryPositionFromString(position.String())
+	if err != nil {
+		t.Fatalf("NewHistoryPositionFromString: %s", err)
+	}
+	if *result != *position {
+		t.Fatalf("Expected position %+v but got %+v", position, result)
+	}
+
+	for _, malformed := range []string{"", "zz", position.String()[2:]} {
+		_, err := NewHistoryPositionFromString(malformed)
+		if err == nil {
+			t.Fatalf("Expected an error when parsing the malformed position %q", malformed)
+		}
+	}
+}
@@ -0,0 +1,269 @@
+package addressindex
+
+import (
+	"encoding/binary"
+
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/infrastructure/db/database"
+	"github.com/kobradag/kobrad/infrastructure/logger"
+	"github.com/pkg/errors"
+)
+
+var addressIndexBucket = database.MakeBucket([]byte("address-index"))
+var virtualParentsKey = database.MakeBucket([]byte("")).Key([]byte("address-index-virtual-parents"))
+
+// historyKey identifies an entry in the history of a scriptPublicKey.
+// scriptPublicKey is a string since Go maps don't support slices as keys.
+type historyKey struct {
+	scriptPublicKey        string
+	acceptingBlockDAAScore uint64
+	transactionID          externalapi.DomainTransactionID
+}
+
+func newHistoryKey(entry *HistoryEntry) historyKey {
+	return historyKey{
+		scriptPublicKey:        entry.ScriptPublicKey.String(),
+		acceptingBlockDAAScore: entry.AcceptingBlockDAAScore,
+		transactionID:          *entry.TransactionID,
+	}
+}
+
+type addressIndexStore struct {
+	database database.Database
+	toAdd    map[historyKey]*HistoryEntry
+	toRemove map[historyKey]*externalapi.DomainHash
+
+	virtualParents []*externalapi.DomainHash
+}
+
+func newAddressIndexStore(database database.Database) *addressIndexStore {
+	return &addressIndexStore{
+		database: database,
+		toAdd:    make(map[historyKey]*HistoryEntry),
+		toRemove: make(map[historyKey]*externalapi.DomainHash),
+	}
+}
+
+func (ais *addressIndexStore) add(entry *HistoryEntry) {
+	log.Tracef("Adding transaction %s accepted by block %s to the history of scriptPublicKey %s",
+		entry.TransactionID, entry.AcceptingBlockHash, entry.ScriptPublicKey)
+
+	// An entry that is added after being removed in the same update
+	// was re-accepted by a different chain block with the same DAA score
+	key := newHistoryKey(entry)
+	delete(ais.toRemove, key)
+	ais.toAdd[key] = entry
+}
+
+func (ais *addressIndexStore) remove(entry *HistoryEntry) {
+	log.Tracef("Removing transaction %s accepted by block %s from the history of scriptPublicKey %s",
+		entry.TransactionID, entry.AcceptingBlockHash, entry.ScriptPublicKey)
+
+	key := newHistoryKey(entry)
+	if toAddEntry, ok := ais.toAdd[key]; ok && toAddEntry.AcceptingBlockHash.Equal(entry.AcceptingBlockHash) {
+		delete(ais.toAdd, key)
+		return
+	}
+	ais.toRemove[key] = entry.AcceptingBlockHash
+}
+
+func (ais *addressIndexStore) updateVirtualParents(virtualParents []*externalapi.DomainHash) {
+	ais.virtualParents = virtualParents
+}
+
+func (ais *addressIndexStore) discard() {
+	ais.toAdd = make(map[historyKey]*HistoryEntry)
+	ais.toRemove = make(map[historyKey]*externalapi.DomainHash)
+	ais.virtualParents = nil
+}
+
+func (ais *addressIndexStore) commit() error {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "addressIndexStore.commit")
+	defer onEnd()
+
+	dbTransaction, err := ais.database.Begin()
+	if err != nil {
+		return err
+	}
+	defer dbTransaction.RollbackUnlessClosed()
+
+	for key, acceptingBlockHash := range ais.toRemove {
+		scriptPublicKey := externalapi.NewScriptPublicKeyFromString(key.scriptPublicKey)
+		dbKey := ais.bucketForScriptPublicKey(scriptPublicKey).Key(
+			serializeHistoryKey(key.acceptingBlockDAAScore, &key.transactionID))
+
+		// The transaction might have been accepted by some other chain block
+		// with the same DAA score in the meantime, in which case its entry
+		// must not be touched
+		serializedEntry, err := dbTransaction.Get(dbKey)
+		if err != nil {
+			if database.IsNotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		entry, err := deserializeHistoryEntry(scriptPublicKey, dbKey.Suffix(), serializedEntry)
+		if err != nil {
+			return err
+		}
+		if !entry.AcceptingBlockHash.Equal(acceptingBlockHash) {
+			continue
+		}
+
+		err = dbTransaction.Delete(dbKey)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, entry := range ais.toAdd {
+		err = ais.putHistoryEntry(dbTransaction, entry)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ais.virtualParents != nil {
+		err = dbTransaction.Put(virtualParentsKey, serializeHashes(ais.virtualParents))
+		if err != nil {
+			return err
+		}
+	}
+
+	err = dbTransaction.Commit()
+	if err != nil {
+		return err
+	}
+
+	ais.discard()
+	return nil
+}
+
+func (ais *addressIndexStore) addAndCommitHistoryEntriesWithoutTransaction(entries []*HistoryEntry) error {
+	for _, entry := range entries {
+		err := ais.putHistoryEntry(ais.database, entry)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ais *addressIndexStore) putHistoryEntry(dataAccessor database.DataAccessor, entry *HistoryEntry) error {
+	key := ais.bucketForScriptPublicKey(entry.ScriptPublicKey).Key(
+		serializeHistoryKey(entry.AcceptingBlockDAAScore, entry.TransactionID))
+	return dataAccessor.Put(key, serializeHistoryEntry(entry))
+}
+
+func (ais *addressIndexStore) updateAndCommitVirtualParentsWithoutTransaction(virtualParents []*externalapi.DomainHash) error {
+	serializeParentHashes := serializeHashes(virtualParents)
+	return ais.database.Put(virtualParentsKey, serializeParentHashes)
+}
+
+// bucketForScriptPublicKey returns the bucket of the history of the given
+// scriptPublicKey. The length of the script is a part of the bucket, so that
+// no bucket is a prefix of another and cursors never mix histories.
+func (ais *addressIndexStore) bucketForScriptPublicKey(scriptPublicKey *externalapi.ScriptPublicKey) *database.Bucket {
+	var scriptPublicKeyBytes = make([]byte, 2+4+len(scriptPublicKey.Script)) // uint16 + uint32
+	binary.LittleEndian.PutUint16(scriptPublicKeyBytes[:2], scriptPublicKey.Version)
+	binary.LittleEndian.PutUint32(scriptPublicKeyBytes[2:6], uint32(len(scriptPublicKey.Script)))
+	copy(scriptPublicKeyBytes[6:], scriptPublicKey.Script)
+	return addressIndexBucket.Bucket(scriptPublicKeyBytes)
+}
+
+func (ais *addressIndexStore) isAnythingStaged() bool {
+	return len(ais.toAdd) > 0 || len(ais.toRemove) > 0
+}
+
+// getHistoryEntries returns up to limit entries of the history of the given
+// scriptPublicKey, starting at the first entry that was accepted at the given
+// DAA score with a transaction ID not less than the given one, or at the
+// beginning of the history if startTransactionID is nil
+func (ais *addressIndexStore) getHistoryEntries(scriptPublicKey *externalapi.ScriptPublicKey,
+	startDAAScore uint64, startTransactionID *externalapi.DomainTransactionID, limit int) ([]*HistoryEntry, error) {
+
+	if ais.isAnythingStaged() {
+		return nil, errors.Errorf("cannot get history entries while staging isn't empty")
+	}
+
+	bucket := ais.bucketForScriptPublicKey(scriptPublicKey)
+	cursor, err := ais.database.Cursor(bucket)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	ok := cursor.First()
+	if startTransactionID != nil {
+		// Seek returns a not-found error when the exact key is missing, but
+		// the cursor is still positioned at the next key if there is one
+		err := cursor.Seek(bucket.Key(serializeHistoryKey(startDAAScore, startTransactionID)))
+		if err != nil && !database.IsNotFoundError(err) {
+			return nil, err
+		}
+		_, err = cursor.Key()
+		if err != nil && !database.IsNotFoundError(err) {
+			return nil, err
+		}
+		ok = err == nil
+	}
+
+	entries := make([]*HistoryEntry, 0)
+	for ; ok && len(entries) < limit; ok = cursor.Next() {
+		key, err := cursor.Key()
+		if err != nil {
+			return nil, err
+		}
+		serializedEntry, err := cursor.Value()
+		if err != nil {
+			return nil, err
+		}
+		entry, err := deserializeHistoryEntry(scriptPublicKey, key.Suffix(), serializedEntry)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func (ais *addressIndexStore) getVirtualParents() ([]*externalapi.DomainHash, error) {
+	if ais.isAnythingStaged() {
+		return nil, errors.Errorf("cannot get the virtual parents while staging isn't empty")
+	}
+
+	serializedHashes, err := ais.database.Get(virtualParentsKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return deserializeHashes(serializedHashes)
+}
+
+func (ais *addressIndexStore) deleteAll() error {
+	// First we delete the virtual parents, so if anything goes wrong, the address index will be marked as "not synced"
+	// and will be reset.
+	err := ais.database.Delete(virtualParentsKey)
+	if err != nil {
+		return err
+	}
+
+	cursor, err := ais.database.Cursor(addressIndexBucket)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+	for cursor.Next() {
+		key, err := cursor.Key()
+		if err != nil {
+			return err
+		}
+
+		err = ais.database.Delete(key)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
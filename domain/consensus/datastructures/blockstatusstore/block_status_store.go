@@ -7,6 +7,7 @@ import (
 	"github.com/kobradag/kobrad/domain/consensus/utils/lrucache"
 	"github.com/kobradag/kobrad/util/staging"
 	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
 )
 
 var bucketName = []byte("block-statuses")
@@ -99,3 +100,59 @@ func (bss *blockStatusStore) deserializeBlockStatus(statusBytes []byte) (externa
 func (bss *blockStatusStore) hashAsKey(hash *externalapi.DomainHash) model.DBKey {
 	return bss.bucket.Key(hash.ByteSlice())
 }
+
+type allBlockHashesIterator struct {
+	cursor   model.DBCursor
+	isClosed bool
+}
+
+func (a *allBlockHashesIterator) First() bool {
+	if a.isClosed {
+		panic("Tried using a closed AllBlockHashesIterator")
+	}
+	return a.cursor.First()
+}
+
+func (a *allBlockHashesIterator) Next() bool {
+	if a.isClosed {
+		panic("Tried using a closed AllBlockHashesIterator")
+	}
+	return a.cursor.Next()
+}
+
+func (a *allBlockHashesIterator) Get() (*externalapi.DomainHash, error) {
+	if a.isClosed {
+		return nil, errors.New("Tried using a closed AllBlockHashesIterator")
+	}
+	key, err := a.cursor.Key()
+	if err != nil {
+		return nil, err
+	}
+
+	blockHashBytes := key.Suffix()
+	return externalapi.NewDomainHashFromByteSlice(blockHashBytes)
+}
+
+func (a *allBlockHashesIterator) Close() error {
+	if a.isClosed {
+		return errors.New("Tried using a closed AllBlockHashesIterator")
+	}
+	a.isClosed = true
+	err := a.cursor.Close()
+	if err != nil {
+		return err
+	}
+	a.cursor = nil
+	return nil
+}
+
+// AllBlockHashesIterator returns an iterator over the hashes of all the
+// blocks that have a status in the database. Staged statuses are ignored.
+func (bss *blockStatusStore) AllBlockHashesIterator(dbContext model.DBReader) (model.BlockIterator, error) {
+	cursor, err := dbContext.Cursor(bss.bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	return &allBlockHashesIterator{cursor: cursor}, nil
+}
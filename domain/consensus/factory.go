@@ -218,6 +218,7 @@ func (f *factory) NewConsensus(config *Config, db infrastructuredatabase.Databas
 		config.MaxCoinbasePayloadLength,
 		config.K,
 		config.CoinbasePayloadScriptPublicKeyMaxLength,
+		config.CoinbasePayeesActivationBlueScore,
 		dbManager,
 		pastMedianTimeManager,
 		ghostdagDataStore,
@@ -245,7 +246,7 @@ func (f *factory) NewConsensus(config *Config, db infrastructuredatabase.Databas
 		config.GenesisHash,
 		config.DeflationaryPhaseDaaScore,
 		config.DeflationaryPhaseBaseSubsidy,
-
+		config.CoinbasePayeesActivationBlueScore,
 
 		dagTraversalManager,
 		ghostdagDataStore,
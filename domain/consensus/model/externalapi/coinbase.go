@@ -7,6 +7,30 @@ import "bytes"
 type DomainCoinbaseData struct {
 	ScriptPublicKey *ScriptPublicKey
 	ExtraData       []byte
+
+	// AdditionalPayees are the scripts the rewards of the block are split
+	// with. When there are any, ScriptPublicKey is paid in proportion to
+	// Weight and each additional payee in proportion to its own weight.
+	Weight           uint16
+	AdditionalPayees []*DomainCoinbasePayee
+}
+
+// DomainCoinbasePayee is a script the rewards of a block are paid to in
+// proportion to its weight
+type DomainCoinbasePayee struct {
+	ScriptPublicKey *ScriptPublicKey
+	Weight          uint16
+}
+
+// Payees returns all the scripts the rewards of the block are paid to,
+// starting with ScriptPublicKey
+func (dcd *DomainCoinbaseData) Payees() []*DomainCoinbasePayee {
+	if len(dcd.AdditionalPayees) == 0 {
+		return []*DomainCoinbasePayee{{ScriptPublicKey: dcd.ScriptPublicKey, Weight: 1}}
+	}
+	payees := make([]*DomainCoinbasePayee, 0, len(dcd.AdditionalPayees)+1)
+	payees = append(payees, &DomainCoinbasePayee{ScriptPublicKey: dcd.ScriptPublicKey, Weight: dcd.Weight})
+	return append(payees, dcd.AdditionalPayees...)
 }
 
 // Clone returns a clone of DomainCoinbaseData
@@ -18,9 +42,19 @@ func (dcd *DomainCoinbaseData) Clone() *DomainCoinbaseData {
 	extraDataClone := make([]byte, len(dcd.ExtraData))
 	copy(extraDataClone, dcd.ExtraData)
 
+	var additionalPayeesClone []*DomainCoinbasePayee
+	if dcd.AdditionalPayees != nil {
+		additionalPayeesClone = make([]*DomainCoinbasePayee, len(dcd.AdditionalPayees))
+		for i, payee := range dcd.AdditionalPayees {
+			additionalPayeesClone[i] = payee.Clone()
+		}
+	}
+
 	return &DomainCoinbaseData{
-		ScriptPublicKey: &ScriptPublicKey{Script: scriptPubKeyClone, Version: dcd.ScriptPublicKey.Version},
-		ExtraData:       extraDataClone,
+		ScriptPublicKey:  &ScriptPublicKey{Script: scriptPubKeyClone, Version: dcd.ScriptPublicKey.Version},
+		ExtraData:        extraDataClone,
+		Weight:           dcd.Weight,
+		AdditionalPayees: additionalPayeesClone,
 	}
 }
 
@@ -34,5 +68,35 @@ func (dcd *DomainCoinbaseData) Equal(other *DomainCoinbaseData) bool {
 		return false
 	}
 
+	if dcd.Weight != other.Weight || len(dcd.AdditionalPayees) != len(other.AdditionalPayees) {
+		return false
+	}
+
+	for i, payee := range dcd.AdditionalPayees {
+		if !payee.Equal(other.AdditionalPayees[i]) {
+			return false
+		}
+	}
+
 	return dcd.ScriptPublicKey.Equal(other.ScriptPublicKey)
 }
+
+// Clone returns a clone of DomainCoinbasePayee
+func (dcp *DomainCoinbasePayee) Clone() *DomainCoinbasePayee {
+	scriptPubKeyClone := make([]byte, len(dcp.ScriptPublicKey.Script))
+	copy(scriptPubKeyClone, dcp.ScriptPublicKey.Script)
+
+	return &DomainCoinbasePayee{
+		ScriptPublicKey: &ScriptPublicKey{Script: scriptPubKeyClone, Version: dcp.ScriptPublicKey.Version},
+		Weight:          dcp.Weight,
+	}
+}
+
+// Equal returns whether dcp equals to other
+func (dcp *DomainCoinbasePayee) Equal(other *DomainCoinbasePayee) bool {
+	if dcp == nil || other == nil {
+		return dcp == other
+	}
+
+	return dcp.Weight == other.Weight && dcp.ScriptPublicKey.Equal(other.ScriptPublicKey)
+}
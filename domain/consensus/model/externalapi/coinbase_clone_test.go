@@ -9,11 +9,19 @@ func initTestCoinbaseDataStructsForClone() []*DomainCoinbaseData {
 
 	tests := []*DomainCoinbaseData{
 		{
-			&ScriptPublicKey{Script: []byte{1, 2, 3, 4, 5, 6}, Version: 0},
-			[]byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF},
+			ScriptPublicKey: &ScriptPublicKey{Script: []byte{1, 2, 3, 4, 5, 6}, Version: 0},
+			ExtraData:       []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF},
 		}, {
-			&ScriptPublicKey{Script: []byte{0, 0, 0, 0, 55}, Version: 0},
-			[]byte{0xFF, 0xFF, 0xFF, 0xFF,
+			ScriptPublicKey: &ScriptPublicKey{Script: []byte{1, 2, 3, 4, 5, 6}, Version: 0},
+			ExtraData:       []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF},
+			Weight:          3,
+			AdditionalPayees: []*DomainCoinbasePayee{
+				{ScriptPublicKey: &ScriptPublicKey{Script: []byte{7, 8, 9}, Version: 0}, Weight: 1},
+				{ScriptPublicKey: &ScriptPublicKey{Script: []byte{10, 11}, Version: 1}, Weight: 2},
+			},
+		}, {
+			ScriptPublicKey: &ScriptPublicKey{Script: []byte{0, 0, 0, 0, 55}, Version: 0},
+			ExtraData: []byte{0xFF, 0xFF, 0xFF, 0xFF,
 				0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
 				0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
 				0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
@@ -0,0 +1,61 @@
+package model
+
+import (
+	"fmt"
+
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+)
+
+// IntegrityProblem is an inconsistency that the IntegrityVerifier found in
+// one of the consensus stores
+type IntegrityProblem struct {
+	// Store is the name of the store that holds the inconsistent data
+	Store string
+
+	// BlockHash is the block whose data is inconsistent, or nil if the
+	// problem isn't about a specific block
+	BlockHash *externalapi.DomainHash
+
+	// Description describes the inconsistency
+	Description string
+}
+
+func (p *IntegrityProblem) String() string {
+	if p.BlockHash == nil {
+		return fmt.Sprintf("%s: %s", p.Store, p.Description)
+	}
+	return fmt.Sprintf("%s: block %s: %s", p.Store, p.BlockHash, p.Description)
+}
+
+// IntegrityReport is the result of an integrity verification
+type IntegrityReport struct {
+	// BlockCount is the number of blocks that have a block status,
+	// including the virtual genesis
+	BlockCount uint64
+
+	// BlockCountByStatus is the number of blocks with each block status
+	BlockCountByStatus map[externalapi.BlockStatus]uint64
+
+	// VirtualUTXOCount is the number of entries in the virtual UTXO set
+	VirtualUTXOCount uint64
+
+	// VirtualUTXOCommitment is the MuHash of the virtual UTXO set, as
+	// recomputed from its entries. It's nil if the virtual UTXO set wasn't
+	// verified.
+	VirtualUTXOCommitment *externalapi.DomainHash
+
+	// PruningPoint is the current pruning point, or nil if there's none
+	PruningPoint *externalapi.DomainHash
+
+	// PruningPointUTXOCount is the number of entries in the UTXO set of
+	// the pruning point
+	PruningPointUTXOCount uint64
+
+	// Warnings are states that aren't corruptions, but that prevented
+	// some of the checks, such as an interrupted operation that the node
+	// recovers from when it starts
+	Warnings []string
+
+	// Problems are the inconsistencies that were found
+	Problems []*IntegrityProblem
+}
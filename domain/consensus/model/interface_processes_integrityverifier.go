@@ -0,0 +1,6 @@
+package model
+
+// IntegrityVerifier checks the consistency of the consensus stores
+type IntegrityVerifier interface {
+	VerifyIntegrity() (*IntegrityReport, error)
+}
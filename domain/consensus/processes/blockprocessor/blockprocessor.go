@@ -6,7 +6,9 @@ import (
 	"github.com/kobradag/kobrad/domain/consensus/model"
 	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
 	"github.com/kobradag/kobrad/domain/consensus/processes/blockprocessor/blocklogger"
+	"github.com/kobradag/kobrad/domain/consensus/ruleerrors"
 	"github.com/kobradag/kobrad/infrastructure/logger"
+	"github.com/pkg/errors"
 )
 
 // blockProcessor is responsible for processing incoming blocks
@@ -148,9 +150,20 @@ func (bp *blockProcessor) ValidateAndInsertBlock(block *externalapi.DomainBlock,
 	shouldValidateAgainstUTXO bool) (*externalapi.VirtualChangeSet, externalapi.BlockStatus, error) {
 	onEnd := logger.LogAndMeasureExecutionTime(log, "ValidateAndInsertBlock")
 	defer onEnd()
+	onMeasureEnd := blockProcessingDuration.MeasureExecutionTime()
+	defer onMeasureEnd()
 
 	stagingArea := model.NewStagingArea()
-	return bp.validateAndInsertBlock(stagingArea, block, false, shouldValidateAgainstUTXO, false)
+	virtualChangeSet, blockStatus, err := bp.validateAndInsertBlock(stagingArea, block, false, shouldValidateAgainstUTXO, false)
+	switch {
+	case err == nil:
+		blocksProcessed.WithLabelValue(blockStatus.String()).Inc()
+	case errors.As(err, &ruleerrors.RuleError{}):
+		blocksProcessed.WithLabelValue(externalapi.StatusInvalid.String()).Inc()
+	default:
+		blocksProcessed.WithLabelValue("Error").Inc()
+	}
+	return virtualChangeSet, blockStatus, err
 }
 
 func (bp *blockProcessor) ValidateAndInsertImportedPruningPoint(newPruningPoint *externalapi.DomainHash) error {
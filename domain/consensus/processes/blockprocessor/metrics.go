@@ -0,0 +1,12 @@
+package blockprocessor
+
+import (
+	"github.com/kobradag/kobrad/infrastructure/metrics"
+)
+
+var (
+	blocksProcessed = metrics.NewCounterVec("kobrad_blocks_processed_total",
+		"The number of blocks processed, by resulting block status", "status")
+	blockProcessingDuration = metrics.NewHistogram("kobrad_block_processing_duration_seconds",
+		"The time it takes to validate and insert a block", metrics.DurationBuckets)
+)
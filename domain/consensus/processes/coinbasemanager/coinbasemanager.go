@@ -2,6 +2,7 @@ package coinbasemanager
 
 import (
 	"math"
+	"math/bits"
 
 	"github.com/kobradag/kobrad/domain/consensus/model"
 	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
@@ -22,6 +23,7 @@ type coinbaseManager struct {
 	genesisHash                             *externalapi.DomainHash
 	deflationaryPhaseDaaScore               uint64
 	deflationaryPhaseBaseSubsidy            uint64
+	coinbasePayeesActivationBlueScore       uint64
 
 	databaseContext     model.DBReader
 	dagTraversalManager model.DAGTraversalManager
@@ -63,36 +65,36 @@ func (c *coinbaseManager) ExpectedCoinbaseTransaction(stagingArea *model.Staging
 	acceptanceDataMap := acceptanceDataFromArrayToMap(acceptanceData)
 	if constants.BlockVersion == 1 {
 		for _, blue := range ghostdagData.MergeSetBlues() {
-			txOut, hasReward, err := c.coinbaseOutputForBlueBlockV1(stagingArea, blue, acceptanceDataMap[*blue], daaAddedBlocksSet)
+			blueTxOuts, hasReward, err := c.coinbaseOutputForBlueBlockV1(stagingArea, blue, acceptanceDataMap[*blue], daaAddedBlocksSet)
 			if err != nil {
 				return nil, false, err
 			}
 			if hasReward {
-				txOuts = append(txOuts, txOut)
+				txOuts = append(txOuts, blueTxOuts...)
 			}
 		}
-		txOut, hasRedReward, err := c.coinbaseOutputForRewardFromRedBlocksV1(
+		redTxOuts, hasRedReward, err := c.coinbaseOutputForRewardFromRedBlocksV1(
 			stagingArea, ghostdagData, acceptanceData, daaAddedBlocksSet, coinbaseData)
-	if err != nil {
-		return nil, false, err
-	}
+		if err != nil {
+			return nil, false, err
+		}
 
-	if hasRedReward {
-		txOuts = append(txOuts, txOut)
+		if hasRedReward {
+			txOuts = append(txOuts, redTxOuts...)
 		}
 	} else if constants.BlockVersion == 2 {
 		for _, blue := range ghostdagData.MergeSetBlues() {
-			txOut, devTx, hasReward, err := c.coinbaseOutputForBlueBlockV2(stagingArea, blue, acceptanceDataMap[*blue], daaAddedBlocksSet)
+			blueTxOuts, devTx, hasReward, err := c.coinbaseOutputForBlueBlockV2(stagingArea, blue, acceptanceDataMap[*blue], daaAddedBlocksSet)
 			if err != nil {
 				return nil, false, err
 			}
 			if hasReward {
-				txOuts = append(txOuts, txOut)
+				txOuts = append(txOuts, blueTxOuts...)
 				txOuts = append(txOuts, devTx)
 			}
 		}
 
-txOut, devTx, hasRedReward, err := c.coinbaseOutputForRewardFromRedBlocksV2(
+		redTxOuts, devTx, hasRedReward, err := c.coinbaseOutputForRewardFromRedBlocksV2(
 			stagingArea, ghostdagData, acceptanceData, daaAddedBlocksSet, coinbaseData)
 		if err != nil {
 			return nil, false, err
@@ -101,7 +103,7 @@ txOut, devTx, hasRedReward, err := c.coinbaseOutputForRewardFromRedBlocksV2(
 
 
 		if hasRedReward {
-			txOuts = append(txOuts, txOut)
+			txOuts = append(txOuts, redTxOuts...)
 			txOuts = append(txOuts, devTx)
 		}
 	}
@@ -143,7 +145,7 @@ func (c *coinbaseManager) daaAddedBlocksSet(stagingArea *model.StagingArea, bloc
 // If blueBlock gets no fee - returns nil for txOut
 func (c *coinbaseManager) coinbaseOutputForBlueBlockV2(stagingArea *model.StagingArea,
 	blueBlock *externalapi.DomainHash, blockAcceptanceData *externalapi.BlockAcceptanceData,
-	mergingBlockDAAAddedBlocksSet hashset.HashSet) (*externalapi.DomainTransactionOutput, *externalapi.DomainTransactionOutput, bool, error) {
+	mergingBlockDAAAddedBlocksSet hashset.HashSet) ([]*externalapi.DomainTransactionOutput, *externalapi.DomainTransactionOutput, bool, error) {
 	blockReward, err := c.calcMergedBlockReward(stagingArea, blueBlock, blockAcceptanceData, mergingBlockDAAAddedBlocksSet)
 	if err != nil {
 		return nil, nil, false, err
@@ -169,20 +171,17 @@ func (c *coinbaseManager) coinbaseOutputForBlueBlockV2(stagingArea *model.Stagin
 	if err != nil {
 		return nil, nil, false, err
 	}
-	txOut := &externalapi.DomainTransactionOutput{
-		Value:           blockReward,
-		ScriptPublicKey: coinbaseData.ScriptPublicKey,
-	}
+	txOuts := payeeOutputs(coinbaseData, blockReward)
 	devTx := &externalapi.DomainTransactionOutput{
 		Value:           devFeeQuantity,
 		ScriptPublicKey: devFeeScriptPublicKey,
 	}
-	return txOut, devTx, true, nil
+	return txOuts, devTx, true, nil
 }
 
 func (c *coinbaseManager) coinbaseOutputForBlueBlockV1(stagingArea *model.StagingArea,
 	blueBlock *externalapi.DomainHash, blockAcceptanceData *externalapi.BlockAcceptanceData,
-	mergingBlockDAAAddedBlocksSet hashset.HashSet) (*externalapi.DomainTransactionOutput, bool, error) {
+	mergingBlockDAAAddedBlocksSet hashset.HashSet) ([]*externalapi.DomainTransactionOutput, bool, error) {
 
 	blockReward, err := c.calcMergedBlockReward(stagingArea, blueBlock, blockAcceptanceData, mergingBlockDAAAddedBlocksSet)
 	if err != nil {
@@ -199,17 +198,12 @@ func (c *coinbaseManager) coinbaseOutputForBlueBlockV1(stagingArea *model.Stagin
 		return nil, false, err
 	}
 
-	txOut := &externalapi.DomainTransactionOutput{
-		Value:           blockReward,
-		ScriptPublicKey: coinbaseData.ScriptPublicKey,
-	}
-
-	return txOut, true, nil
+	return payeeOutputs(coinbaseData, blockReward), true, nil
 }
 
 func (c *coinbaseManager) coinbaseOutputForRewardFromRedBlocksV2(stagingArea *model.StagingArea,
 	ghostdagData *externalapi.BlockGHOSTDAGData, acceptanceData externalapi.AcceptanceData, daaAddedBlocksSet hashset.HashSet,
-	coinbaseData *externalapi.DomainCoinbaseData) (*externalapi.DomainTransactionOutput, *externalapi.DomainTransactionOutput, bool, error) {
+	coinbaseData *externalapi.DomainCoinbaseData) ([]*externalapi.DomainTransactionOutput, *externalapi.DomainTransactionOutput, bool, error) {
 	acceptanceDataMap := acceptanceDataFromArrayToMap(acceptanceData)
 	totalReward := uint64(0)
 	for _, red := range ghostdagData.MergeSetReds() {
@@ -236,22 +230,18 @@ func (c *coinbaseManager) coinbaseOutputForRewardFromRedBlocksV2(stagingArea *mo
 		return nil, nil, false, nil
 	}
 
-	txOut := &externalapi.DomainTransactionOutput{
-		Value:           totalReward,
-		ScriptPublicKey: coinbaseData.ScriptPublicKey,
-	}
-
+	txOuts := payeeOutputs(coinbaseData, totalReward)
 	devTx := &externalapi.DomainTransactionOutput{
 		Value:           devFeeQuantity,
 		ScriptPublicKey: devFeeScriptPublicKey,
 	}
 
-	return txOut, devTx, true, nil
+	return txOuts, devTx, true, nil
 }
 
 func (c *coinbaseManager) coinbaseOutputForRewardFromRedBlocksV1(stagingArea *model.StagingArea,
 	ghostdagData *externalapi.BlockGHOSTDAGData, acceptanceData externalapi.AcceptanceData, daaAddedBlocksSet hashset.HashSet,
-	coinbaseData *externalapi.DomainCoinbaseData) (*externalapi.DomainTransactionOutput, bool, error) {
+	coinbaseData *externalapi.DomainCoinbaseData) ([]*externalapi.DomainTransactionOutput, bool, error) {
 
 	acceptanceDataMap := acceptanceDataFromArrayToMap(acceptanceData)
 	totalReward := uint64(0)
@@ -268,12 +258,40 @@ func (c *coinbaseManager) coinbaseOutputForRewardFromRedBlocksV1(stagingArea *mo
 		return nil, false, nil
 	}
 
-	txOut := &externalapi.DomainTransactionOutput{
-		Value:           totalReward,
-		ScriptPublicKey: coinbaseData.ScriptPublicKey,
+	return payeeOutputs(coinbaseData, totalReward), true, nil
+}
+
+// payeeOutputs splits the given reward among the payees of the given coinbase data
+// in proportion to their weights. The remainder of the division goes to the first
+// payee, and payees whose share is 0 get no output.
+func payeeOutputs(coinbaseData *externalapi.DomainCoinbaseData, reward uint64) []*externalapi.DomainTransactionOutput {
+	payees := coinbaseData.Payees()
+	totalWeight := uint64(0)
+	for _, payee := range payees {
+		totalWeight += uint64(payee.Weight)
+	}
+
+	amounts := make([]uint64, len(payees))
+	remainder := reward
+	for i, payee := range payees {
+		// reward * weight may overflow, but its quotient by the total weight can't
+		high, low := bits.Mul64(reward, uint64(payee.Weight))
+		amounts[i], _ = bits.Div64(high, low, totalWeight)
+		remainder -= amounts[i]
+	}
+	amounts[0] += remainder
+
+	txOuts := make([]*externalapi.DomainTransactionOutput, 0, len(payees))
+	for i, payee := range payees {
+		if amounts[i] == 0 {
+			continue
+		}
+		txOuts = append(txOuts, &externalapi.DomainTransactionOutput{
+			Value:           amounts[i],
+			ScriptPublicKey: payee.ScriptPublicKey,
+		})
 	}
-	
-	return txOut, true, nil
+	return txOuts
 }
 
 func acceptanceDataFromArrayToMap(acceptanceData externalapi.AcceptanceData) map[externalapi.DomainHash]*externalapi.BlockAcceptanceData {
@@ -393,6 +411,7 @@ func New(
 	genesisHash *externalapi.DomainHash,
 	deflationaryPhaseDaaScore uint64,
 	deflationaryPhaseBaseSubsidy uint64,
+	coinbasePayeesActivationBlueScore uint64,
 
 	dagTraversalManager model.DAGTraversalManager,
 	ghostdagDataStore model.GHOSTDAGDataStore,
@@ -411,6 +430,7 @@ func New(
 		genesisHash:                             genesisHash,
 		deflationaryPhaseDaaScore:               deflationaryPhaseDaaScore,
 		deflationaryPhaseBaseSubsidy:            deflationaryPhaseBaseSubsidy,
+		coinbasePayeesActivationBlueScore:       coinbasePayeesActivationBlueScore,
 
 		dagTraversalManager: dagTraversalManager,
 		ghostdagDataStore:   ghostdagDataStore,
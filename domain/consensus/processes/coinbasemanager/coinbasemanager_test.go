@@ -24,6 +24,7 @@ func TestCalcDeflationaryPeriodBlockSubsidy(t *testing.T) {
 		&externalapi.DomainHash{},
 		deflationaryPhaseDaaScore,
 		deflationaryPhaseBaseSubsidy,
+		0,
 		nil,
 		nil,
 		nil,
@@ -99,6 +100,7 @@ func TestBuildSubsidyTable(t *testing.T) {
 		&externalapi.DomainHash{},
 		0,
 		deflationaryPhaseBaseSubsidy,
+		0,
 		nil,
 		nil,
 		nil,
@@ -5,6 +5,7 @@ import (
 
 	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
 	"github.com/kobradag/kobrad/domain/consensus/ruleerrors"
+	"github.com/kobradag/kobrad/domain/consensus/utils/constants"
 	"github.com/pkg/errors"
 )
 
@@ -13,51 +14,123 @@ const uint16Len = 2
 const lengthOfSubsidy = uint64Len
 const lengthOfScriptPubKeyLength = 1
 const lengthOfVersionScriptPubKey = uint16Len
+const lengthOfPayeeWeight = uint16Len
+const lengthOfPayeeCount = 1
 
 // serializeCoinbasePayload builds the coinbase payload based on the provided scriptPubKey and extra data.
+//
+// The payload consists of the blue score, the subsidy, the script public key of the miner and the extra data.
+// If the rewards of the block are split to several payees, the version of the script public key of the
+// miner is flagged with constants.CoinbasePayeesScriptPublicKeyVersionFlag and the script is followed by
+// its weight, the number of additional payees and the weight and script public key of each of them.
 func (c *coinbaseManager) serializeCoinbasePayload(blueScore uint64,
 	coinbaseData *externalapi.DomainCoinbaseData, subsidy uint64) ([]byte, error) {
 
-	scriptLengthOfScriptPubKey := len(coinbaseData.ScriptPublicKey.Script)
-	if scriptLengthOfScriptPubKey > int(c.coinbasePayloadScriptPublicKeyMaxLength) {
-		return nil, errors.Wrapf(ruleerrors.ErrBadCoinbasePayloadLen, "coinbase's payload script public key is "+
-			"longer than the max allowed length of %d", c.coinbasePayloadScriptPublicKeyMaxLength)
-	}
-
-	payload := make([]byte, uint64Len+lengthOfVersionScriptPubKey+lengthOfScriptPubKeyLength+scriptLengthOfScriptPubKey+len(coinbaseData.ExtraData)+lengthOfSubsidy)
+	payload := make([]byte, uint64Len+lengthOfSubsidy)
 	binary.LittleEndian.PutUint64(payload[:uint64Len], blueScore)
 	binary.LittleEndian.PutUint64(payload[uint64Len:], subsidy)
 
-	binary.LittleEndian.PutUint16(payload[uint64Len+lengthOfSubsidy:], coinbaseData.ScriptPublicKey.Version)
-	payload[uint64Len+lengthOfSubsidy+lengthOfVersionScriptPubKey] = uint8(len(coinbaseData.ScriptPublicKey.Script))
-	copy(payload[uint64Len+lengthOfSubsidy+lengthOfVersionScriptPubKey+lengthOfScriptPubKeyLength:], coinbaseData.ScriptPublicKey.Script)
-	copy(payload[uint64Len+lengthOfSubsidy+lengthOfVersionScriptPubKey+lengthOfScriptPubKeyLength+scriptLengthOfScriptPubKey:], coinbaseData.ExtraData)
-
-	return payload, nil
+	return appendCoinbaseData(payload, blueScore, coinbaseData,
+		c.coinbasePayloadScriptPublicKeyMaxLength, c.coinbasePayeesActivationBlueScore)
 }
 
 // ModifyCoinbasePayload modifies the coinbase payload based on the provided scriptPubKey and extra data.
-func ModifyCoinbasePayload(payload []byte, coinbaseData *externalapi.DomainCoinbaseData, coinbasePayloadScriptPublicKeyMaxLength uint8) ([]byte, error) {
+func ModifyCoinbasePayload(payload []byte, coinbaseData *externalapi.DomainCoinbaseData,
+	coinbasePayloadScriptPublicKeyMaxLength uint8, coinbasePayeesActivationBlueScore uint64) ([]byte, error) {
+
+	blueScore := binary.LittleEndian.Uint64(payload[:uint64Len])
+	newPayload := make([]byte, uint64Len+lengthOfSubsidy)
+	copy(newPayload, payload[:uint64Len+lengthOfSubsidy])
+
+	return appendCoinbaseData(newPayload, blueScore, coinbaseData,
+		coinbasePayloadScriptPublicKeyMaxLength, coinbasePayeesActivationBlueScore)
+}
+
+func appendCoinbaseData(payload []byte, blueScore uint64, coinbaseData *externalapi.DomainCoinbaseData,
+	coinbasePayloadScriptPublicKeyMaxLength uint8, coinbasePayeesActivationBlueScore uint64) ([]byte, error) {
+
+	err := validateCoinbaseData(blueScore, coinbaseData,
+		coinbasePayloadScriptPublicKeyMaxLength, coinbasePayeesActivationBlueScore)
+	if err != nil {
+		return nil, err
+	}
+
+	scriptPubKeyVersion := coinbaseData.ScriptPublicKey.Version
+	if len(coinbaseData.AdditionalPayees) > 0 {
+		scriptPubKeyVersion |= constants.CoinbasePayeesScriptPublicKeyVersionFlag
+	}
+	payload = appendScriptPublicKey(payload, scriptPubKeyVersion, coinbaseData.ScriptPublicKey.Script)
+
+	if len(coinbaseData.AdditionalPayees) > 0 {
+		payload = appendUint16(payload, coinbaseData.Weight)
+		payload = append(payload, uint8(len(coinbaseData.AdditionalPayees)))
+		for _, payee := range coinbaseData.AdditionalPayees {
+			payload = appendUint16(payload, payee.Weight)
+			payload = appendScriptPublicKey(payload, payee.ScriptPublicKey.Version, payee.ScriptPublicKey.Script)
+		}
+	}
+
+	return append(payload, coinbaseData.ExtraData...), nil
+}
+
+func appendUint16(payload []byte, value uint16) []byte {
+	var serializedValue [uint16Len]byte
+	binary.LittleEndian.PutUint16(serializedValue[:], value)
+	return append(payload, serializedValue[:]...)
+}
 
-	scriptLengthOfScriptPubKey := len(coinbaseData.ScriptPublicKey.Script)
-	if scriptLengthOfScriptPubKey > int(coinbasePayloadScriptPublicKeyMaxLength) {
-		return nil, errors.Wrapf(ruleerrors.ErrBadCoinbasePayloadLen, "coinbase's payload script public key is "+
+func appendScriptPublicKey(payload []byte, version uint16, script []byte) []byte {
+	payload = appendUint16(payload, version)
+	payload = append(payload, uint8(len(script)))
+	return append(payload, script...)
+}
+
+// validateCoinbaseData validates that the given coinbase data, of a block with the given
+// blue score, can be serialized into a coinbase payload
+func validateCoinbaseData(blueScore uint64, coinbaseData *externalapi.DomainCoinbaseData,
+	coinbasePayloadScriptPublicKeyMaxLength uint8, coinbasePayeesActivationBlueScore uint64) error {
+
+	if len(coinbaseData.ScriptPublicKey.Script) > int(coinbasePayloadScriptPublicKeyMaxLength) {
+		return errors.Wrapf(ruleerrors.ErrBadCoinbasePayloadLen, "coinbase's payload script public key is "+
 			"longer than the max allowed length of %d", coinbasePayloadScriptPublicKeyMaxLength)
 	}
 
-	newPayloadLen := uint64Len + lengthOfVersionScriptPubKey + lengthOfScriptPubKeyLength + scriptLengthOfScriptPubKey + len(coinbaseData.ExtraData) + lengthOfSubsidy
-	if len(payload) != newPayloadLen {
-		newPayload := make([]byte, newPayloadLen)
-		copy(newPayload, payload[:uint64Len+lengthOfSubsidy])
-		payload = newPayload
+	if blueScore < coinbasePayeesActivationBlueScore {
+		if len(coinbaseData.AdditionalPayees) > 0 {
+			return errors.Wrapf(ruleerrors.ErrBadCoinbasePayees, "the rewards of a block with blue score %d "+
+				"can't be split to several payees before blue score %d", blueScore, coinbasePayeesActivationBlueScore)
+		}
+		return nil
+	}
+
+	if len(coinbaseData.AdditionalPayees) == 0 {
+		if coinbaseData.ScriptPublicKey.Version&constants.CoinbasePayeesScriptPublicKeyVersionFlag != 0 {
+			return errors.Wrapf(ruleerrors.ErrBadCoinbasePayees, "coinbase's payload script public key "+
+				"version %d is reserved for blocks whose rewards are split", coinbaseData.ScriptPublicKey.Version)
+		}
+		return nil
 	}
 
-	binary.LittleEndian.PutUint16(payload[uint64Len+lengthOfSubsidy:uint64Len+lengthOfSubsidy+lengthOfVersionScriptPubKey], coinbaseData.ScriptPublicKey.Version)
-	payload[uint64Len+lengthOfSubsidy+lengthOfVersionScriptPubKey] = uint8(len(coinbaseData.ScriptPublicKey.Script))
-	copy(payload[uint64Len+lengthOfSubsidy+lengthOfVersionScriptPubKey+lengthOfScriptPubKeyLength:], coinbaseData.ScriptPublicKey.Script)
-	copy(payload[uint64Len+lengthOfSubsidy+lengthOfVersionScriptPubKey+lengthOfScriptPubKeyLength+scriptLengthOfScriptPubKey:], coinbaseData.ExtraData)
+	payees := coinbaseData.Payees()
+	if len(payees) > constants.MaxCoinbasePayees {
+		return errors.Wrapf(ruleerrors.ErrBadCoinbasePayees, "the rewards of a block can be split to at most "+
+			"%d payees but got %d", constants.MaxCoinbasePayees, len(payees))
+	}
+	for i, payee := range payees {
+		if payee.Weight == 0 {
+			return errors.Wrapf(ruleerrors.ErrBadCoinbasePayees, "coinbase payee %d has a weight of 0", i)
+		}
+		if payee.ScriptPublicKey.Version&constants.CoinbasePayeesScriptPublicKeyVersionFlag != 0 {
+			return errors.Wrapf(ruleerrors.ErrBadCoinbasePayees, "coinbase payee %d has the reserved script "+
+				"public key version %d", i, payee.ScriptPublicKey.Version)
+		}
+		if len(payee.ScriptPublicKey.Script) > int(coinbasePayloadScriptPublicKeyMaxLength) {
+			return errors.Wrapf(ruleerrors.ErrBadCoinbasePayees, "the script public key of coinbase payee %d "+
+				"is longer than the max allowed length of %d", i, coinbasePayloadScriptPublicKeyMaxLength)
+		}
+	}
 
-	return payload, nil
+	return nil
 }
 
 // ExtractCoinbaseDataBlueScoreAndSubsidy deserializes the coinbase payload to its component (scriptPubKey, extra data, and subsidy).
@@ -73,23 +146,97 @@ func (c *coinbaseManager) ExtractCoinbaseDataBlueScoreAndSubsidy(coinbaseTx *ext
 	blueScore = binary.LittleEndian.Uint64(coinbaseTx.Payload[:uint64Len])
 	subsidy = binary.LittleEndian.Uint64(coinbaseTx.Payload[uint64Len:])
 
-	scriptPubKeyVersion := binary.LittleEndian.Uint16(coinbaseTx.Payload[uint64Len+lengthOfSubsidy : uint64Len+lengthOfSubsidy+uint16Len])
+	offset := uint64Len + lengthOfSubsidy
+	scriptPublicKey, offset, err := c.extractScriptPublicKey(coinbaseTx.Payload, offset)
+	if err != nil {
+		return 0, nil, 0, err
+	}
+
+	coinbaseData = &externalapi.DomainCoinbaseData{ScriptPublicKey: scriptPublicKey}
+	if blueScore >= c.coinbasePayeesActivationBlueScore &&
+		scriptPublicKey.Version&constants.CoinbasePayeesScriptPublicKeyVersionFlag != 0 {
+
+		scriptPublicKey.Version &^= constants.CoinbasePayeesScriptPublicKeyVersionFlag
+		offset, err = c.extractAdditionalPayees(coinbaseTx.Payload, offset, coinbaseData)
+		if err != nil {
+			return 0, nil, 0, err
+		}
+	}
+	coinbaseData.ExtraData = coinbaseTx.Payload[offset:]
+
+	err = validateCoinbaseData(blueScore, coinbaseData,
+		c.coinbasePayloadScriptPublicKeyMaxLength, c.coinbasePayeesActivationBlueScore)
+	if err != nil {
+		return 0, nil, 0, err
+	}
+
+	return blueScore, coinbaseData, subsidy, nil
+}
+
+func (c *coinbaseManager) extractScriptPublicKey(payload []byte, offset int) (*externalapi.ScriptPublicKey, int, error) {
+	if len(payload) < offset+lengthOfVersionScriptPubKey+lengthOfScriptPubKeyLength {
+		return nil, 0, errors.Wrapf(ruleerrors.ErrBadCoinbasePayloadLen,
+			"coinbase payload doesn't have enough bytes to contain a script public key")
+	}
+	scriptPubKeyVersion := binary.LittleEndian.Uint16(payload[offset : offset+lengthOfVersionScriptPubKey])
+	offset += lengthOfVersionScriptPubKey
 
-	scriptPubKeyScriptLength := coinbaseTx.Payload[uint64Len+lengthOfSubsidy+lengthOfVersionScriptPubKey]
+	scriptPubKeyScriptLength := payload[offset]
+	offset += lengthOfScriptPubKeyLength
 
 	if scriptPubKeyScriptLength > c.coinbasePayloadScriptPublicKeyMaxLength {
-		return 0, nil, 0, errors.Wrapf(ruleerrors.ErrBadCoinbasePayloadLen, "coinbase's payload script public key is "+
+		return nil, 0, errors.Wrapf(ruleerrors.ErrBadCoinbasePayloadLen, "coinbase's payload script public key is "+
 			"longer than the max allowed length of %d", c.coinbasePayloadScriptPublicKeyMaxLength)
 	}
 
-	if len(coinbaseTx.Payload) < minLength+int(scriptPubKeyScriptLength) {
-		return 0, nil, 0, errors.Wrapf(ruleerrors.ErrBadCoinbasePayloadLen,
+	if len(payload) < offset+int(scriptPubKeyScriptLength) {
+		return nil, 0, errors.Wrapf(ruleerrors.ErrBadCoinbasePayloadLen,
 			"coinbase payload doesn't have enough bytes to contain a script public key of %d bytes", scriptPubKeyScriptLength)
 	}
-	scriptPubKeyScript := coinbaseTx.Payload[uint64Len+lengthOfSubsidy+lengthOfVersionScriptPubKey+lengthOfScriptPubKeyLength : uint64Len+lengthOfSubsidy+lengthOfVersionScriptPubKey+lengthOfScriptPubKeyLength+scriptPubKeyScriptLength]
+	scriptPubKeyScript := payload[offset : offset+int(scriptPubKeyScriptLength)]
+	offset += int(scriptPubKeyScriptLength)
+
+	return &externalapi.ScriptPublicKey{Script: scriptPubKeyScript, Version: scriptPubKeyVersion}, offset, nil
+}
+
+func (c *coinbaseManager) extractAdditionalPayees(payload []byte, offset int,
+	coinbaseData *externalapi.DomainCoinbaseData) (int, error) {
+
+	if len(payload) < offset+lengthOfPayeeWeight+lengthOfPayeeCount {
+		return 0, errors.Wrapf(ruleerrors.ErrBadCoinbasePayloadLen,
+			"coinbase payload doesn't have enough bytes to contain its payees")
+	}
+	coinbaseData.Weight = binary.LittleEndian.Uint16(payload[offset : offset+lengthOfPayeeWeight])
+	offset += lengthOfPayeeWeight
+
+	additionalPayeeCount := int(payload[offset])
+	offset += lengthOfPayeeCount
+	if additionalPayeeCount == 0 {
+		return 0, errors.Wrapf(ruleerrors.ErrBadCoinbasePayees,
+			"coinbase payload is flagged as having several payees but has none")
+	}
+	if additionalPayeeCount+1 > constants.MaxCoinbasePayees {
+		return 0, errors.Wrapf(ruleerrors.ErrBadCoinbasePayees, "the rewards of a block can be split to at most "+
+			"%d payees but got %d", constants.MaxCoinbasePayees, additionalPayeeCount+1)
+	}
+
+	coinbaseData.AdditionalPayees = make([]*externalapi.DomainCoinbasePayee, additionalPayeeCount)
+	for i := range coinbaseData.AdditionalPayees {
+		if len(payload) < offset+lengthOfPayeeWeight {
+			return 0, errors.Wrapf(ruleerrors.ErrBadCoinbasePayloadLen,
+				"coinbase payload doesn't have enough bytes to contain its payees")
+		}
+		weight := binary.LittleEndian.Uint16(payload[offset : offset+lengthOfPayeeWeight])
+		offset += lengthOfPayeeWeight
+
+		var scriptPublicKey *externalapi.ScriptPublicKey
+		var err error
+		scriptPublicKey, offset, err = c.extractScriptPublicKey(payload, offset)
+		if err != nil {
+			return 0, err
+		}
+		coinbaseData.AdditionalPayees[i] = &externalapi.DomainCoinbasePayee{ScriptPublicKey: scriptPublicKey, Weight: weight}
+	}
 
-	return blueScore, &externalapi.DomainCoinbaseData{
-		ScriptPublicKey: &externalapi.ScriptPublicKey{Script: scriptPubKeyScript, Version: scriptPubKeyVersion},
-		ExtraData:       coinbaseTx.Payload[uint64Len+lengthOfSubsidy+lengthOfVersionScriptPubKey+lengthOfScriptPubKeyLength+scriptPubKeyScriptLength:],
-	}, subsidy, nil
+	return offset, nil
 }
@@ -0,0 +1,173 @@
+package coinbasemanager
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/domain/consensus/ruleerrors"
+	"github.com/kobradag/kobrad/domain/consensus/utils/constants"
+	"github.com/pkg/errors"
+)
+
+func TestCoinbasePayloadPayees(t *testing.T) {
+	const activationBlueScore = 100
+	manager := &coinbaseManager{
+		coinbasePayloadScriptPublicKeyMaxLength: 150,
+		coinbasePayeesActivationBlueScore:       activationBlueScore,
+	}
+
+	singlePayee := &externalapi.DomainCoinbaseData{
+		ScriptPublicKey: &externalapi.ScriptPublicKey{Script: []byte{1, 2, 3}, Version: 0},
+		ExtraData:       []byte("extra"),
+	}
+	splitPayees := &externalapi.DomainCoinbaseData{
+		ScriptPublicKey: &externalapi.ScriptPublicKey{Script: []byte{1, 2, 3}, Version: 0},
+		ExtraData:       []byte("extra"),
+		Weight:          2,
+		AdditionalPayees: []*externalapi.DomainCoinbasePayee{
+			{ScriptPublicKey: &externalapi.ScriptPublicKey{Script: []byte{4, 5}, Version: 0}, Weight: 1},
+			{ScriptPublicKey: &externalapi.ScriptPublicKey{Script: []byte{6}, Version: 1}, Weight: 3},
+		},
+	}
+
+	// A single payee is serialized the same way before and after the activation
+	legacyPayload := []byte{
+		100, 0, 0, 0, 0, 0, 0, 0, // blue score
+		50, 0, 0, 0, 0, 0, 0, 0, // subsidy
+		0, 0, 3, 1, 2, 3, // script public key
+		'e', 'x', 't', 'r', 'a',
+	}
+	payload, err := manager.serializeCoinbasePayload(activationBlueScore, singlePayee, 50)
+	if err != nil {
+		t.Fatalf("serializeCoinbasePayload: %+v", err)
+	}
+	if !bytes.Equal(payload, legacyPayload) {
+		t.Fatalf("Unexpected payload of a single payee. Want: %v, got: %v", legacyPayload, payload)
+	}
+
+	for _, coinbaseData := range []*externalapi.DomainCoinbaseData{singlePayee, splitPayees} {
+		payload, err := manager.serializeCoinbasePayload(activationBlueScore, coinbaseData, 50)
+		if err != nil {
+			t.Fatalf("serializeCoinbasePayload: %+v", err)
+		}
+		blueScore, extractedCoinbaseData, subsidy, err :=
+			manager.ExtractCoinbaseDataBlueScoreAndSubsidy(&externalapi.DomainTransaction{Payload: payload})
+		if err != nil {
+			t.Fatalf("ExtractCoinbaseDataBlueScoreAndSubsidy: %+v", err)
+		}
+		if blueScore != activationBlueScore || subsidy != 50 || !extractedCoinbaseData.Equal(coinbaseData) {
+			t.Fatalf("Unexpected extracted coinbase data %+v", extractedCoinbaseData)
+		}
+
+		modifiedPayload, err := ModifyCoinbasePayload(legacyPayload, coinbaseData, 150, activationBlueScore)
+		if err != nil {
+			t.Fatalf("ModifyCoinbasePayload: %+v", err)
+		}
+		if !bytes.Equal(modifiedPayload, payload) {
+			t.Fatalf("Unexpected modified payload. Want: %v, got: %v", payload, modifiedPayload)
+		}
+	}
+
+	// Before the activation the rewards can't be split, and the payee flag is a
+	// part of the script public key version like any other bit
+	_, err = manager.serializeCoinbasePayload(activationBlueScore-1, splitPayees, 50)
+	if !errors.Is(err, ruleerrors.ErrBadCoinbasePayees) {
+		t.Fatalf("Expected ErrBadCoinbasePayees before the activation but got %v", err)
+	}
+	flaggedPayload := append([]byte{}, legacyPayload...)
+	flaggedPayload[0] = activationBlueScore - 1
+	flaggedPayload[17] = byte(constants.CoinbasePayeesScriptPublicKeyVersionFlag >> 8)
+	_, coinbaseData, _, err := manager.ExtractCoinbaseDataBlueScoreAndSubsidy(
+		&externalapi.DomainTransaction{Payload: flaggedPayload})
+	if err != nil {
+		t.Fatalf("ExtractCoinbaseDataBlueScoreAndSubsidy: %+v", err)
+	}
+	if coinbaseData.ScriptPublicKey.Version != constants.CoinbasePayeesScriptPublicKeyVersionFlag ||
+		len(coinbaseData.AdditionalPayees) != 0 || !bytes.Equal(coinbaseData.ExtraData, []byte("extra")) {
+
+		t.Fatalf("Unexpected coinbase data of a flagged payload before the activation %+v", coinbaseData)
+	}
+
+	// After the activation the same payload claims to have payees it doesn't have
+	flaggedPayload[0] = activationBlueScore
+	_, _, _, err = manager.ExtractCoinbaseDataBlueScoreAndSubsidy(&externalapi.DomainTransaction{Payload: flaggedPayload})
+	if err == nil {
+		t.Fatalf("Expected an error extracting a flagged payload without payees")
+	}
+
+	invalidCoinbaseData := []*externalapi.DomainCoinbaseData{
+		{
+			ScriptPublicKey: &externalapi.ScriptPublicKey{Script: []byte{1}, Version: constants.CoinbasePayeesScriptPublicKeyVersionFlag},
+		},
+		{
+			ScriptPublicKey: &externalapi.ScriptPublicKey{Script: []byte{1}},
+			Weight:          0,
+			AdditionalPayees: []*externalapi.DomainCoinbasePayee{
+				{ScriptPublicKey: &externalapi.ScriptPublicKey{Script: []byte{2}}, Weight: 1},
+			},
+		},
+		{
+			ScriptPublicKey:  &externalapi.ScriptPublicKey{Script: []byte{1}},
+			Weight:           1,
+			AdditionalPayees: make([]*externalapi.DomainCoinbasePayee, constants.MaxCoinbasePayees),
+		},
+	}
+	for i := range invalidCoinbaseData[2].AdditionalPayees {
+		invalidCoinbaseData[2].AdditionalPayees[i] = &externalapi.DomainCoinbasePayee{
+			ScriptPublicKey: &externalapi.ScriptPublicKey{Script: []byte{2}}, Weight: 1}
+	}
+	for i, coinbaseData := range invalidCoinbaseData {
+		_, err := manager.serializeCoinbasePayload(activationBlueScore, coinbaseData, 50)
+		if !errors.Is(err, ruleerrors.ErrBadCoinbasePayees) {
+			t.Fatalf("Test #%d: expected ErrBadCoinbasePayees but got %v", i, err)
+		}
+	}
+}
+
+func TestPayeeOutputs(t *testing.T) {
+	coinbaseData := &externalapi.DomainCoinbaseData{
+		ScriptPublicKey: &externalapi.ScriptPublicKey{Script: []byte{1}},
+		Weight:          1,
+		AdditionalPayees: []*externalapi.DomainCoinbasePayee{
+			{ScriptPublicKey: &externalapi.ScriptPublicKey{Script: []byte{2}}, Weight: 1},
+			{ScriptPublicKey: &externalapi.ScriptPublicKey{Script: []byte{3}}, Weight: 1000},
+		},
+	}
+
+	tests := []struct {
+		reward         uint64
+		expectedValues []uint64
+	}{
+		{reward: 1002, expectedValues: []uint64{1, 1, 1000}},
+		{reward: 1005, expectedValues: []uint64{2, 1, 1002}},
+		// The first payee gets the remainder even if its share is 0
+		{reward: 500, expectedValues: []uint64{1, 499}},
+		// The reward times the weight of the last payee overflows
+		{reward: constants.MaxLeor, expectedValues: []uint64{44411177644712, 44411177644710, 44411177644710578}},
+	}
+
+	for _, test := range tests {
+		txOuts := payeeOutputs(coinbaseData, test.reward)
+		if len(txOuts) != len(test.expectedValues) {
+			t.Fatalf("Reward %d: expected %d outputs but got %d", test.reward, len(test.expectedValues), len(txOuts))
+		}
+		total := uint64(0)
+		for i, txOut := range txOuts {
+			if txOut.Value != test.expectedValues[i] {
+				t.Fatalf("Reward %d: unexpected value of output %d. Want: %d, got: %d",
+					test.reward, i, test.expectedValues[i], txOut.Value)
+			}
+			total += txOut.Value
+		}
+		if total != test.reward {
+			t.Fatalf("Reward %d: the outputs pay %d", test.reward, total)
+		}
+	}
+
+	singlePayee := &externalapi.DomainCoinbaseData{ScriptPublicKey: &externalapi.ScriptPublicKey{Script: []byte{1}}}
+	txOuts := payeeOutputs(singlePayee, 1234)
+	if len(txOuts) != 1 || txOuts[0].Value != 1234 || !txOuts[0].ScriptPublicKey.Equal(singlePayee.ScriptPublicKey) {
+		t.Fatalf("Unexpected outputs of a single payee %+v", txOuts)
+	}
+}
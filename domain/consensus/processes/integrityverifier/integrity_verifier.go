@@ -0,0 +1,140 @@
+package integrityverifier
+
+import (
+	"fmt"
+
+	"github.com/kobradag/kobrad/domain/consensus/database"
+	"github.com/kobradag/kobrad/domain/consensus/model"
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/infrastructure/logger"
+)
+
+// The names of the stores in the reported problems
+const (
+	blockStatusStoreName      = "blockstatusstore"
+	blockHeaderStoreName      = "blockheaderstore"
+	blockStoreName            = "blockstore"
+	ghostdagDataStoreName     = "ghostdagdatastore"
+	reachabilityDataStoreName = "reachabilitydatastore"
+	utxoDiffStoreName         = "utxodiffstore"
+	multisetStoreName         = "multisetstore"
+	consensusStateStoreName   = "consensusstatestore"
+	pruningStoreName          = "pruningstore"
+)
+
+// progressLogInterval is the number of blocks between progress log messages
+const progressLogInterval = 100_000
+
+type integrityVerifier struct {
+	databaseContext model.DBManager
+
+	blockStatusStore      model.BlockStatusStore
+	blockHeaderStore      model.BlockHeaderStore
+	blockStore            model.BlockStore
+	ghostdagDataStore     model.GHOSTDAGDataStore
+	reachabilityDataStore model.ReachabilityDataStore
+	utxoDiffStore         model.UTXODiffStore
+	multisetStore         model.MultisetStore
+	consensusStateStore   model.ConsensusStateStore
+	pruningStore          model.PruningStore
+}
+
+// New instantiates a new IntegrityVerifier. It only reads from the given
+// stores, and never stages or commits anything.
+func New(databaseContext model.DBManager,
+	blockStatusStore model.BlockStatusStore,
+	blockHeaderStore model.BlockHeaderStore,
+	blockStore model.BlockStore,
+	ghostdagDataStore model.GHOSTDAGDataStore,
+	reachabilityDataStore model.ReachabilityDataStore,
+	utxoDiffStore model.UTXODiffStore,
+	multisetStore model.MultisetStore,
+	consensusStateStore model.ConsensusStateStore,
+	pruningStore model.PruningStore) model.IntegrityVerifier {
+
+	return &integrityVerifier{
+		databaseContext:       databaseContext,
+		blockStatusStore:      blockStatusStore,
+		blockHeaderStore:      blockHeaderStore,
+		blockStore:            blockStore,
+		ghostdagDataStore:     ghostdagDataStore,
+		reachabilityDataStore: reachabilityDataStore,
+		utxoDiffStore:         utxoDiffStore,
+		multisetStore:         multisetStore,
+		consensusStateStore:   consensusStateStore,
+		pruningStore:          pruningStore,
+	}
+}
+
+// VerifyIntegrity walks over all the blocks that have a block status and
+// checks that the data of every store that they're expected to have exists
+// and references existing blocks. It then recomputes the MuHash of the
+// virtual UTXO set and of the pruning point UTXO set, and compares them
+// against their commitments.
+//
+// Inconsistencies are returned in the report. An error is returned only if
+// the verification itself couldn't proceed.
+func (iv *integrityVerifier) VerifyIntegrity() (*model.IntegrityReport, error) {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "VerifyIntegrity")
+	defer onEnd()
+
+	report := &model.IntegrityReport{
+		BlockCountByStatus: make(map[externalapi.BlockStatus]uint64),
+	}
+
+	log.Infof("Verifying the data of all the blocks")
+	err := iv.verifyBlocks(report)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Verifying the reachability reindex root and the virtual")
+	err = iv.verifyReachabilityReindexRoot(report)
+	if err != nil {
+		return nil, err
+	}
+	err = iv.verifyVirtual(report)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Verifying the virtual UTXO set")
+	err = iv.verifyVirtualUTXOSet(report)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Verifying the pruning point UTXO set")
+	err = iv.verifyPruningPointUTXOSet(report)
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+func addProblem(report *model.IntegrityReport, store string, blockHash *externalapi.DomainHash,
+	format string, args ...interface{}) {
+
+	problem := &model.IntegrityProblem{
+		Store:       store,
+		BlockHash:   blockHash,
+		Description: fmt.Sprintf(format, args...),
+	}
+	log.Debugf("Found a problem: %s", problem)
+	report.Problems = append(report.Problems, problem)
+}
+
+// addReadProblem adds the problem of an error that was returned while
+// reading the given entry from a store. Both a missing entry and one that
+// can't be deserialized are what a crash in the middle of a write leaves
+// behind, so neither stops the verification.
+func addReadProblem(report *model.IntegrityReport, store string, blockHash *externalapi.DomainHash,
+	entry string, err error) {
+
+	if database.IsNotFoundError(err) {
+		addProblem(report, store, blockHash, "missing %s", entry)
+		return
+	}
+	addProblem(report, store, blockHash, "malformed %s: %s", entry, err)
+}
@@ -0,0 +1,312 @@
+package integrityverifier
+
+import (
+	"io/ioutil"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+
+	consensusdatabase "github.com/kobradag/kobrad/domain/consensus/database"
+	"github.com/kobradag/kobrad/domain/consensus/datastructures/blockheaderstore"
+	"github.com/kobradag/kobrad/domain/consensus/datastructures/blockstatusstore"
+	"github.com/kobradag/kobrad/domain/consensus/datastructures/blockstore"
+	"github.com/kobradag/kobrad/domain/consensus/datastructures/consensusstatestore"
+	"github.com/kobradag/kobrad/domain/consensus/datastructures/ghostdagdatastore"
+	"github.com/kobradag/kobrad/domain/consensus/datastructures/multisetstore"
+	"github.com/kobradag/kobrad/domain/consensus/datastructures/pruningstore"
+	"github.com/kobradag/kobrad/domain/consensus/datastructures/reachabilitydatastore"
+	"github.com/kobradag/kobrad/domain/consensus/datastructures/utxodiffstore"
+	"github.com/kobradag/kobrad/domain/consensus/model"
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/domain/consensus/utils/blockheader"
+	"github.com/kobradag/kobrad/domain/consensus/utils/multiset"
+	"github.com/kobradag/kobrad/domain/consensus/utils/reachabilitydata"
+	"github.com/kobradag/kobrad/domain/consensus/utils/utxo"
+	"github.com/kobradag/kobrad/infrastructure/db/database/ldb"
+	"github.com/kobradag/kobrad/util/staging"
+)
+
+type testStores struct {
+	dbManager model.DBManager
+
+	blockStatusStore      model.BlockStatusStore
+	blockHeaderStore      model.BlockHeaderStore
+	blockStore            model.BlockStore
+	ghostdagDataStore     model.GHOSTDAGDataStore
+	reachabilityDataStore model.ReachabilityDataStore
+	utxoDiffStore         model.UTXODiffStore
+	multisetStore         model.MultisetStore
+	consensusStateStore   model.ConsensusStateStore
+	pruningStore          model.PruningStore
+}
+
+func (ts *testStores) verifyIntegrity(t *testing.T) *model.IntegrityReport {
+	integrityVerifier := New(ts.dbManager, ts.blockStatusStore, ts.blockHeaderStore, ts.blockStore,
+		ts.ghostdagDataStore, ts.reachabilityDataStore, ts.utxoDiffStore, ts.multisetStore,
+		ts.consensusStateStore, ts.pruningStore)
+	report, err := integrityVerifier.VerifyIntegrity()
+	if err != nil {
+		t.Fatalf("VerifyIntegrity: %+v", err)
+	}
+	return report
+}
+
+func (ts *testStores) commit(t *testing.T, stagingArea *model.StagingArea) {
+	err := staging.CommitAllChanges(ts.dbManager, stagingArea)
+	if err != nil {
+		t.Fatalf("CommitAllChanges: %+v", err)
+	}
+}
+
+var (
+	genesisHash = externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{1})
+	blockAHash  = externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{2})
+	unknownHash = externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{3})
+
+	testOutpoint = &externalapi.DomainOutpoint{
+		TransactionID: *externalapi.NewDomainTransactionIDFromByteArray(&[externalapi.DomainHashSize]byte{4}),
+		Index:         0,
+	}
+	testUTXOEntry = utxo.NewUTXOEntry(1000, &externalapi.ScriptPublicKey{Script: []byte{1, 2, 3}}, true, 1)
+)
+
+func testUTXODiff(t *testing.T) externalapi.UTXODiff {
+	utxoDiff, err := utxo.NewUTXODiffFromCollections(
+		utxo.NewUTXOCollection(map[externalapi.DomainOutpoint]externalapi.UTXOEntry{*testOutpoint: testUTXOEntry}),
+		utxo.NewUTXOCollection(map[externalapi.DomainOutpoint]externalapi.UTXOEntry{}))
+	if err != nil {
+		t.Fatalf("NewUTXODiffFromCollections: %+v", err)
+	}
+	return utxoDiff
+}
+
+func testMultiset(t *testing.T) model.Multiset {
+	testMultiset := multiset.New()
+	serializedUTXO, err := utxo.SerializeUTXO(testUTXOEntry, testOutpoint)
+	if err != nil {
+		t.Fatalf("SerializeUTXO: %+v", err)
+	}
+	testMultiset.Add(serializedUTXO)
+	return testMultiset
+}
+
+func testBlock(utxoCommitment *externalapi.DomainHash) *externalapi.DomainBlock {
+	return &externalapi.DomainBlock{
+		Header: blockheader.NewImmutableBlockHeader(0, []externalapi.BlockLevelParents{}, &externalapi.DomainHash{},
+			&externalapi.DomainHash{}, utxoCommitment, 0, 0, 0, 0, 0, big.NewInt(0), &externalapi.DomainHash{}),
+		Transactions: []*externalapi.DomainTransaction{},
+	}
+}
+
+// setUpTestStores builds the consensus stores of a DAG with the virtual
+// genesis, the genesis and a single block A on top of it, whose merge set
+// adds one UTXO to the virtual UTXO set
+func setUpTestStores(t *testing.T) (stores *testStores, teardown func()) {
+	tmpDir, err := ioutil.TempDir("", "TestVerifyIntegrity")
+	if err != nil {
+		t.Fatalf("TempDir: %s", err)
+	}
+	db, err := ldb.NewLevelDB(tmpDir, 8)
+	if err != nil {
+		t.Fatalf("NewLevelDB: %+v", err)
+	}
+	teardown = func() {
+		db.Close()
+		os.RemoveAll(tmpDir)
+	}
+
+	dbManager := consensusdatabase.New(db)
+	prefixBucket := consensusdatabase.MakeBucket(nil)
+	blockHeaderStore, err := blockheaderstore.New(dbManager, prefixBucket, 10, false)
+	if err != nil {
+		t.Fatalf("blockheaderstore.New: %+v", err)
+	}
+	blockStore, err := blockstore.New(dbManager, prefixBucket, 10, false)
+	if err != nil {
+		t.Fatalf("blockstore.New: %+v", err)
+	}
+	stores = &testStores{
+		dbManager:             dbManager,
+		blockStatusStore:      blockstatusstore.New(prefixBucket, 10, false),
+		blockHeaderStore:      blockHeaderStore,
+		blockStore:            blockStore,
+		ghostdagDataStore:     ghostdagdatastore.New(prefixBucket, 10, false),
+		reachabilityDataStore: reachabilitydatastore.New(prefixBucket, 10, false),
+		utxoDiffStore:         utxodiffstore.New(prefixBucket, 10, false),
+		multisetStore:         multisetstore.New(prefixBucket, 10, false),
+		consensusStateStore:   consensusstatestore.New(prefixBucket, 10, false),
+		pruningStore:          pruningstore.New(prefixBucket, 2, false),
+	}
+
+	stagingArea := model.NewStagingArea()
+	virtualGenesis := model.VirtualGenesisBlockHash
+
+	for _, blockHash := range []*externalapi.DomainHash{virtualGenesis, genesisHash, blockAHash} {
+		stores.blockStatusStore.Stage(stagingArea, blockHash, externalapi.StatusUTXOValid)
+	}
+
+	stores.ghostdagDataStore.Stage(stagingArea, virtualGenesis,
+		externalapi.NewBlockGHOSTDAGData(0, big.NewInt(0), nil, nil, nil, nil), false)
+	stores.ghostdagDataStore.Stage(stagingArea, genesisHash, externalapi.NewBlockGHOSTDAGData(0, big.NewInt(0),
+		virtualGenesis, []*externalapi.DomainHash{virtualGenesis}, nil, nil), false)
+	stores.ghostdagDataStore.Stage(stagingArea, blockAHash, externalapi.NewBlockGHOSTDAGData(1, big.NewInt(1),
+		genesisHash, []*externalapi.DomainHash{genesisHash}, nil, nil), false)
+	stores.ghostdagDataStore.Stage(stagingArea, model.VirtualBlockHash, externalapi.NewBlockGHOSTDAGData(2, big.NewInt(2),
+		blockAHash, []*externalapi.DomainHash{blockAHash}, nil, nil), false)
+
+	stores.reachabilityDataStore.StageReachabilityData(stagingArea, virtualGenesis, reachabilitydata.New(
+		[]*externalapi.DomainHash{genesisHash}, nil, &model.ReachabilityInterval{Start: 1, End: 100}, nil))
+	stores.reachabilityDataStore.StageReachabilityData(stagingArea, genesisHash, reachabilitydata.New(
+		[]*externalapi.DomainHash{blockAHash}, virtualGenesis, &model.ReachabilityInterval{Start: 2, End: 99}, nil))
+	stores.reachabilityDataStore.StageReachabilityData(stagingArea, blockAHash, reachabilitydata.New(
+		nil, genesisHash, &model.ReachabilityInterval{Start: 3, End: 98}, nil))
+	stores.reachabilityDataStore.StageReachabilityReindexRoot(stagingArea, virtualGenesis)
+
+	genesis := testBlock(multiset.New().Hash())
+	blockA := testBlock(testMultiset(t).Hash())
+	stores.blockHeaderStore.Stage(stagingArea, genesisHash, genesis.Header)
+	stores.blockStore.Stage(stagingArea, genesisHash, genesis)
+	stores.blockHeaderStore.Stage(stagingArea, blockAHash, blockA.Header)
+	stores.blockStore.Stage(stagingArea, blockAHash, blockA)
+
+	stores.multisetStore.Stage(stagingArea, genesisHash, multiset.New())
+	stores.multisetStore.Stage(stagingArea, blockAHash, testMultiset(t))
+	stores.multisetStore.Stage(stagingArea, model.VirtualBlockHash, testMultiset(t))
+	stores.utxoDiffStore.Stage(stagingArea, genesisHash, utxo.NewUTXODiff(), blockAHash)
+	stores.utxoDiffStore.Stage(stagingArea, blockAHash, testUTXODiff(t), nil)
+
+	stores.consensusStateStore.StageTips(stagingArea, []*externalapi.DomainHash{blockAHash})
+	stores.consensusStateStore.StageVirtualUTXODiff(stagingArea, testUTXODiff(t))
+	err = stores.pruningStore.StagePruningPoint(dbManager, stagingArea, genesisHash)
+	if err != nil {
+		t.Fatalf("StagePruningPoint: %+v", err)
+	}
+
+	stores.commit(t, stagingArea)
+	return stores, teardown
+}
+
+func checkProblems(t *testing.T, report *model.IntegrityReport, expectedProblems []*model.IntegrityProblem) {
+	if len(report.Problems) != len(expectedProblems) {
+		t.Fatalf("Got problems %s, expected %s", report.Problems, expectedProblems)
+	}
+	for i, problem := range report.Problems {
+		expectedProblem := expectedProblems[i]
+		if problem.Store != expectedProblem.Store || !problem.BlockHash.Equal(expectedProblem.BlockHash) ||
+			!strings.HasPrefix(problem.Description, expectedProblem.Description) {
+
+			t.Fatalf("Got problem %s, expected %s", problem, expectedProblem)
+		}
+	}
+}
+
+func TestVerifyIntegrity(t *testing.T) {
+	stores, teardown := setUpTestStores(t)
+	defer teardown()
+
+	report := stores.verifyIntegrity(t)
+	checkProblems(t, report, nil)
+	if report.BlockCount != 3 || report.BlockCountByStatus[externalapi.StatusUTXOValid] != 3 {
+		t.Fatalf("Unexpected block counts %d, %v", report.BlockCount, report.BlockCountByStatus)
+	}
+	if report.VirtualUTXOCount != 1 || !report.VirtualUTXOCommitment.Equal(testMultiset(t).Hash()) {
+		t.Fatalf("Unexpected virtual UTXO set %d, %s", report.VirtualUTXOCount, report.VirtualUTXOCommitment)
+	}
+	if !report.PruningPoint.Equal(genesisHash) || report.PruningPointUTXOCount != 0 {
+		t.Fatalf("Unexpected pruning point %s with %d UTXOs", report.PruningPoint, report.PruningPointUTXOCount)
+	}
+	if len(report.Warnings) != 0 {
+		t.Fatalf("Unexpected warnings %s", report.Warnings)
+	}
+}
+
+func TestVerifyIntegrityProblems(t *testing.T) {
+	tests := []struct {
+		name             string
+		corrupt          func(t *testing.T, stores *testStores, stagingArea *model.StagingArea)
+		expectedProblems []*model.IntegrityProblem
+	}{
+		{
+			name: "missing multiset",
+			corrupt: func(t *testing.T, stores *testStores, stagingArea *model.StagingArea) {
+				stores.multisetStore.Delete(stagingArea, blockAHash)
+			},
+			expectedProblems: []*model.IntegrityProblem{
+				{Store: multisetStoreName, BlockHash: blockAHash, Description: "missing multiset"},
+			},
+		},
+		{
+			name: "missing UTXO diff",
+			corrupt: func(t *testing.T, stores *testStores, stagingArea *model.StagingArea) {
+				stores.utxoDiffStore.Delete(stagingArea, blockAHash)
+			},
+			expectedProblems: []*model.IntegrityProblem{
+				{Store: utxoDiffStoreName, BlockHash: blockAHash, Description: "missing UTXO diff"},
+			},
+		},
+		{
+			name: "missing body",
+			corrupt: func(t *testing.T, stores *testStores, stagingArea *model.StagingArea) {
+				stores.blockStore.Delete(stagingArea, blockAHash)
+			},
+			expectedProblems: []*model.IntegrityProblem{
+				{Store: blockStoreName, BlockHash: blockAHash, Description: "missing the body"},
+			},
+		},
+		{
+			name: "unknown merge set block",
+			corrupt: func(t *testing.T, stores *testStores, stagingArea *model.StagingArea) {
+				stores.ghostdagDataStore.Stage(stagingArea, blockAHash, externalapi.NewBlockGHOSTDAGData(1, big.NewInt(1),
+					genesisHash, []*externalapi.DomainHash{genesisHash}, []*externalapi.DomainHash{unknownHash}, nil), false)
+			},
+			expectedProblems: []*model.IntegrityProblem{
+				{Store: ghostdagDataStoreName, BlockHash: blockAHash, Description: "the red merge set block " + unknownHash.String()},
+			},
+		},
+		{
+			name: "wrong reachability tree parent",
+			corrupt: func(t *testing.T, stores *testStores, stagingArea *model.StagingArea) {
+				stores.reachabilityDataStore.StageReachabilityData(stagingArea, blockAHash, reachabilitydata.New(
+					nil, model.VirtualGenesisBlockHash, &model.ReachabilityInterval{Start: 3, End: 98}, nil))
+			},
+			expectedProblems: []*model.IntegrityProblem{
+				{Store: reachabilityDataStoreName, BlockHash: genesisHash, Description: "the reachability tree child " + blockAHash.String()},
+			},
+		},
+		{
+			name: "virtual UTXO set doesn't match the virtual multiset",
+			corrupt: func(t *testing.T, stores *testStores, stagingArea *model.StagingArea) {
+				stores.multisetStore.Stage(stagingArea, model.VirtualBlockHash, multiset.New())
+			},
+			expectedProblems: []*model.IntegrityProblem{
+				{Store: consensusStateStoreName, Description: "the MuHash " + testMultiset(t).Hash().String()},
+			},
+		},
+		{
+			name: "pruning point UTXO set doesn't match its commitment",
+			corrupt: func(t *testing.T, stores *testStores, stagingArea *model.StagingArea) {
+				err := stores.pruningStore.UpdatePruningPointUTXOSet(stores.dbManager, testUTXODiff(t))
+				if err != nil {
+					t.Fatalf("UpdatePruningPointUTXOSet: %+v", err)
+				}
+			},
+			expectedProblems: []*model.IntegrityProblem{
+				{Store: pruningStoreName, BlockHash: genesisHash, Description: "the MuHash " + testMultiset(t).Hash().String()},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			stores, teardown := setUpTestStores(t)
+			defer teardown()
+
+			stagingArea := model.NewStagingArea()
+			test.corrupt(t, stores, stagingArea)
+			stores.commit(t, stagingArea)
+
+			checkProblems(t, stores.verifyIntegrity(t), test.expectedProblems)
+		})
+	}
+}
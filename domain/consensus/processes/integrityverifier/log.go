@@ -0,0 +1,5 @@
+package integrityverifier
+
+import "github.com/kobradag/kobrad/infrastructure/logger"
+
+var log = logger.RegisterSubSystem("INTV")
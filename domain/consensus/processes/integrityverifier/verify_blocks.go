@@ -0,0 +1,258 @@
+package integrityverifier
+
+import (
+	"github.com/kobradag/kobrad/domain/consensus/database"
+	"github.com/kobradag/kobrad/domain/consensus/model"
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+)
+
+func (iv *integrityVerifier) verifyBlocks(report *model.IntegrityReport) error {
+	stagingArea := model.NewStagingArea()
+
+	iterator, err := iv.blockStatusStore.AllBlockHashesIterator(iv.databaseContext)
+	if err != nil {
+		return err
+	}
+	defer iterator.Close()
+
+	for ok := iterator.First(); ok; ok = iterator.Next() {
+		blockHash, err := iterator.Get()
+		if err != nil {
+			addProblem(report, blockStatusStoreName, nil, "malformed block hash key: %s", err)
+			continue
+		}
+
+		err = iv.verifyBlock(report, stagingArea, blockHash)
+		if err != nil {
+			return err
+		}
+
+		report.BlockCount++
+		if report.BlockCount%progressLogInterval == 0 {
+			log.Infof("Verified %d blocks, found %d problems so far", report.BlockCount, len(report.Problems))
+		}
+	}
+	log.Infof("Verified %d blocks", report.BlockCount)
+
+	return nil
+}
+
+func (iv *integrityVerifier) verifyBlock(report *model.IntegrityReport, stagingArea *model.StagingArea,
+	blockHash *externalapi.DomainHash) error {
+
+	status, err := iv.blockStatusStore.Get(iv.databaseContext, stagingArea, blockHash)
+	if err != nil {
+		addReadProblem(report, blockStatusStoreName, blockHash, "block status", err)
+		return nil
+	}
+	report.BlockCountByStatus[status]++
+
+	switch status {
+	case externalapi.StatusInvalid:
+		// Invalid blocks only have a block status
+		return nil
+	case externalapi.StatusUTXOValid, externalapi.StatusUTXOPendingVerification,
+		externalapi.StatusDisqualifiedFromChain, externalapi.StatusHeaderOnly:
+	default:
+		addProblem(report, blockStatusStoreName, blockHash, "unknown block status %d", status)
+		return nil
+	}
+
+	err = iv.verifyGHOSTDAGData(report, stagingArea, blockHash)
+	if err != nil {
+		return err
+	}
+	err = iv.verifyReachabilityData(report, stagingArea, blockHash)
+	if err != nil {
+		return err
+	}
+
+	// The virtual genesis has no header, body or UTXO data
+	if blockHash.Equal(model.VirtualGenesisBlockHash) {
+		return nil
+	}
+
+	header, err := iv.blockHeaderStore.BlockHeader(iv.databaseContext, stagingArea, blockHash)
+	if err != nil {
+		addReadProblem(report, blockHeaderStoreName, blockHash, "block header", err)
+	}
+
+	if status != externalapi.StatusHeaderOnly {
+		hasBlock, err := iv.blockStore.HasBlock(iv.databaseContext, stagingArea, blockHash)
+		if err != nil {
+			return err
+		}
+		if !hasBlock {
+			addProblem(report, blockStoreName, blockHash, "missing the body of a block with status %s", status)
+		}
+	}
+
+	// Blocks that were pruned have their status set to header-only, and on
+	// non-archival nodes their UTXO data is deleted along with their body
+	if status == externalapi.StatusUTXOValid {
+		return iv.verifyUTXOData(report, stagingArea, blockHash, header)
+	}
+	return nil
+}
+
+func (iv *integrityVerifier) verifyGHOSTDAGData(report *model.IntegrityReport, stagingArea *model.StagingArea,
+	blockHash *externalapi.DomainHash) error {
+
+	ghostdagData, err := iv.ghostdagDataStore.Get(iv.databaseContext, stagingArea, blockHash, false)
+	if err != nil {
+		addReadProblem(report, ghostdagDataStoreName, blockHash, "GHOSTDAG data", err)
+		return nil
+	}
+
+	// The virtual genesis is the only block without a selected parent
+	if blockHash.Equal(model.VirtualGenesisBlockHash) {
+		return nil
+	}
+	if ghostdagData.SelectedParent() == nil {
+		addProblem(report, ghostdagDataStoreName, blockHash, "the GHOSTDAG data has no selected parent")
+	} else {
+		err = iv.verifyReferencedBlock(report, stagingArea, blockHash, "selected parent", ghostdagData.SelectedParent())
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, blue := range ghostdagData.MergeSetBlues() {
+		err = iv.verifyReferencedBlock(report, stagingArea, blockHash, "blue merge set block", blue)
+		if err != nil {
+			return err
+		}
+	}
+	for _, red := range ghostdagData.MergeSetReds() {
+		err = iv.verifyReferencedBlock(report, stagingArea, blockHash, "red merge set block", red)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// verifyReferencedBlock checks that a block that's referenced by the GHOSTDAG
+// data of blockHash has a block status. The data of the referenced block
+// itself is verified when the walk reaches it.
+func (iv *integrityVerifier) verifyReferencedBlock(report *model.IntegrityReport, stagingArea *model.StagingArea,
+	blockHash *externalapi.DomainHash, reference string, referencedBlockHash *externalapi.DomainHash) error {
+
+	exists, err := iv.blockStatusStore.Exists(iv.databaseContext, stagingArea, referencedBlockHash)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		addProblem(report, ghostdagDataStoreName, blockHash, "the %s %s has no block status",
+			reference, referencedBlockHash)
+	}
+	return nil
+}
+
+func (iv *integrityVerifier) verifyReachabilityData(report *model.IntegrityReport, stagingArea *model.StagingArea,
+	blockHash *externalapi.DomainHash) error {
+
+	reachabilityData, err := iv.reachabilityDataStore.ReachabilityData(iv.databaseContext, stagingArea, blockHash)
+	if err != nil {
+		addReadProblem(report, reachabilityDataStoreName, blockHash, "reachability data", err)
+		return nil
+	}
+
+	parent := reachabilityData.Parent()
+	if parent != nil {
+		hasParent, err := iv.reachabilityDataStore.HasReachabilityData(iv.databaseContext, stagingArea, parent)
+		if err != nil {
+			return err
+		}
+		if !hasParent {
+			addProblem(report, reachabilityDataStoreName, blockHash,
+				"the reachability tree parent %s has no reachability data", parent)
+		}
+	}
+
+	interval := reachabilityData.Interval()
+	for _, child := range reachabilityData.Children() {
+		childReachabilityData, err := iv.reachabilityDataStore.ReachabilityData(iv.databaseContext, stagingArea, child)
+		if err != nil {
+			if database.IsNotFoundError(err) {
+				addProblem(report, reachabilityDataStoreName, blockHash,
+					"the reachability tree child %s has no reachability data", child)
+			}
+			// A malformed child is reported when the walk reaches it
+			continue
+		}
+		if !blockHash.Equal(childReachabilityData.Parent()) {
+			addProblem(report, reachabilityDataStoreName, blockHash,
+				"the reachability tree child %s has the parent %s", child, childReachabilityData.Parent())
+		}
+		childInterval := childReachabilityData.Interval()
+		if childInterval.Start < interval.Start || childInterval.End > interval.End {
+			addProblem(report, reachabilityDataStoreName, blockHash,
+				"the interval %s of the reachability tree child %s isn't contained in the interval %s",
+				childInterval, child, interval)
+		}
+	}
+
+	for _, futureCoveringBlockHash := range reachabilityData.FutureCoveringSet() {
+		exists, err := iv.reachabilityDataStore.HasReachabilityData(iv.databaseContext, stagingArea, futureCoveringBlockHash)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			addProblem(report, reachabilityDataStoreName, blockHash,
+				"the future covering set block %s has no reachability data", futureCoveringBlockHash)
+		}
+	}
+
+	return nil
+}
+
+func (iv *integrityVerifier) verifyUTXOData(report *model.IntegrityReport, stagingArea *model.StagingArea,
+	blockHash *externalapi.DomainHash, header externalapi.BlockHeader) error {
+
+	multiset, err := iv.multisetStore.Get(iv.databaseContext, stagingArea, blockHash)
+	if err != nil {
+		addReadProblem(report, multisetStoreName, blockHash, "multiset", err)
+	} else if header != nil {
+		multisetHash := multiset.Hash()
+		if !header.UTXOCommitment().Equal(multisetHash) {
+			addProblem(report, multisetStoreName, blockHash,
+				"the multiset hash %s doesn't match the UTXO commitment %s in the header",
+				multisetHash, header.UTXOCommitment())
+		}
+	}
+
+	_, err = iv.utxoDiffStore.UTXODiff(iv.databaseContext, stagingArea, blockHash)
+	if err != nil {
+		addReadProblem(report, utxoDiffStoreName, blockHash, "UTXO diff", err)
+	}
+
+	hasUTXODiffChild, err := iv.utxoDiffStore.HasUTXODiffChild(iv.databaseContext, stagingArea, blockHash)
+	if err != nil {
+		return err
+	}
+	if !hasUTXODiffChild {
+		return nil
+	}
+	utxoDiffChild, err := iv.utxoDiffStore.UTXODiffChild(iv.databaseContext, stagingArea, blockHash)
+	if err != nil {
+		addReadProblem(report, utxoDiffStoreName, blockHash, "UTXO diff child", err)
+		return nil
+	}
+	utxoDiffChildStatus, err := iv.blockStatusStore.Get(iv.databaseContext, stagingArea, utxoDiffChild)
+	if err != nil {
+		if database.IsNotFoundError(err) {
+			addProblem(report, utxoDiffStoreName, blockHash,
+				"the UTXO diff child %s has no block status", utxoDiffChild)
+		}
+		// A malformed status is reported when the walk reaches the child
+		return nil
+	}
+	// The UTXO diff child might have been pruned since
+	if utxoDiffChildStatus != externalapi.StatusUTXOValid && utxoDiffChildStatus != externalapi.StatusHeaderOnly {
+		addProblem(report, utxoDiffStoreName, blockHash, "the UTXO diff child %s has status %s",
+			utxoDiffChild, utxoDiffChildStatus)
+	}
+	return nil
+}
@@ -0,0 +1,195 @@
+package integrityverifier
+
+import (
+	"github.com/kobradag/kobrad/domain/consensus/model"
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/domain/consensus/utils/multiset"
+	"github.com/kobradag/kobrad/domain/consensus/utils/utxo"
+)
+
+func (iv *integrityVerifier) verifyReachabilityReindexRoot(report *model.IntegrityReport) error {
+	stagingArea := model.NewStagingArea()
+
+	reindexRoot, err := iv.reachabilityDataStore.ReachabilityReindexRoot(iv.databaseContext, stagingArea)
+	if err != nil {
+		addReadProblem(report, reachabilityDataStoreName, nil, "reachability reindex root", err)
+		return nil
+	}
+	hasReachabilityData, err := iv.reachabilityDataStore.HasReachabilityData(iv.databaseContext, stagingArea, reindexRoot)
+	if err != nil {
+		return err
+	}
+	if !hasReachabilityData {
+		addProblem(report, reachabilityDataStoreName, nil,
+			"the reachability reindex root %s has no reachability data", reindexRoot)
+	}
+	return nil
+}
+
+func (iv *integrityVerifier) verifyVirtual(report *model.IntegrityReport) error {
+	stagingArea := model.NewStagingArea()
+
+	tips, err := iv.consensusStateStore.Tips(stagingArea, iv.databaseContext)
+	if err != nil {
+		addReadProblem(report, consensusStateStoreName, nil, "DAG tips", err)
+	}
+	for _, tip := range tips {
+		exists, err := iv.blockStatusStore.Exists(iv.databaseContext, stagingArea, tip)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			addProblem(report, consensusStateStoreName, nil, "the DAG tip %s has no block status", tip)
+		}
+	}
+
+	virtualGHOSTDAGData, err := iv.ghostdagDataStore.Get(iv.databaseContext, stagingArea, model.VirtualBlockHash, false)
+	if err != nil {
+		addReadProblem(report, ghostdagDataStoreName, nil, "GHOSTDAG data of the virtual", err)
+		return nil
+	}
+	virtualSelectedParent := virtualGHOSTDAGData.SelectedParent()
+	if virtualSelectedParent == nil {
+		addProblem(report, ghostdagDataStoreName, nil, "the GHOSTDAG data of the virtual has no selected parent")
+		return nil
+	}
+	virtualSelectedParentStatus, err := iv.blockStatusStore.Get(iv.databaseContext, stagingArea, virtualSelectedParent)
+	if err != nil {
+		addReadProblem(report, blockStatusStoreName, virtualSelectedParent,
+			"block status of the virtual selected parent", err)
+		return nil
+	}
+	if virtualSelectedParentStatus != externalapi.StatusUTXOValid {
+		addProblem(report, ghostdagDataStoreName, nil, "the virtual selected parent %s has status %s",
+			virtualSelectedParent, virtualSelectedParentStatus)
+	}
+	return nil
+}
+
+// verifyVirtualUTXOSet recomputes the MuHash of the virtual UTXO set, and
+// compares it against the multiset of the virtual, which is what the UTXO
+// commitment of the next block is built from
+func (iv *integrityVerifier) verifyVirtualUTXOSet(report *model.IntegrityReport) error {
+	stagingArea := model.NewStagingArea()
+
+	hadStartedImportingPruningPointUTXOSet, err := iv.consensusStateStore.HadStartedImportingPruningPointUTXOSet(iv.databaseContext)
+	if err != nil {
+		return err
+	}
+	if hadStartedImportingPruningPointUTXOSet {
+		report.Warnings = append(report.Warnings, "The import of the pruning point UTXO set into the virtual "+
+			"UTXO set was interrupted, so the virtual UTXO set wasn't verified. The node finishes the import "+
+			"when it starts.")
+		return nil
+	}
+
+	iterator, err := iv.consensusStateStore.VirtualUTXOSetIterator(iv.databaseContext, stagingArea)
+	if err != nil {
+		return err
+	}
+	defer iterator.Close()
+
+	virtualUTXOSetMultiset, virtualUTXOCount, err := utxoSetMultiset(report, consensusStateStoreName, "virtual UTXO set", iterator)
+	if err != nil {
+		return err
+	}
+	report.VirtualUTXOCount = virtualUTXOCount
+	report.VirtualUTXOCommitment = virtualUTXOSetMultiset.Hash()
+
+	virtualMultiset, err := iv.multisetStore.Get(iv.databaseContext, stagingArea, model.VirtualBlockHash)
+	if err != nil {
+		addReadProblem(report, multisetStoreName, nil, "multiset of the virtual", err)
+		return nil
+	}
+	committedUTXOCommitment := virtualMultiset.Hash()
+	if !report.VirtualUTXOCommitment.Equal(committedUTXOCommitment) {
+		addProblem(report, consensusStateStoreName, nil, "the MuHash %s of the virtual UTXO set doesn't match "+
+			"the hash %s of the multiset of the virtual", report.VirtualUTXOCommitment, committedUTXOCommitment)
+	}
+	return nil
+}
+
+// verifyPruningPointUTXOSet recomputes the MuHash of the pruning point UTXO
+// set, and compares it against the UTXO commitment in the header of the
+// pruning point
+func (iv *integrityVerifier) verifyPruningPointUTXOSet(report *model.IntegrityReport) error {
+	stagingArea := model.NewStagingArea()
+
+	pruningPoint, err := iv.pruningStore.PruningPoint(iv.databaseContext, stagingArea)
+	if err != nil {
+		addReadProblem(report, pruningStoreName, nil, "pruning point", err)
+		return nil
+	}
+	report.PruningPoint = pruningPoint
+
+	exists, err := iv.blockStatusStore.Exists(iv.databaseContext, stagingArea, pruningPoint)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		addProblem(report, pruningStoreName, pruningPoint, "the pruning point has no block status")
+	}
+	pruningPointHeader, err := iv.blockHeaderStore.BlockHeader(iv.databaseContext, stagingArea, pruningPoint)
+	if err != nil {
+		addReadProblem(report, blockHeaderStoreName, pruningPoint, "header of the pruning point", err)
+		return nil
+	}
+
+	hadStartedUpdatingPruningPointUTXOSet, err := iv.pruningStore.HadStartedUpdatingPruningPointUTXOSet(iv.databaseContext)
+	if err != nil {
+		return err
+	}
+	if hadStartedUpdatingPruningPointUTXOSet {
+		report.Warnings = append(report.Warnings, "The update of the pruning point UTXO set was interrupted, "+
+			"so the pruning point UTXO set wasn't verified. The node finishes the update when it starts.")
+		return nil
+	}
+
+	iterator, err := iv.pruningStore.PruningPointUTXOIterator(iv.databaseContext)
+	if err != nil {
+		return err
+	}
+	defer iterator.Close()
+
+	pruningPointUTXOSetMultiset, pruningPointUTXOCount, err :=
+		utxoSetMultiset(report, pruningStoreName, "pruning point UTXO set", iterator)
+	if err != nil {
+		return err
+	}
+	report.PruningPointUTXOCount = pruningPointUTXOCount
+
+	pruningPointUTXOSetHash := pruningPointUTXOSetMultiset.Hash()
+	if !pruningPointHeader.UTXOCommitment().Equal(pruningPointUTXOSetHash) {
+		addProblem(report, pruningStoreName, pruningPoint, "the MuHash %s of the pruning point UTXO set doesn't "+
+			"match the UTXO commitment %s in the header of the pruning point",
+			pruningPointUTXOSetHash, pruningPointHeader.UTXOCommitment())
+	}
+	return nil
+}
+
+// utxoSetMultiset adds all the entries of the given UTXO set to a new
+// multiset, and returns it along with the number of entries
+func utxoSetMultiset(report *model.IntegrityReport, store string, utxoSetName string,
+	iterator externalapi.ReadOnlyUTXOSetIterator) (model.Multiset, uint64, error) {
+
+	utxoSetMultiset := multiset.New()
+	count := uint64(0)
+	for ok := iterator.First(); ok; ok = iterator.Next() {
+		outpoint, entry, err := iterator.Get()
+		if err != nil {
+			addProblem(report, store, nil, "malformed entry in the %s: %s", utxoSetName, err)
+			continue
+		}
+		serializedUTXO, err := utxo.SerializeUTXO(entry, outpoint)
+		if err != nil {
+			return nil, 0, err
+		}
+		utxoSetMultiset.Add(serializedUTXO)
+
+		count++
+		if count%progressLogInterval == 0 {
+			log.Infof("Added %d entries of the %s", count, utxoSetName)
+		}
+	}
+	return utxoSetMultiset, count, nil
+}
@@ -1,6 +1,8 @@
 package transactionvalidator
 
 import (
+	"encoding/binary"
+
 	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
 	"github.com/kobradag/kobrad/domain/consensus/ruleerrors"
 	"github.com/kobradag/kobrad/domain/consensus/utils/constants"
@@ -134,6 +136,13 @@ func (v *transactionValidator) checkCoinbaseInIsolation(tx *externalapi.DomainTr
 	outputsLimit := uint64(v.ghostdagK) + 2
 	// Make the outputsLimits twice the size because of developer fee in outputs with coinbase outputs.
 	outputsLimit *= 2
+	// Once the rewards of blocks may be split, every reward may be split to several payees
+	const blueScoreLength = 8
+	if len(tx.Payload) >= blueScoreLength &&
+		binary.LittleEndian.Uint64(tx.Payload[:blueScoreLength]) >= v.coinbasePayeesActivationBlueScore {
+
+		outputsLimit *= constants.MaxCoinbasePayees
+	}
 	if uint64(len(tx.Outputs)) > outputsLimit {
 		return errors.Wrapf(ruleerrors.ErrCoinbaseTooManyOutputs, "coinbase has too many outputs: got %d where the limit is %d", len(tx.Outputs), outputsLimit)
 	}
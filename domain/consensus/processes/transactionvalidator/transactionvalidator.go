@@ -21,6 +21,7 @@ type transactionValidator struct {
 	maxCoinbasePayloadLength                uint64
 	ghostdagK                               externalapi.KType
 	coinbasePayloadScriptPublicKeyMaxLength uint8
+	coinbasePayeesActivationBlueScore       uint64
 	sigCache                                *txscript.SigCache
 	sigCacheECDSA                           *txscript.SigCacheECDSA
 	txMassCalculator                        *txmass.Calculator
@@ -32,6 +33,7 @@ func New(blockCoinbaseMaturity uint64,
 	maxCoinbasePayloadLength uint64,
 	ghostdagK externalapi.KType,
 	coinbasePayloadScriptPublicKeyMaxLength uint8,
+	coinbasePayeesActivationBlueScore uint64,
 	databaseContext model.DBReader,
 	pastMedianTimeManager model.PastMedianTimeManager,
 	ghostdagDataStore model.GHOSTDAGDataStore,
@@ -44,6 +46,7 @@ func New(blockCoinbaseMaturity uint64,
 		maxCoinbasePayloadLength:                maxCoinbasePayloadLength,
 		ghostdagK:                               ghostdagK,
 		coinbasePayloadScriptPublicKeyMaxLength: coinbasePayloadScriptPublicKeyMaxLength,
+		coinbasePayeesActivationBlueScore:       coinbasePayeesActivationBlueScore,
 		databaseContext:                         databaseContext,
 		pastMedianTimeManager:                   pastMedianTimeManager,
 		ghostdagDataStore:                       ghostdagDataStore,
@@ -140,6 +140,10 @@ var (
 	// for a coinbase transaction is too high.
 	ErrBadCoinbasePayloadLen = newRuleError("ErrBadCoinbasePayloadLen")
 
+	// ErrBadCoinbasePayees indicates that the payees the rewards of a block
+	// are split to are invalid
+	ErrBadCoinbasePayees = newRuleError("ErrBadCoinbasePayees")
+
 	// ErrBadCoinbaseTransaction indicates that the block's coinbase transaction is not build as expected
 	ErrBadCoinbaseTransaction = newRuleError("ErrBadCoinbaseTransaction")
 
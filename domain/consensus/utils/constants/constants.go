@@ -19,6 +19,14 @@ const (
 	// MaxScriptPublicKeyVersion is the current latest supported public key script version.
 	MaxScriptPublicKeyVersion uint16 = 0
 
+	// MaxCoinbasePayees is the maximum number of scripts the rewards of a
+	// block may be split to
+	MaxCoinbasePayees = 8
+
+	// CoinbasePayeesScriptPublicKeyVersionFlag is set in the script public key
+	// version of a coinbase payload whose rewards are split to several payees
+	CoinbasePayeesScriptPublicKeyVersionFlag uint16 = 1 << 15
+
 	// LeorPerKobra is the number of leor in one kobra (1 KODA).
 	LeorPerKobra = 100_000_000
 
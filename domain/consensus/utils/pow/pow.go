@@ -126,6 +126,12 @@ func (state *State) CalculateProofOfWorkValueKodahash() *big.Int {
 	return toBig(multiplied)
 }
 
+// PrePowHash returns the hash of the header with its timestamp and nonce
+// zeroed out, which is the part of the work that external miners hash
+func (state *State) PrePowHash() *externalapi.DomainHash {
+	return &state.prePowHash
+}
+
 // IncrementNonce increments the nonce in State by 1
 func (state *State) IncrementNonce() {
 	state.Nonce++
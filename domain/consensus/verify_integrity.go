@@ -0,0 +1,79 @@
+package consensus
+
+import (
+	consensusdatabase "github.com/kobradag/kobrad/domain/consensus/database"
+	"github.com/kobradag/kobrad/domain/consensus/datastructures/blockheaderstore"
+	"github.com/kobradag/kobrad/domain/consensus/datastructures/blockstatusstore"
+	"github.com/kobradag/kobrad/domain/consensus/datastructures/blockstore"
+	"github.com/kobradag/kobrad/domain/consensus/datastructures/consensusstatestore"
+	"github.com/kobradag/kobrad/domain/consensus/datastructures/multisetstore"
+	"github.com/kobradag/kobrad/domain/consensus/datastructures/pruningstore"
+	"github.com/kobradag/kobrad/domain/consensus/datastructures/reachabilitydatastore"
+	"github.com/kobradag/kobrad/domain/consensus/datastructures/utxodiffstore"
+	"github.com/kobradag/kobrad/domain/consensus/model"
+	"github.com/kobradag/kobrad/domain/consensus/processes/integrityverifier"
+	"github.com/kobradag/kobrad/domain/prefixmanager/prefix"
+	infrastructuredatabase "github.com/kobradag/kobrad/infrastructure/db/database"
+)
+
+// integrityVerificationCacheSize is the size of the store caches during an
+// integrity verification. Every block is visited once, so the caches only
+// serve the lookups of the neighbours of the current block.
+const integrityVerificationCacheSize = 10_000
+
+// VerifyIntegrity checks the consistency of the consensus stores of the
+// consensus with the given prefix in db, and returns a report of the
+// inconsistencies that it found.
+//
+// Unlike NewConsensus, it doesn't initialize the consensus or recover an
+// interrupted operation, so it never writes to the database. It's meant to
+// run on the database of a stopped node, and especially of one that crashed.
+func VerifyIntegrity(config *Config, db infrastructuredatabase.Database, dbPrefix *prefix.Prefix) (
+	*model.IntegrityReport, error) {
+
+	dbManager := consensusdatabase.New(db)
+	prefixBucket := consensusdatabase.MakeBucket(dbPrefix.Serialize())
+
+	blockStatusStore := blockstatusstore.New(prefixBucket, integrityVerificationCacheSize, false)
+	blockHeaderStore, err := blockheaderstore.New(dbManager, prefixBucket, integrityVerificationCacheSize, false)
+	if err != nil {
+		return nil, err
+	}
+	blockStore, err := blockstore.New(dbManager, prefixBucket, 200, false)
+	if err != nil {
+		return nil, err
+	}
+	multisetStore := multisetstore.New(prefixBucket, 200, false)
+	pruningStore := pruningstore.New(prefixBucket, 2, false)
+	utxoDiffStore := utxodiffstore.New(prefixBucket, 200, false)
+	consensusStateStore := consensusstatestore.New(prefixBucket, integrityVerificationCacheSize, false)
+
+	// The reachability data is either in the store of the first block
+	// level, or in the newer store at the root of the prefix. See
+	// NewConsensus.
+	_, reachabilityDataStores, ghostdagDataStores := dagStores(config, prefixBucket,
+		integrityVerificationCacheSize, integrityVerificationCacheSize, false)
+	reachabilityDataStore := reachabilityDataStores[0]
+	isOldReachabilityInitialized, err := reachabilityDataStore.HasReachabilityData(
+		dbManager, model.NewStagingArea(), model.VirtualGenesisBlockHash)
+	if err != nil {
+		return nil, err
+	}
+	if !isOldReachabilityInitialized {
+		reachabilityDataStore = reachabilitydatastore.New(prefixBucket, integrityVerificationCacheSize, false)
+	}
+
+	integrityVerifier := integrityverifier.New(
+		dbManager,
+		blockStatusStore,
+		blockHeaderStore,
+		blockStore,
+		ghostdagDataStores[0],
+		reachabilityDataStore,
+		utxoDiffStore,
+		multisetStore,
+		consensusStateStore,
+		pruningStore)
+
+	return integrityVerifier.VerifyIntegrity()
+}
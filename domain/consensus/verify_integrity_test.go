@@ -0,0 +1,81 @@
+package consensus_test
+
+import (
+	"testing"
+
+	"github.com/kobradag/kobrad/domain/consensus"
+	"github.com/kobradag/kobrad/domain/consensus/model"
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/domain/consensus/utils/testutils"
+	"github.com/kobradag/kobrad/domain/prefixmanager/prefix"
+)
+
+func TestVerifyIntegrity(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		// Keep the pruning depth small so that the pruning point moves quickly
+		consensusConfig.FinalityDuration = 10 * consensusConfig.TargetTimePerBlock
+		consensusConfig.MergeSetSizeLimit = 5
+
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestVerifyIntegrity")
+		if err != nil {
+			t.Fatalf("Error setting up consensus: %+v", err)
+		}
+		defer teardown(false)
+
+		verifyIntegrity := func() *model.IntegrityReport {
+			report, err := consensus.VerifyIntegrity(consensusConfig, tc.Database(), &prefix.Prefix{})
+			if err != nil {
+				t.Fatalf("VerifyIntegrity: %+v", err)
+			}
+			if len(report.Problems) != 0 {
+				t.Fatalf("Expected no problems, but got %s", report.Problems)
+			}
+			return report
+		}
+
+		// A DAG whose every other block merges two parallel blocks
+		tip := consensusConfig.GenesisHash
+		for i := 0; i < 10; i++ {
+			blockA, _, err := tc.AddBlock([]*externalapi.DomainHash{tip}, nil, nil)
+			if err != nil {
+				t.Fatalf("AddBlock: %+v", err)
+			}
+			blockB, _, err := tc.AddBlock([]*externalapi.DomainHash{tip}, nil, nil)
+			if err != nil {
+				t.Fatalf("AddBlock: %+v", err)
+			}
+			tip, _, err = tc.AddBlock([]*externalapi.DomainHash{blockA, blockB}, nil, nil)
+			if err != nil {
+				t.Fatalf("AddBlock: %+v", err)
+			}
+		}
+		report := verifyIntegrity()
+		// The blocks, the genesis and the virtual genesis
+		if report.BlockCount != 30+2 || report.BlockCountByStatus[externalapi.StatusInvalid] != 0 {
+			t.Fatalf("Unexpected block counts %d, %v", report.BlockCount, report.BlockCountByStatus)
+		}
+		if !report.PruningPoint.Equal(consensusConfig.GenesisHash) {
+			t.Fatalf("Expected the pruning point to be the genesis, but got %s", report.PruningPoint)
+		}
+
+		// Extend the chain until the blocks at its bottom are pruned
+		for i := uint64(0); i < consensusConfig.PruningDepth()+2*consensusConfig.FinalityDepth(); i++ {
+			tip, _, err = tc.AddBlock([]*externalapi.DomainHash{tip}, nil, nil)
+			if err != nil {
+				t.Fatalf("AddBlock: %+v", err)
+			}
+		}
+		pruningPoint, err := tc.PruningPoint()
+		if err != nil {
+			t.Fatalf("PruningPoint: %+v", err)
+		}
+		if pruningPoint.Equal(consensusConfig.GenesisHash) {
+			t.Fatalf("Expected the pruning point to move")
+		}
+		report = verifyIntegrity()
+		if !report.PruningPoint.Equal(pruningPoint) {
+			t.Fatalf("Expected the pruning point %s, but got %s", pruningPoint, report.PruningPoint)
+		}
+	})
+}
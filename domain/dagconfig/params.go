@@ -5,6 +5,7 @@
 package dagconfig
 
 import (
+	"math"
 	"math/big"
 	"time"
 
@@ -183,6 +184,10 @@ type Params struct {
 	// to its deflationary phase
 	DeflationaryPhaseDaaScore uint64
 
+	// CoinbasePayeesActivationBlueScore is the blue score from which the
+	// rewards of a block may be split to several payees
+	CoinbasePayeesActivationBlueScore uint64
+
 	DisallowDirectBlocksOnTopOfGenesis bool
 
 	// MaxBlockLevel is the maximum possible block level.
@@ -270,6 +275,7 @@ var MainnetParams = Params{
 	CoinbasePayloadScriptPublicKeyMaxLength: defaultCoinbasePayloadScriptPublicKeyMaxLength,
 	PruningProofM:                           defaultPruningProofM,
 	DeflationaryPhaseDaaScore:               defaultDeflationaryPhaseDaaScore,
+	CoinbasePayeesActivationBlueScore:       math.MaxUint64,
 	DisallowDirectBlocksOnTopOfGenesis:      true,
 	
 	// This is technically 255, but we clamped it at 256 - block level of mainnet genesis
@@ -338,6 +344,7 @@ var TestnetParams = Params{
 	CoinbasePayloadScriptPublicKeyMaxLength: defaultCoinbasePayloadScriptPublicKeyMaxLength,
 	PruningProofM:                           defaultPruningProofM,
 	DeflationaryPhaseDaaScore:               defaultDeflationaryPhaseDaaScore,
+	CoinbasePayeesActivationBlueScore:       math.MaxUint64,
 
 	MaxBlockLevel: 250,
 	MergeDepth:    defaultMergeDepth,
@@ -405,6 +412,7 @@ var SimnetParams = Params{
 	CoinbasePayloadScriptPublicKeyMaxLength: defaultCoinbasePayloadScriptPublicKeyMaxLength,
 	PruningProofM:                           defaultPruningProofM,
 	DeflationaryPhaseDaaScore:               defaultDeflationaryPhaseDaaScore,
+	CoinbasePayeesActivationBlueScore:       0,
 
 	MaxBlockLevel: 250,
 	MergeDepth:    defaultMergeDepth,
@@ -468,6 +476,7 @@ var DevnetParams = Params{
 	CoinbasePayloadScriptPublicKeyMaxLength: defaultCoinbasePayloadScriptPublicKeyMaxLength,
 	PruningProofM:                           defaultPruningProofM,
 	DeflationaryPhaseDaaScore:               defaultDeflationaryPhaseDaaScore,
+	CoinbasePayeesActivationBlueScore:       0,
 
 	MaxBlockLevel: 250,
 	MergeDepth:    defaultMergeDepth,
@@ -0,0 +1,9 @@
+package mempoolstore
+
+import (
+	"github.com/kobradag/kobrad/infrastructure/logger"
+	"github.com/kobradag/kobrad/util/panics"
+)
+
+var log = logger.RegisterSubSystem("MPST")
+var spawn = panics.GoroutineWrapperFunc(log)
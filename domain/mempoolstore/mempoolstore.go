@@ -0,0 +1,178 @@
+package mempoolstore
+
+import (
+	"sync"
+	"time"
+
+	"github.com/kobradag/kobrad/domain"
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/domain/consensus/utils/consensushashing"
+	"github.com/kobradag/kobrad/domain/miningmanager/mempool"
+	"github.com/kobradag/kobrad/infrastructure/db/database"
+	"github.com/kobradag/kobrad/infrastructure/logger"
+	"github.com/pkg/errors"
+)
+
+// saveInterval is the interval in which the mempool is persisted
+// while the node is running, so that a crash loses as little as possible
+const saveInterval = 5 * time.Minute
+
+// MempoolStore persists the transactions of the mempool to the database,
+// so that they survive node restarts
+type MempoolStore struct {
+	domain domain.Domain
+	store  *mempoolStore
+
+	mutex sync.Mutex
+	quit  chan struct{}
+}
+
+// New creates a new MempoolStore
+func New(domain domain.Domain, database database.Database) *MempoolStore {
+	return &MempoolStore{
+		domain: domain,
+		store:  newMempoolStore(database),
+		quit:   make(chan struct{}),
+	}
+}
+
+// Start begins persisting the mempool periodically
+func (ms *MempoolStore) Start() {
+	spawn("MempoolStore.saveLoop", ms.saveLoop)
+}
+
+// Stop stops persisting the mempool periodically and persists it one last time
+func (ms *MempoolStore) Stop() error {
+	close(ms.quit)
+	return ms.Save()
+}
+
+func (ms *MempoolStore) saveLoop() {
+	ticker := time.NewTicker(saveInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ms.quit:
+			return
+		case <-ticker.C:
+			err := ms.Save()
+			if err != nil {
+				log.Errorf("Error persisting the mempool: %+v", err)
+			}
+		}
+	}
+}
+
+// Save persists all the transactions currently in the mempool, replacing
+// the previously persisted ones
+func (ms *MempoolStore) Save() error {
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+
+	miningManager := ms.domain.MiningManager()
+	transactionPoolTransactions, orphanPoolTransactions := miningManager.AllTransactions(true, true)
+
+	highPriorityTransactionIDs := make(map[externalapi.DomainTransactionID]struct{})
+	for _, transactionID := range miningManager.HighPriorityTransactionIDs() {
+		highPriorityTransactionIDs[*transactionID] = struct{}{}
+	}
+
+	orphanTransactionIDs := make(map[externalapi.DomainTransactionID]struct{}, len(orphanPoolTransactions))
+	for _, transaction := range orphanPoolTransactions {
+		orphanTransactionIDs[*consensushashing.TransactionID(transaction)] = struct{}{}
+	}
+
+	transactions := sortTopologically(append(transactionPoolTransactions, orphanPoolTransactions...))
+	entries := make([]*mempoolEntry, len(transactions))
+	for i, transaction := range transactions {
+		transactionID := consensushashing.TransactionID(transaction)
+		_, isHighPriority := highPriorityTransactionIDs[*transactionID]
+		_, isOrphan := orphanTransactionIDs[*transactionID]
+		entries[i] = &mempoolEntry{
+			transaction:    transaction,
+			isHighPriority: isHighPriority,
+			isOrphan:       isOrphan,
+		}
+	}
+
+	err := ms.store.replaceAll(entries)
+	if err != nil {
+		return err
+	}
+
+	log.Debugf("Persisted %d mempool transactions", len(entries))
+	return nil
+}
+
+// Load revalidates the persisted transactions and inserts the valid ones
+// into the mempool. Transactions that are no longer valid - usually because
+// they were mined or double spent while the node was down - are dropped.
+//
+// A transaction that wasn't an orphan when it was persisted and whose inputs
+// are now missing has most likely been mined, so it's dropped rather than
+// inserted as an orphan. Persisted orphans are inserted back as orphans, but
+// with a normal priority, so that they expire if their parents never arrive.
+func (ms *MempoolStore) Load() error {
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+
+	onEnd := logger.LogAndMeasureExecutionTime(log, "MempoolStore.Load")
+	defer onEnd()
+
+	entries, err := ms.store.getAll()
+	if err != nil {
+		return err
+	}
+
+	acceptedCount := 0
+	for _, entry := range entries {
+		isHighPriority := entry.isHighPriority && !entry.isOrphan
+		acceptedTransactions, err := ms.domain.MiningManager().ValidateAndInsertTransaction(
+			entry.transaction, isHighPriority, entry.isOrphan)
+		if err != nil {
+			if errors.As(err, &mempool.RuleError{}) {
+				log.Debugf("Dropping persisted mempool transaction %s: %s",
+					consensushashing.TransactionID(entry.transaction), err)
+				continue
+			}
+			return err
+		}
+		acceptedCount += len(acceptedTransactions)
+	}
+
+	log.Infof("Loaded %d out of %d persisted mempool transactions", acceptedCount, len(entries))
+	return nil
+}
+
+// sortTopologically sorts the given transactions so that every transaction
+// comes after the transactions whose outputs it spends
+func sortTopologically(transactions []*externalapi.DomainTransaction) []*externalapi.DomainTransaction {
+	transactionsByID := make(map[externalapi.DomainTransactionID]*externalapi.DomainTransaction, len(transactions))
+	for _, transaction := range transactions {
+		transactionsByID[*consensushashing.TransactionID(transaction)] = transaction
+	}
+
+	sorted := make([]*externalapi.DomainTransaction, 0, len(transactions))
+	visited := make(map[externalapi.DomainTransactionID]struct{}, len(transactions))
+	var visit func(transactionID externalapi.DomainTransactionID)
+	visit = func(transactionID externalapi.DomainTransactionID) {
+		if _, ok := visited[transactionID]; ok {
+			return
+		}
+		visited[transactionID] = struct{}{}
+
+		transaction := transactionsByID[transactionID]
+		for _, input := range transaction.Inputs {
+			if _, ok := transactionsByID[input.PreviousOutpoint.TransactionID]; ok {
+				visit(input.PreviousOutpoint.TransactionID)
+			}
+		}
+		sorted = append(sorted, transaction)
+	}
+
+	for _, transaction := range transactions {
+		visit(*consensushashing.TransactionID(transaction))
+	}
+	return sorted
+}
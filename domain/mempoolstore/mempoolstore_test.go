@@ -0,0 +1,107 @@
+package mempoolstore
+
+import (
+	"testing"
+
+	"github.com/kobradag/kobrad/domain"
+	"github.com/kobradag/kobrad/domain/consensus"
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/domain/consensus/utils/consensushashing"
+	"github.com/kobradag/kobrad/domain/consensus/utils/testutils"
+	"github.com/kobradag/kobrad/domain/consensus/utils/transactionhelper"
+	"github.com/kobradag/kobrad/domain/miningmanager/mempool"
+)
+
+// TestSaveAndLoadOverMinedTransaction persists a mempool, mines one of its
+// transactions while the node is "down", and verifies that reloading it drops
+// the mined transaction instead of inserting it back as an orphan
+func TestSaveAndLoadOverMinedTransaction(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.BlockCoinbaseMaturity = 0
+		tc, teardown, err := consensus.NewFactory().NewTestConsensus(consensusConfig, "TestSaveAndLoadOverMinedTransaction")
+		if err != nil {
+			t.Fatalf("Error setting up consensus: %+v", err)
+		}
+		defer teardown(false)
+
+		// The coinbase of a block pays the blocks it merges, so the funding
+		// block is the second one
+		tip, _, err := tc.AddBlock([]*externalapi.DomainHash{consensusConfig.GenesisHash}, nil, nil)
+		if err != nil {
+			t.Fatalf("AddBlock: %+v", err)
+		}
+		fundingBlockHash, _, err := tc.AddBlock([]*externalapi.DomainHash{tip}, nil, nil)
+		if err != nil {
+			t.Fatalf("AddBlock: %+v", err)
+		}
+		fundingBlock, _, err := tc.GetBlock(fundingBlockHash)
+		if err != nil {
+			t.Fatalf("GetBlock: %+v", err)
+		}
+		fundingTransaction := fundingBlock.Transactions[transactionhelper.CoinbaseTransactionIndex]
+
+		minedTransaction, err := testutils.CreateTransaction(fundingTransaction, 1000)
+		if err != nil {
+			t.Fatalf("CreateTransaction: %+v", err)
+		}
+		childTransaction, err := testutils.CreateTransaction(minedTransaction, 1000)
+		if err != nil {
+			t.Fatalf("CreateTransaction: %+v", err)
+		}
+		missingTransaction, err := testutils.CreateTransaction(childTransaction, 1000)
+		if err != nil {
+			t.Fatalf("CreateTransaction: %+v", err)
+		}
+		orphanTransaction, err := testutils.CreateTransaction(missingTransaction, 1000)
+		if err != nil {
+			t.Fatalf("CreateTransaction: %+v", err)
+		}
+
+		mempoolConfig := mempool.DefaultConfig(&consensusConfig.Params)
+		domainBeforeRestart, err := domain.New(consensusConfig, mempoolConfig, tc.Database())
+		if err != nil {
+			t.Fatalf("Failed to set up a domain instance: %+v", err)
+		}
+		for _, transaction := range []*externalapi.DomainTransaction{minedTransaction, childTransaction, orphanTransaction} {
+			_, err = domainBeforeRestart.MiningManager().ValidateAndInsertTransaction(transaction.Clone(), true, true)
+			if err != nil {
+				t.Fatalf("ValidateAndInsertTransaction: %+v", err)
+			}
+		}
+		err = New(domainBeforeRestart, tc.Database()).Save()
+		if err != nil {
+			t.Fatalf("Save: %+v", err)
+		}
+
+		_, _, err = tc.AddBlock([]*externalapi.DomainHash{fundingBlockHash}, nil, []*externalapi.DomainTransaction{minedTransaction.Clone()})
+		if err != nil {
+			t.Fatalf("AddBlock: %+v", err)
+		}
+
+		domainAfterRestart, err := domain.New(consensusConfig, mempoolConfig, tc.Database())
+		if err != nil {
+			t.Fatalf("Failed to set up a domain instance: %+v", err)
+		}
+		err = New(domainAfterRestart, tc.Database()).Load()
+		if err != nil {
+			t.Fatalf("Load: %+v", err)
+		}
+
+		miningManager := domainAfterRestart.MiningManager()
+		transactions, orphans := miningManager.AllTransactions(true, true)
+		if len(transactions) != 1 || !transactions[0].Equal(childTransaction) {
+			t.Fatalf("Expected only the child of the mined transaction in the transaction pool, but got %d "+
+				"transactions", len(transactions))
+		}
+		if len(orphans) != 1 || !orphans[0].Equal(orphanTransaction) {
+			t.Fatalf("Expected only the persisted orphan in the orphan pool, but got %d orphans", len(orphans))
+		}
+
+		highPriorityTransactionIDs := miningManager.HighPriorityTransactionIDs()
+		if len(highPriorityTransactionIDs) != 1 ||
+			!highPriorityTransactionIDs[0].Equal(consensushashing.TransactionID(childTransaction)) {
+			t.Fatalf("Expected only the child of the mined transaction to be high priority, but got %d "+
+				"high priority transactions", len(highPriorityTransactionIDs))
+		}
+	})
+}
@@ -0,0 +1,12 @@
+package mempoolstore
+
+import (
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+)
+
+// mempoolEntry is a persisted mempool transaction
+type mempoolEntry struct {
+	transaction    *externalapi.DomainTransaction
+	isHighPriority bool
+	isOrphan       bool
+}
@@ -0,0 +1,60 @@
+package mempoolstore
+
+import (
+	"io"
+
+	"github.com/kobradag/kobrad/domain/consensus/database/serialization"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// The flags of a mempool entry, which prefix its serialized transaction
+const (
+	highPriorityFlag = 1 << 0
+	orphanFlag       = 1 << 1
+
+	knownFlags = highPriorityFlag | orphanFlag
+)
+
+func serializeMempoolEntry(entry *mempoolEntry) ([]byte, error) {
+	serializedTransaction, err := proto.Marshal(serialization.DomainTransactionToDbTransaction(entry.transaction))
+	if err != nil {
+		return nil, err
+	}
+
+	flags := byte(0)
+	if entry.isHighPriority {
+		flags |= highPriorityFlag
+	}
+	if entry.isOrphan {
+		flags |= orphanFlag
+	}
+	return append([]byte{flags}, serializedTransaction...), nil
+}
+
+func deserializeMempoolEntry(serialized []byte) (*mempoolEntry, error) {
+	if len(serialized) == 0 {
+		return nil, errors.Wrapf(io.ErrUnexpectedEOF, "unexpected empty mempool entry")
+	}
+
+	flags := serialized[0]
+	if flags&^knownFlags != 0 {
+		return nil, errors.Errorf("unknown mempool entry flags %d", flags)
+	}
+
+	dbTransaction := &serialization.DbTransaction{}
+	err := proto.Unmarshal(serialized[1:], dbTransaction)
+	if err != nil {
+		return nil, err
+	}
+	transaction, err := serialization.DbTransactionToDomainTransaction(dbTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mempoolEntry{
+		transaction:    transaction,
+		isHighPriority: flags&highPriorityFlag != 0,
+		isOrphan:       flags&orphanFlag != 0,
+	}, nil
+}
@@ -0,0 +1,110 @@
+package mempoolstore
+
+import (
+	"io"
+	"testing"
+
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/domain/consensus/utils/consensushashing"
+	"github.com/kobradag/kobrad/domain/consensus/utils/subnetworks"
+	"github.com/pkg/errors"
+)
+
+func Test_serializeMempoolEntry(t *testing.T) {
+	transaction := &externalapi.DomainTransaction{
+		Version: 0,
+		Inputs: []*externalapi.DomainTransactionInput{{
+			PreviousOutpoint: externalapi.DomainOutpoint{
+				TransactionID: externalapi.DomainTransactionID{},
+				Index:         1,
+			},
+			SignatureScript: []byte{1, 2, 3},
+			Sequence:        4,
+			SigOpCount:      1,
+		}},
+		Outputs: []*externalapi.DomainTransactionOutput{{
+			Value:           5,
+			ScriptPublicKey: &externalapi.ScriptPublicKey{Script: []byte{6, 7}, Version: 0},
+		}},
+		LockTime:     8,
+		SubnetworkID: subnetworks.SubnetworkIDNative,
+		Payload:      []byte{},
+	}
+
+	for _, isHighPriority := range []bool{false, true} {
+		for _, isOrphan := range []bool{false, true} {
+			entry := &mempoolEntry{transaction: transaction, isHighPriority: isHighPriority, isOrphan: isOrphan}
+			serialized, err := serializeMempoolEntry(entry)
+			if err != nil {
+				t.Fatalf("Failed serializing mempool entry: %v", err)
+			}
+
+			result, err := deserializeMempoolEntry(serialized)
+			if err != nil {
+				t.Fatalf("Failed deserializing mempool entry: %v", err)
+			}
+			if result.isHighPriority != isHighPriority {
+				t.Fatalf("Expected isHighPriority %t but got %t", isHighPriority, result.isHighPriority)
+			}
+			if result.isOrphan != isOrphan {
+				t.Fatalf("Expected isOrphan %t but got %t", isOrphan, result.isOrphan)
+			}
+			if !result.transaction.Equal(transaction) {
+				t.Fatalf("Expected transaction %v but got %v", transaction, result.transaction)
+			}
+		}
+	}
+}
+
+func Test_deserializeMempoolEntryFailure(t *testing.T) {
+	_, err := deserializeMempoolEntry([]byte{})
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("Expected error to be EOF, but got: %v", err)
+	}
+
+	_, err = deserializeMempoolEntry([]byte{4})
+	if err == nil {
+		t.Fatalf("Expected an error for an unknown flag")
+	}
+}
+
+func Test_sortTopologically(t *testing.T) {
+	newTransaction := func(lockTime uint64, parents ...*externalapi.DomainTransaction) *externalapi.DomainTransaction {
+		transaction := &externalapi.DomainTransaction{
+			LockTime:     lockTime,
+			SubnetworkID: subnetworks.SubnetworkIDNative,
+		}
+		for _, parent := range parents {
+			transaction.Inputs = append(transaction.Inputs, &externalapi.DomainTransactionInput{
+				PreviousOutpoint: externalapi.DomainOutpoint{TransactionID: *consensushashing.TransactionID(parent)},
+			})
+		}
+		return transaction
+	}
+
+	grandparent := newTransaction(1)
+	parent1 := newTransaction(2, grandparent)
+	parent2 := newTransaction(3)
+	child := newTransaction(4, parent1, parent2)
+
+	sorted := sortTopologically([]*externalapi.DomainTransaction{child, parent1, grandparent, parent2})
+	if len(sorted) != 4 {
+		t.Fatalf("Expected 4 sorted transactions but got %d", len(sorted))
+	}
+
+	positions := make(map[*externalapi.DomainTransaction]int)
+	for i, transaction := range sorted {
+		positions[transaction] = i
+	}
+	for _, transaction := range sorted {
+		for _, input := range transaction.Inputs {
+			for _, parent := range sorted {
+				if *consensushashing.TransactionID(parent) == input.PreviousOutpoint.TransactionID &&
+					positions[parent] > positions[transaction] {
+					t.Fatalf("Transaction %s is sorted before its parent %s",
+						consensushashing.TransactionID(transaction), consensushashing.TransactionID(parent))
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package mempoolstore
+
+import (
+	"encoding/binary"
+
+	"github.com/kobradag/kobrad/infrastructure/db/database"
+	"github.com/kobradag/kobrad/infrastructure/logger"
+)
+
+var mempoolBucket = database.MakeBucket([]byte("mempool"))
+
+type mempoolStore struct {
+	database database.Database
+}
+
+func newMempoolStore(database database.Database) *mempoolStore {
+	return &mempoolStore{database: database}
+}
+
+// replaceAll replaces all the persisted entries with the given ones.
+// The entries are kept in order, so that transactions are persisted
+// after the transactions they spend.
+func (ms *mempoolStore) replaceAll(entries []*mempoolEntry) error {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "mempoolStore.replaceAll")
+	defer onEnd()
+
+	dbTransaction, err := ms.database.Begin()
+	if err != nil {
+		return err
+	}
+	defer dbTransaction.RollbackUnlessClosed()
+
+	err = ms.deleteAll(dbTransaction)
+	if err != nil {
+		return err
+	}
+
+	for i, entry := range entries {
+		serializedEntry, err := serializeMempoolEntry(entry)
+		if err != nil {
+			return err
+		}
+		err = dbTransaction.Put(ms.convertIndexToKey(uint64(i)), serializedEntry)
+		if err != nil {
+			return err
+		}
+	}
+
+	return dbTransaction.Commit()
+}
+
+func (ms *mempoolStore) deleteAll(dataAccessor database.DataAccessor) error {
+	cursor, err := dataAccessor.Cursor(mempoolBucket)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+
+	for cursor.Next() {
+		key, err := cursor.Key()
+		if err != nil {
+			return err
+		}
+
+		err = dataAccessor.Delete(key)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getAll returns all the persisted entries, in the order they were persisted
+func (ms *mempoolStore) getAll() ([]*mempoolEntry, error) {
+	cursor, err := ms.database.Cursor(mempoolBucket)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	entries := []*mempoolEntry{}
+	for cursor.Next() {
+		serializedEntry, err := cursor.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		entry, err := deserializeMempoolEntry(serializedEntry)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// convertIndexToKey serializes the index as big endian, so that the
+// database iterates over the entries in the order they were persisted
+func (ms *mempoolStore) convertIndexToKey(index uint64) *database.Key {
+	var keyBytes [8]byte
+	binary.BigEndian.PutUint64(keyBytes[:], index)
+	return mempoolBucket.Key(keyBytes[:])
+}
@@ -24,6 +24,10 @@ type candidateTx struct {
 	txValue  float64
 	gasLimit uint64
 
+	// packageMembers are the candidates that have to be selected along with
+	// this one in order to earn txValue. See applyAncestorPackages for details.
+	packageMembers []*candidateTx
+
 	p     float64
 	start float64
 	end   float64
@@ -38,17 +42,20 @@ type blockTemplateBuilder struct {
 	policy             policy
 
 	coinbasePayloadScriptPublicKeyMaxLength uint8
+	coinbasePayeesActivationBlueScore       uint64
 }
 
 // New creates a new blockTemplateBuilder
 func New(consensusReference consensusreference.ConsensusReference, mempool miningmanagerapi.Mempool,
-	blockMaxMass uint64, coinbasePayloadScriptPublicKeyMaxLength uint8) miningmanagerapi.BlockTemplateBuilder {
+	blockMaxMass uint64, coinbasePayloadScriptPublicKeyMaxLength uint8,
+	coinbasePayeesActivationBlueScore uint64) miningmanagerapi.BlockTemplateBuilder {
 	return &blockTemplateBuilder{
 		consensusReference: consensusReference,
 		mempool:            mempool,
 		policy:             policy{BlockMaxMass: blockMaxMass},
 
 		coinbasePayloadScriptPublicKeyMaxLength: coinbasePayloadScriptPublicKeyMaxLength,
+		coinbasePayeesActivationBlueScore:       coinbasePayeesActivationBlueScore,
 	}
 }
 
@@ -134,6 +141,8 @@ func (btb *blockTemplateBuilder) BuildBlockTemplate(
 		})
 	}
 
+	btb.applyAncestorPackages(candidateTxs, btb.mempool.AncestorPackages())
+
 	// Sort the candidate txs by subnetworkID.
 	sort.Slice(candidateTxs, func(i, j int) bool {
 		return subnetworks.Less(candidateTxs[i].SubnetworkID, candidateTxs[j].SubnetworkID)
@@ -174,9 +183,18 @@ func (btb *blockTemplateBuilder) BuildBlockTemplate(
 func (btb *blockTemplateBuilder) ModifyBlockTemplate(newCoinbaseData *consensusexternalapi.DomainCoinbaseData,
 	blockTemplateToModify *consensusexternalapi.DomainBlockTemplate) (*consensusexternalapi.DomainBlockTemplate, error) {
 
+	// The red blocks reward is split to the payees of the block, so if they
+	// aren't a single script on both sides it can't be simply redirected
+	if blockTemplateToModify.CoinbaseHasRedReward &&
+		(len(blockTemplateToModify.CoinbaseData.AdditionalPayees) > 0 || len(newCoinbaseData.AdditionalPayees) > 0) {
+
+		return btb.BuildBlockTemplate(newCoinbaseData)
+	}
+
 	// The first transaction is always the coinbase transaction
 	coinbaseTx := blockTemplateToModify.Block.Transactions[transactionhelper.CoinbaseTransactionIndex]
-	newPayload, err := coinbasemanager.ModifyCoinbasePayload(coinbaseTx.Payload, newCoinbaseData, btb.coinbasePayloadScriptPublicKeyMaxLength)
+	newPayload, err := coinbasemanager.ModifyCoinbasePayload(coinbaseTx.Payload, newCoinbaseData,
+		btb.coinbasePayloadScriptPublicKeyMaxLength, btb.coinbasePayeesActivationBlueScore)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,68 @@
+package blocktemplatebuilder
+
+import (
+	miningmanagerapi "github.com/kobradag/kobrad/domain/miningmanager/model"
+)
+
+const (
+	// priorityBucketBlocks is the amount of blocks within which a transaction
+	// paying the priority fee rate is expected to be included
+	priorityBucketBlocks = 1
+
+	// normalBucketBlocks is the amount of blocks within which a transaction
+	// paying the normal fee rate is expected to be included
+	normalBucketBlocks = 10
+
+	// lowBucketBlocks is the amount of blocks within which a transaction
+	// paying the low fee rate is expected to be included
+	lowBucketBlocks = 60
+
+	// feeRateIncrement is the amount, in leor per gram of mass, by which an
+	// estimated fee rate exceeds the fee rate it has to outbid. It's one leor
+	// per kilogram, the granularity of the minimum relay fee.
+	feeRateIncrement = 0.001
+)
+
+// EstimateFees estimates the fee rates required for a transaction to be
+// included within various amounts of blocks.
+//
+// The estimation assumes that transactions are selected in descending fee rate
+// order until the block mass limit is reached. The actual selection in
+// selectTransactions is probabilistic, but since a transaction's selection
+// probability grows with its fee rate, this is a good approximation.
+func (btb *blockTemplateBuilder) EstimateFees() *miningmanagerapi.FeeEstimate {
+	feeRates, masses := btb.mempool.TransactionFeeRates()
+	minimumFeeRate := btb.mempool.MinimumFeeRate()
+
+	bucket := func(blocks uint64) miningmanagerapi.FeeRateBucket {
+		return miningmanagerapi.FeeRateBucket{
+			FeeRate:         estimateFeeRate(feeRates, masses, blocks*btb.policy.BlockMaxMass, minimumFeeRate),
+			EstimatedBlocks: blocks,
+		}
+	}
+
+	return &miningmanagerapi.FeeEstimate{
+		PriorityBucket: bucket(priorityBucketBlocks),
+		NormalBucket:   bucket(normalBucketBlocks),
+		LowBucket:      bucket(lowBucketBlocks),
+	}
+}
+
+// estimateFeeRate returns the fee rate required to outbid all the transactions
+// that don't fit into the given mass capacity, which is strictly above the fee
+// rate of the first of them, since paying the same fee rate only ties with it.
+// feeRates must be ordered from the highest fee rate to the lowest, and masses
+// must match them by index. If all transactions fit, minimumFeeRate is returned.
+func estimateFeeRate(feeRates []float64, masses []uint64, massCapacity uint64, minimumFeeRate float64) float64 {
+	accumulatedMass := uint64(0)
+	for i, feeRate := range feeRates {
+		accumulatedMass += masses[i]
+		if accumulatedMass > massCapacity {
+			if feeRate < minimumFeeRate {
+				return minimumFeeRate
+			}
+			return feeRate + feeRateIncrement
+		}
+	}
+	return minimumFeeRate
+}
@@ -0,0 +1,65 @@
+package blocktemplatebuilder
+
+import "testing"
+
+func TestEstimateFeeRate(t *testing.T) {
+	const minimumFeeRate = 1.0
+
+	tests := []struct {
+		name            string
+		feeRates        []float64
+		masses          []uint64
+		massCapacity    uint64
+		expectedFeeRate float64
+	}{
+		{
+			name:            "empty mempool",
+			feeRates:        nil,
+			masses:          nil,
+			massCapacity:    1000,
+			expectedFeeRate: minimumFeeRate,
+		},
+		{
+			name:            "all transactions fit",
+			feeRates:        []float64{10, 5, 2},
+			masses:          []uint64{300, 300, 300},
+			massCapacity:    1000,
+			expectedFeeRate: minimumFeeRate,
+		},
+		{
+			name:            "capacity exceeded",
+			feeRates:        []float64{10, 5, 2},
+			masses:          []uint64{500, 400, 300},
+			massCapacity:    1000,
+			expectedFeeRate: 2 + feeRateIncrement,
+		},
+		{
+			name:            "capacity exceeded by the first transaction",
+			feeRates:        []float64{10, 5, 2},
+			masses:          []uint64{1500, 400, 300},
+			massCapacity:    1000,
+			expectedFeeRate: 10 + feeRateIncrement,
+		},
+		{
+			name:            "boundary fee rate equal to minimum",
+			feeRates:        []float64{10, minimumFeeRate},
+			masses:          []uint64{800, 400},
+			massCapacity:    1000,
+			expectedFeeRate: minimumFeeRate + feeRateIncrement,
+		},
+		{
+			name:            "boundary fee rate below minimum",
+			feeRates:        []float64{10, 0.5},
+			masses:          []uint64{800, 400},
+			massCapacity:    1000,
+			expectedFeeRate: minimumFeeRate,
+		},
+	}
+
+	for _, test := range tests {
+		feeRate := estimateFeeRate(test.feeRates, test.masses, test.massCapacity, minimumFeeRate)
+		if feeRate != test.expectedFeeRate {
+			t.Errorf("%s: expected fee rate %f but got %f", test.name, test.expectedFeeRate, feeRate)
+		}
+	}
+}
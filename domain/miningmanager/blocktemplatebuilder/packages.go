@@ -0,0 +1,68 @@
+package blocktemplatebuilder
+
+import (
+	consensusexternalapi "github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/domain/consensus/utils/consensushashing"
+	miningmanagerapi "github.com/kobradag/kobrad/domain/miningmanager/model"
+)
+
+// applyAncestorPackages makes child-pays-for-parent work by valuing every
+// candidate transaction according to the best paying ancestor package it is
+// part of, when that package pays more than the transaction itself.
+//
+// A block may not contain a transaction alongside the transaction whose
+// outputs it spends, so the only members of a package that can be selected
+// into the next block are its ready transactions - the ones that don't
+// depend on other mempool transactions. Those are the candidates here, and
+// they are marked to be selected together so that the remainder of the
+// package becomes minable in the following blocks.
+func (btb *blockTemplateBuilder) applyAncestorPackages(candidateTxs []*candidateTx,
+	packages []*miningmanagerapi.TransactionPackage) {
+
+	candidateTxsByID := make(map[consensusexternalapi.DomainTransactionID]*candidateTx, len(candidateTxs))
+	for _, candidateTx := range candidateTxs {
+		candidateTxsByID[*consensushashing.TransactionID(candidateTx.DomainTransaction)] = candidateTx
+	}
+
+	for _, transactionPackage := range packages {
+		members, ok := packageCandidates(candidateTxsByID, transactionPackage)
+		if !ok {
+			continue
+		}
+
+		packageValue := btb.calcPackageValue(transactionPackage)
+		for _, member := range members {
+			if packageValue > member.txValue {
+				member.txValue = packageValue
+				member.packageMembers = members
+			}
+		}
+	}
+}
+
+// packageCandidates returns the candidates corresponding to the ready transactions
+// of the given package. If any of them is not a candidate, the package can't be
+// mined and false is returned.
+func packageCandidates(candidateTxsByID map[consensusexternalapi.DomainTransactionID]*candidateTx,
+	transactionPackage *miningmanagerapi.TransactionPackage) ([]*candidateTx, bool) {
+
+	if len(transactionPackage.ReadyTransactionIDs) == 0 {
+		return nil, false
+	}
+
+	members := make([]*candidateTx, 0, len(transactionPackage.ReadyTransactionIDs))
+	for _, transactionID := range transactionPackage.ReadyTransactionIDs {
+		member, ok := candidateTxsByID[*transactionID]
+		if !ok {
+			return nil, false
+		}
+		members = append(members, member)
+	}
+	return members, true
+}
+
+// calcPackageValue calculates the value of an ancestor package the same way
+// calcTxValue does for a single native transaction
+func (btb *blockTemplateBuilder) calcPackageValue(transactionPackage *miningmanagerapi.TransactionPackage) float64 {
+	return transactionPackage.FeeRate() * float64(btb.policy.BlockMaxMass)
+}
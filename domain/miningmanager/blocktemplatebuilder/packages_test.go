@@ -0,0 +1,143 @@
+package blocktemplatebuilder
+
+import (
+	"testing"
+
+	consensusexternalapi "github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/domain/consensus/utils/consensushashing"
+	"github.com/kobradag/kobrad/domain/consensus/utils/subnetworks"
+	miningmanagerapi "github.com/kobradag/kobrad/domain/miningmanager/model"
+)
+
+func newTestCandidateTx(btb *blockTemplateBuilder, fee uint64, mass uint64, lockTime uint64) *candidateTx {
+	tx := &consensusexternalapi.DomainTransaction{
+		Inputs:       []*consensusexternalapi.DomainTransactionInput{},
+		Outputs:      []*consensusexternalapi.DomainTransactionOutput{},
+		LockTime:     lockTime, // Makes the ID of every test transaction unique
+		SubnetworkID: subnetworks.SubnetworkIDNative,
+		Fee:          fee,
+		Mass:         mass,
+	}
+	return &candidateTx{
+		DomainTransaction: tx,
+		txValue:           btb.calcTxValue(tx),
+	}
+}
+
+func TestApplyAncestorPackages(t *testing.T) {
+	btb := &blockTemplateBuilder{policy: policy{BlockMaxMass: 10_000}}
+
+	parent1 := newTestCandidateTx(btb, 100, 1000, 1)
+	parent2 := newTestCandidateTx(btb, 100, 1000, 2)
+	unrelated := newTestCandidateTx(btb, 5000, 1000, 3)
+	candidateTxs := []*candidateTx{parent1, parent2, unrelated}
+
+	parent1ID := consensushashing.TransactionID(parent1.DomainTransaction)
+	parent2ID := consensushashing.TransactionID(parent2.DomainTransaction)
+	unrelatedID := consensushashing.TransactionID(unrelated.DomainTransaction)
+	missingID := consensushashing.TransactionID(newTestCandidateTx(btb, 1, 1, 4).DomainTransaction)
+
+	highFeeChildPackage := &miningmanagerapi.TransactionPackage{
+		ReadyTransactionIDs: []*consensusexternalapi.DomainTransactionID{parent1ID, parent2ID},
+		Fee:                 100 + 100 + 20_000,
+		Mass:                1000 + 1000 + 1000,
+	}
+	lowFeeChildPackage := &miningmanagerapi.TransactionPackage{
+		ReadyTransactionIDs: []*consensusexternalapi.DomainTransactionID{unrelatedID},
+		Fee:                 5000 + 10,
+		Mass:                1000 + 1000,
+	}
+	missingParentPackage := &miningmanagerapi.TransactionPackage{
+		ReadyTransactionIDs: []*consensusexternalapi.DomainTransactionID{parent1ID, missingID},
+		Fee:                 1_000_000,
+		Mass:                2000,
+	}
+
+	unrelatedValue := unrelated.txValue
+	btb.applyAncestorPackages(candidateTxs,
+		[]*miningmanagerapi.TransactionPackage{highFeeChildPackage, lowFeeChildPackage, missingParentPackage})
+
+	expectedPackageValue := btb.calcPackageValue(highFeeChildPackage)
+	for _, parent := range []*candidateTx{parent1, parent2} {
+		if parent.txValue != expectedPackageValue {
+			t.Errorf("Expected parent value to be raised to %f, but got %f", expectedPackageValue, parent.txValue)
+		}
+		if len(parent.packageMembers) != 2 {
+			t.Fatalf("Expected parent to have 2 package members, but got %d", len(parent.packageMembers))
+		}
+	}
+
+	if unrelated.txValue != unrelatedValue {
+		t.Errorf("Expected the value of the unrelated transaction to remain %f, but got %f",
+			unrelatedValue, unrelated.txValue)
+	}
+	if unrelated.packageMembers != nil {
+		t.Errorf("Expected the unrelated transaction to have no package members")
+	}
+}
+
+func TestSelectTransactionsWithPackages(t *testing.T) {
+	btb := &blockTemplateBuilder{policy: policy{BlockMaxMass: 2000}}
+
+	parent1 := newTestCandidateTx(btb, 100, 1000, 1)
+	parent2 := newTestCandidateTx(btb, 100, 1000, 2)
+	packageMembers := []*candidateTx{parent1, parent2}
+	parent1.packageMembers = packageMembers
+	parent2.packageMembers = packageMembers
+
+	selected := btb.selectTransactions([]*candidateTx{parent1, parent2})
+	if len(selected.selectedTxs) != 2 {
+		t.Fatalf("Expected both package members to be selected, but got %d transactions",
+			len(selected.selectedTxs))
+	}
+	if selected.totalMass != 2000 || selected.totalFees != 200 {
+		t.Errorf("Unexpected totals: mass %d, fees %d", selected.totalMass, selected.totalFees)
+	}
+
+	// A package that doesn't fit should be skipped as a whole, without
+	// preventing other transactions from being selected
+	btb.policy.BlockMaxMass = 1500
+	for _, member := range packageMembers {
+		member.isMarkedForDeletion = false
+	}
+	unrelated := newTestCandidateTx(btb, 5000, 1000, 3)
+
+	selected = btb.selectTransactions([]*candidateTx{parent1, parent2, unrelated})
+	if len(selected.selectedTxs) != 1 || selected.selectedTxs[0] != unrelated.DomainTransaction {
+		t.Fatalf("Expected only the unrelated transaction to be selected, but got %d transactions",
+			len(selected.selectedTxs))
+	}
+}
+
+func TestSelectTransactionsWithPackagesGasLimit(t *testing.T) {
+	btb := &blockTemplateBuilder{policy: policy{BlockMaxMass: 10_000}}
+
+	subnetworkID := consensusexternalapi.DomainSubnetworkID{0x10}
+	newSubnetworkCandidateTx := func(gas uint64, lockTime uint64) *candidateTx {
+		candidate := newTestCandidateTx(btb, 100, 1000, lockTime)
+		candidate.SubnetworkID = subnetworkID
+		candidate.Gas = gas
+		candidate.gasLimit = 100
+		return candidate
+	}
+
+	// Every transaction of the package fits the gas limit on its own, but
+	// the package as a whole doesn't
+	parent := newSubnetworkCandidateTx(60, 1)
+	child := newSubnetworkCandidateTx(60, 2)
+	packageMembers := []*candidateTx{parent, child}
+	parent.packageMembers = packageMembers
+	child.packageMembers = packageMembers
+	single := newSubnetworkCandidateTx(50, 3)
+	native := newTestCandidateTx(btb, 100, 1000, 4)
+
+	selected := btb.selectTransactions([]*candidateTx{native, parent, child, single})
+	if len(selected.selectedTxs) != 2 {
+		t.Fatalf("Expected 2 transactions to be selected, but got %d", len(selected.selectedTxs))
+	}
+	for _, selectedTx := range selected.selectedTxs {
+		if selectedTx != native.DomainTransaction && selectedTx != single.DomainTransaction {
+			t.Fatalf("Unexpected selected transaction %s", consensushashing.TransactionID(selectedTx))
+		}
+	}
+}
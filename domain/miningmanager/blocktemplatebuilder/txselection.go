@@ -99,10 +99,27 @@ func (btb *blockTemplateBuilder) selectTransactions(candidateTxs []*candidateTx)
 		}
 		tx := selectedTx.DomainTransaction
 
+		// A transaction valued by its ancestor package is selected along with
+		// the rest of the package's candidates
+		selectedGroup := selectedTxGroup(selectedTx)
+		groupMass := uint64(0)
+		for _, groupTx := range selectedGroup {
+			groupMass += groupTx.Mass
+		}
+
 		// Enforce maximum transaction mass per block. Also check
 		// for overflow.
-		if txsForBlockTemplate.totalMass+selectedTx.Mass < txsForBlockTemplate.totalMass ||
-			txsForBlockTemplate.totalMass+selectedTx.Mass > btb.policy.BlockMaxMass {
+		if txsForBlockTemplate.totalMass+groupMass < txsForBlockTemplate.totalMass ||
+			txsForBlockTemplate.totalMass+groupMass > btb.policy.BlockMaxMass {
+			if len(selectedGroup) > 1 {
+				// Smaller packages or single transactions might still fit
+				log.Tracef("The package of tx %s would exceed the max block mass. "+
+					"As such, skipping it.", consensushashing.TransactionID(tx))
+				for _, groupTx := range selectedGroup {
+					markCandidateTxForDeletion(groupTx)
+				}
+				continue
+			}
 			log.Tracef("Tx %s would exceed the max block mass. "+
 				"As such, stopping.", consensushashing.TransactionID(tx))
 			break
@@ -110,46 +127,52 @@ func (btb *blockTemplateBuilder) selectTransactions(candidateTxs []*candidateTx)
 
 		// Enforce maximum gas per subnetwork per block. Also check
 		// for overflow.
-		if !subnetworks.IsBuiltInOrNative(tx.SubnetworkID) {
-			subnetworkID := tx.SubnetworkID
-			gasUsage, ok := gasUsageMap[subnetworkID]
-			if !ok {
-				gasUsage = 0
-			}
-			txGas := tx.Gas
-			if gasUsage+txGas < gasUsage ||
-				gasUsage+txGas > selectedTx.gasLimit {
-				log.Tracef("Tx %s would exceed the gas limit in "+
-					"subnetwork %s. Removing all remaining txs from this "+
-					"subnetwork.",
-					consensushashing.TransactionID(tx), subnetworkID)
-				for _, candidateTx := range candidateTxs {
-					// candidateTxs are ordered by subnetwork, so we can safely assume
-					// that transactions after subnetworkID will not be relevant.
-					if subnetworks.Less(subnetworkID, candidateTx.SubnetworkID) {
-						break
-					}
-
-					if candidateTx.SubnetworkID == subnetworkID {
-						markCandidateTxForDeletion(candidateTx)
-					}
+		groupGasUsage, exceedingTx := groupGasUsage(selectedGroup, gasUsageMap)
+		if exceedingTx != nil {
+			subnetworkID := exceedingTx.SubnetworkID
+			if len(selectedGroup) > 1 {
+				// Smaller packages or single transactions might still fit
+				log.Tracef("The package of tx %s would exceed the gas limit in "+
+					"subnetwork %s. As such, skipping it.", consensushashing.TransactionID(tx), subnetworkID)
+				for _, groupTx := range selectedGroup {
+					markCandidateTxForDeletion(groupTx)
 				}
 				continue
 			}
-			gasUsageMap[subnetworkID] = gasUsage + txGas
+			log.Tracef("Tx %s would exceed the gas limit in "+
+				"subnetwork %s. Removing all remaining txs from this "+
+				"subnetwork.",
+				consensushashing.TransactionID(tx), subnetworkID)
+			for _, candidateTx := range candidateTxs {
+				// candidateTxs are ordered by subnetwork, so we can safely assume
+				// that transactions after subnetworkID will not be relevant.
+				if subnetworks.Less(subnetworkID, candidateTx.SubnetworkID) {
+					break
+				}
+
+				if candidateTx.SubnetworkID == subnetworkID {
+					markCandidateTxForDeletion(candidateTx)
+				}
+			}
+			continue
+		}
+		for subnetworkID, gasUsage := range groupGasUsage {
+			gasUsageMap[subnetworkID] = gasUsage
 		}
 
 		// Add the transaction to the result, increment counters, and
 		// save the masses, fees, and signature operation counts to the
 		// result.
-		selectedTxs = append(selectedTxs, selectedTx)
-		txsForBlockTemplate.totalMass += selectedTx.Mass
-		txsForBlockTemplate.totalFees += selectedTx.Fee
+		for _, groupTx := range selectedGroup {
+			selectedTxs = append(selectedTxs, groupTx)
+			txsForBlockTemplate.totalMass += groupTx.Mass
+			txsForBlockTemplate.totalFees += groupTx.Fee
 
-		log.Tracef("Adding tx %s (feePerMegaGram %d)",
-			consensushashing.TransactionID(tx), selectedTx.Fee*1e6/selectedTx.Mass)
+			log.Tracef("Adding tx %s (feePerMegaGram %d)",
+				consensushashing.TransactionID(groupTx.DomainTransaction), groupTx.Fee*1e6/groupTx.Mass)
 
-		markCandidateTxForDeletion(selectedTx)
+			markCandidateTxForDeletion(groupTx)
+		}
 	}
 
 	sort.Slice(selectedTxs, func(i, j int) bool {
@@ -163,6 +186,46 @@ func (btb *blockTemplateBuilder) selectTransactions(candidateTxs []*candidateTx)
 	return txsForBlockTemplate
 }
 
+// groupGasUsage returns the gas usage per subnetwork after adding the given
+// group of transactions to the gas usage in gasUsageMap, which is left
+// unchanged. If any transaction in the group exceeds the gas limit of its
+// subnetwork, it's returned as exceedingTx.
+func groupGasUsage(group []*candidateTx, gasUsageMap map[consensusexternalapi.DomainSubnetworkID]uint64) (
+	groupGasUsage map[consensusexternalapi.DomainSubnetworkID]uint64, exceedingTx *candidateTx) {
+
+	groupGasUsage = make(map[consensusexternalapi.DomainSubnetworkID]uint64)
+	for _, groupTx := range group {
+		if subnetworks.IsBuiltInOrNative(groupTx.SubnetworkID) {
+			continue
+		}
+		subnetworkID := groupTx.SubnetworkID
+		gasUsage, ok := groupGasUsage[subnetworkID]
+		if !ok {
+			gasUsage = gasUsageMap[subnetworkID]
+		}
+		txGas := groupTx.Gas
+		if gasUsage+txGas < gasUsage ||
+			gasUsage+txGas > groupTx.gasLimit {
+			return nil, groupTx
+		}
+		groupGasUsage[subnetworkID] = gasUsage + txGas
+	}
+	return groupGasUsage, nil
+}
+
+// selectedTxGroup returns the given candidate along with the members of its
+// ancestor package that were not yet selected
+func selectedTxGroup(selectedTx *candidateTx) []*candidateTx {
+	group := []*candidateTx{selectedTx}
+	for _, member := range selectedTx.packageMembers {
+		if member == selectedTx || member.isMarkedForDeletion {
+			continue
+		}
+		group = append(group, member)
+	}
+	return group
+}
+
 func rebalanceCandidates(oldCandidateTxs []*candidateTx, isFirstRun bool) (
 	candidateTxs []*candidateTx, totalP float64) {
 
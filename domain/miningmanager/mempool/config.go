@@ -23,6 +23,12 @@ const (
 	// removeOrphans when removeRedeemers = true
 	defaultMaximumOrphanTransactionCount = 50
 
+	// defaultMaximumAncestorCount and defaultMaximumAncestorMass limit the ancestors a transaction
+	// may have in the mempool, which bounds the work of collecting the ancestor packages of the
+	// mempool whenever a block template is built
+	defaultMaximumAncestorCount = 25
+	defaultMaximumAncestorMass  = 100000
+
 	// defaultMinimumRelayTransactionFee specifies the minimum transaction fee for a transaction to be accepted to
 	// the mempool and relayed. It is specified in leor per 1kg (or 1000 grams) of transaction mass.
 	defaultMinimumRelayTransactionFee = util.Amount(1000)
@@ -45,6 +51,8 @@ type Config struct {
 	OrphanExpireScanIntervalDAAScore      uint64
 	MaximumOrphanTransactionMass          uint64
 	MaximumOrphanTransactionCount         uint64
+	MaximumAncestorCount                  uint64
+	MaximumAncestorMass                   uint64
 	AcceptNonStandard                     bool
 	MaximumMassPerBlock                   uint64
 	MinimumRelayTransactionFee            util.Amount
@@ -65,6 +73,8 @@ func DefaultConfig(dagParams *dagconfig.Params) *Config {
 		OrphanExpireScanIntervalDAAScore:      uint64(float64(defaultOrphanExpireScanIntervalSeconds) / targetBlocksPerSecond),
 		MaximumOrphanTransactionMass:          defaultMaximumOrphanTransactionMass,
 		MaximumOrphanTransactionCount:         defaultMaximumOrphanTransactionCount,
+		MaximumAncestorCount:                  defaultMaximumAncestorCount,
+		MaximumAncestorMass:                   defaultMaximumAncestorMass,
 		AcceptNonStandard:                     dagParams.RelayNonStdTxs,
 		MaximumMassPerBlock:                   dagParams.MaxBlockMass,
 		MinimumRelayTransactionFee:            defaultMinimumRelayTransactionFee,
@@ -44,6 +44,7 @@ const (
 	RejectObsolete        RejectCode = 0x11
 	RejectDuplicate       RejectCode = 0x12
 	RejectNotRequested    RejectCode = 0x13
+	RejectDoubleSpend     RejectCode = 0x14
 	RejectNonstandard     RejectCode = 0x40
 	RejectDust            RejectCode = 0x41
 	RejectInsufficientFee RejectCode = 0x42
@@ -66,6 +67,7 @@ var rejectCodeStrings = map[RejectCode]string{
 	RejectFinality:        "REJECT_FINALITY",
 	RejectDifficulty:      "REJECT_DIFFICULTY",
 	RejectNotRequested:    "REJECT_NOT_REQUESTED",
+	RejectDoubleSpend:     "REJECT_DOUBLE_SPEND",
 	RejectImmatureSpend:   "REJECT_IMMATURE_SPEND",
 	RejectBadOrphan:       "REJECT_BAD_ORPHAN",
 }
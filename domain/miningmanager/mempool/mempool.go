@@ -44,7 +44,28 @@ func (mp *mempool) ValidateAndInsertTransaction(transaction *externalapi.DomainT
 	mp.mtx.Lock()
 	defer mp.mtx.Unlock()
 
-	return mp.validateAndInsertTransaction(transaction, isHighPriority, allowOrphan)
+	acceptedTransactions, err = mp.validateAndInsertTransaction(transaction, isHighPriority, allowOrphan)
+	if err != nil {
+		transactionsRejected.Inc()
+		return nil, err
+	}
+	transactionsAccepted.Add(uint64(len(acceptedTransactions)))
+	return acceptedTransactions, nil
+}
+
+func (mp *mempool) ValidateAndInsertTransactionReplacement(transaction *externalapi.DomainTransaction, isHighPriority bool) (
+	acceptedTransactions []*externalapi.DomainTransaction, replacedTransactions []*externalapi.DomainTransaction, err error) {
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	acceptedTransactions, replacedTransactions, err = mp.validateAndInsertTransactionReplacement(transaction, isHighPriority)
+	if err != nil {
+		transactionsRejected.Inc()
+		return nil, nil, err
+	}
+	transactionsAccepted.Add(uint64(len(acceptedTransactions)))
+	return acceptedTransactions, replacedTransactions, nil
 }
 
 func (mp *mempool) GetTransaction(transactionID *externalapi.DomainTransactionID,
@@ -132,6 +153,17 @@ func (mp *mempool) TransactionCount(includeTransactionPool bool, includeOrphanPo
 	return transactionCount
 }
 
+func (mp *mempool) TransactionFeeRates() (feeRates []float64, masses []uint64) {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	return mp.transactionsPool.feeRates()
+}
+
+func (mp *mempool) MinimumFeeRate() float64 {
+	return float64(mp.config.MinimumRelayTransactionFee) / 1000
+}
+
 func (mp *mempool) HandleNewBlockTransactions(transactions []*externalapi.DomainTransaction) (
 	acceptedOrphans []*externalapi.DomainTransaction, err error) {
 
@@ -198,6 +230,13 @@ func (mp *mempool) BlockCandidateTransactions() []*externalapi.DomainTransaction
 	return candidateTxs
 }
 
+func (mp *mempool) AncestorPackages() []*miningmanagermodel.TransactionPackage {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	return mp.transactionsPool.ancestorPackages()
+}
+
 func (mp *mempool) RevalidateHighPriorityTransactions() (validTransactions []*externalapi.DomainTransaction, err error) {
 	mp.mtx.Lock()
 	defer mp.mtx.Unlock()
@@ -205,6 +244,23 @@ func (mp *mempool) RevalidateHighPriorityTransactions() (validTransactions []*ex
 	return mp.revalidateHighPriorityTransactions()
 }
 
+func (mp *mempool) HighPriorityTransactionIDs() []*externalapi.DomainTransactionID {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	highPriorityTransactionIDs := make([]*externalapi.DomainTransactionID, 0,
+		len(mp.transactionsPool.highPriorityTransactions))
+	for _, transaction := range mp.transactionsPool.highPriorityTransactions {
+		highPriorityTransactionIDs = append(highPriorityTransactionIDs, transaction.TransactionID())
+	}
+	for _, orphan := range mp.orphansPool.allOrphans {
+		if orphan.IsHighPriority() {
+			highPriorityTransactionIDs = append(highPriorityTransactionIDs, orphan.TransactionID())
+		}
+	}
+	return highPriorityTransactionIDs
+}
+
 func (mp *mempool) RemoveInvalidTransactions(err *ruleerrors.ErrInvalidTransactionsInNewBlock) error {
 	mp.mtx.Lock()
 	defer mp.mtx.Unlock()
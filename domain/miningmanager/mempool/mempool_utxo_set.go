@@ -65,6 +65,18 @@ func (mpus *mempoolUTXOSet) removeTransaction(transaction *model.MempoolTransact
 	}
 }
 
+// conflictingTransactions returns the transactions in the mempool
+// that spend any of the outpoints spent by the given transaction
+func (mpus *mempoolUTXOSet) conflictingTransactions(transaction *externalapi.DomainTransaction) model.IDToTransactionMap {
+	conflictingTransactions := model.IDToTransactionMap{}
+	for _, input := range transaction.Inputs {
+		if existingTransaction, exists := mpus.transactionByPreviousOutpoint[input.PreviousOutpoint]; exists {
+			conflictingTransactions[*existingTransaction.TransactionID()] = existingTransaction
+		}
+	}
+	return conflictingTransactions
+}
+
 func (mpus *mempoolUTXOSet) checkDoubleSpends(transaction *externalapi.DomainTransaction) error {
 	outpoint := externalapi.DomainOutpoint{TransactionID: *consensushashing.TransactionID(transaction)}
 
@@ -74,7 +86,7 @@ func (mpus *mempoolUTXOSet) checkDoubleSpends(transaction *externalapi.DomainTra
 		if existingTransaction, exists := mpus.transactionByPreviousOutpoint[input.PreviousOutpoint]; exists {
 			str := fmt.Sprintf("output %s already spent by transaction %s in the memory pool",
 				input.PreviousOutpoint, existingTransaction.TransactionID())
-			return transactionRuleError(RejectDuplicate, str)
+			return transactionRuleError(RejectDoubleSpend, str)
 		}
 	}
 
@@ -0,0 +1,16 @@
+package mempool
+
+import (
+	"github.com/kobradag/kobrad/infrastructure/metrics"
+)
+
+var (
+	mempoolTransactionCount = metrics.NewGauge("kobrad_mempool_transactions",
+		"The number of transactions in the transaction pool")
+	mempoolOrphanCount = metrics.NewGauge("kobrad_mempool_orphans",
+		"The number of transactions in the orphan pool")
+	transactionsAccepted = metrics.NewCounter("kobrad_mempool_accepted_transactions_total",
+		"The number of transactions accepted to the mempool, including unorphaned ones")
+	transactionsRejected = metrics.NewCounter("kobrad_mempool_rejected_transactions_total",
+		"The number of transactions rejected by the mempool")
+)
@@ -16,6 +16,11 @@ func (tobf *TransactionsOrderedByFeeRate) GetByIndex(index int) *MempoolTransact
 	return tobf.slice[index]
 }
 
+// Len returns the number of transactions in the set
+func (tobf *TransactionsOrderedByFeeRate) Len() int {
+	return len(tobf.slice)
+}
+
 // Push inserts a transaction into the set, placing it in the correct place to preserve order
 func (tobf *TransactionsOrderedByFeeRate) Push(transaction *MempoolTransaction) error {
 	index, _, err := tobf.findTransactionIndex(transaction)
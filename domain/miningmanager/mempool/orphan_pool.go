@@ -127,6 +127,7 @@ func (op *orphansPool) addOrphan(transaction *externalapi.DomainTransaction, isH
 	orphanTransaction := model.NewOrphanTransaction(transaction, isHighPriority, virtualDAAScore)
 
 	op.allOrphans[*orphanTransaction.TransactionID()] = orphanTransaction
+	mempoolOrphanCount.Set(int64(len(op.allOrphans)))
 	for _, input := range transaction.Inputs {
 		op.orphansByPreviousOutpoint[input.PreviousOutpoint] = orphanTransaction
 	}
@@ -209,13 +210,19 @@ func (op *orphansPool) unorphanTransaction(transaction *model.OrphanTransaction)
 		return err
 	}
 
+	parentTransactionsInPool := op.mempool.transactionsPool.getParentTransactionsInPool(transaction.Transaction())
+	err = op.mempool.checkAncestorLimits(transaction.Transaction(), parentTransactionsInPool)
+	if err != nil {
+		return err
+	}
+
 	virtualDAAScore, err := op.mempool.consensusReference.Consensus().GetVirtualDAAScore()
 	if err != nil {
 		return err
 	}
 	mempoolTransaction := model.NewMempoolTransaction(
 		transaction.Transaction(),
-		op.mempool.transactionsPool.getParentTransactionsInPool(transaction.Transaction()),
+		parentTransactionsInPool,
 		false,
 		virtualDAAScore,
 	)
@@ -234,6 +241,7 @@ func (op *orphansPool) removeOrphan(orphanTransactionID *externalapi.DomainTrans
 	}
 
 	delete(op.allOrphans, *orphanTransactionID)
+	mempoolOrphanCount.Set(int64(len(op.allOrphans)))
 
 	for i, input := range orphanTransaction.Transaction().Inputs {
 		if _, ok := op.orphansByPreviousOutpoint[input.PreviousOutpoint]; !ok {
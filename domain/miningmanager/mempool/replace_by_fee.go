@@ -0,0 +1,159 @@
+package mempool
+
+import (
+	"fmt"
+
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/domain/consensus/utils/consensushashing"
+	"github.com/kobradag/kobrad/domain/miningmanager/mempool/model"
+	"github.com/kobradag/kobrad/infrastructure/logger"
+)
+
+// validateAndInsertTransactionReplacement inserts the given transaction into the mempool in place
+// of the transactions it double spends.
+//
+// A replacement is accepted only if it double spends at least one transaction in the mempool, pays
+// a strictly higher fee rate than every transaction it double spends, and pays a strictly higher total
+// fee than all the transactions it evicts, which are the double spent transactions and their redeemers.
+func (mp *mempool) validateAndInsertTransactionReplacement(transaction *externalapi.DomainTransaction,
+	isHighPriority bool) (acceptedTransactions []*externalapi.DomainTransaction,
+	replacedTransactions []*externalapi.DomainTransaction, err error) {
+
+	transactionID := consensushashing.TransactionID(transaction)
+	onEnd := logger.LogAndMeasureExecutionTime(log,
+		fmt.Sprintf("validateAndInsertTransactionReplacement %s", transactionID))
+	defer onEnd()
+
+	mp.consensusReference.Consensus().PopulateMass(transaction)
+
+	err = mp.validateTransactionInIsolation(transaction)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	conflictingTransactions := mp.mempoolUTXOSet.conflictingTransactions(transaction)
+	if len(conflictingTransactions) == 0 {
+		str := fmt.Sprintf("transaction %s does not double spend any transaction in the mempool", transactionID)
+		return nil, nil, transactionRuleError(RejectInvalid, str)
+	}
+	evictedTransactions := mp.transactionsToEvict(conflictingTransactions)
+
+	parentsInPool, missingOutpoints, err := mp.fillInputsAndGetMissingParents(transaction)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(missingOutpoints) > 0 {
+		str := fmt.Sprintf("transaction replacement %s is an orphan", transactionID)
+		return nil, nil, transactionRuleError(RejectBadOrphan, str)
+	}
+	for parentID := range parentsInPool {
+		// The replacement would be an orphan once the transactions it replaces are evicted
+		if _, ok := evictedTransactions[parentID]; ok {
+			str := fmt.Sprintf("transaction replacement %s spends transaction %s which it replaces",
+				transactionID, &parentID)
+			return nil, nil, transactionRuleError(RejectBadOrphan, str)
+		}
+	}
+
+	err = mp.validateTransactionInContext(transaction)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = mp.checkAncestorLimits(transaction, parentsInPool)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = checkReplacementFees(transaction, conflictingTransactions, evictedTransactions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Everything that can fail is done before the transactions are evicted,
+	// so that a failure never leaves them evicted with no replacement in their place
+	virtualDAAScore, err := mp.consensusReference.Consensus().GetVirtualDAAScore()
+	if err != nil {
+		return nil, nil, err
+	}
+	mempoolTransaction := model.NewMempoolTransaction(transaction, parentsInPool, isHighPriority, virtualDAAScore)
+
+	replacedTransactions = make([]*externalapi.DomainTransaction, 0, len(evictedTransactions))
+	for _, evictedTransaction := range evictedTransactions {
+		replacedTransactions = append(replacedTransactions, evictedTransaction.Transaction().Clone()) //this pointer leaves the mempool, hence we clone.
+	}
+	for _, conflictingTransaction := range conflictingTransactions {
+		log.Debugf("Replacing transaction %s with transaction %s",
+			conflictingTransaction.TransactionID(), transactionID)
+		err = mp.removeTransaction(conflictingTransaction.TransactionID(), true)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// This can only fail if the fee or the mass of the transaction aren't populated,
+	// which they are once it has been validated
+	err = mp.transactionsPool.addMempoolTransaction(mempoolTransaction)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	acceptedOrphans, err := mp.orphansPool.processOrphansAfterAcceptedTransaction(mempoolTransaction.Transaction())
+	if err != nil {
+		// The replacement is already in place of the transactions it replaces,
+		// so failing to unorphan its redeemers doesn't undo it
+		log.Warnf("Failed to process the orphans of transaction replacement %s: %s", transactionID, err)
+		acceptedOrphans = nil
+	}
+
+	acceptedTransactions = append([]*externalapi.DomainTransaction{transaction.Clone()}, acceptedOrphans...) //these pointer leave the mempool, hence we clone.
+
+	err = mp.transactionsPool.limitTransactionCount()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return acceptedTransactions, replacedTransactions, nil
+}
+
+// transactionsToEvict returns the given conflicting transactions along with all their redeemers
+func (mp *mempool) transactionsToEvict(conflictingTransactions model.IDToTransactionMap) model.IDToTransactionMap {
+	evictedTransactions := model.IDToTransactionMap{}
+	for conflictingTransactionID, conflictingTransaction := range conflictingTransactions {
+		evictedTransactions[conflictingTransactionID] = conflictingTransaction
+		for _, redeemer := range mp.transactionsPool.getRedeemers(conflictingTransaction) {
+			evictedTransactions[*redeemer.TransactionID()] = redeemer
+		}
+	}
+	return evictedTransactions
+}
+
+func checkReplacementFees(transaction *externalapi.DomainTransaction,
+	conflictingTransactions model.IDToTransactionMap, evictedTransactions model.IDToTransactionMap) error {
+
+	transactionID := consensushashing.TransactionID(transaction)
+	feeRate := float64(transaction.Fee) / float64(transaction.Mass)
+	for _, conflictingTransaction := range conflictingTransactions {
+		conflictingFeeRate := float64(conflictingTransaction.Transaction().Fee) /
+			float64(conflictingTransaction.Transaction().Mass)
+		if feeRate <= conflictingFeeRate {
+			str := fmt.Sprintf("transaction replacement %s has a fee rate of %f, which is not higher than "+
+				"the fee rate %f of replaced transaction %s", transactionID, feeRate, conflictingFeeRate,
+				conflictingTransaction.TransactionID())
+			return transactionRuleError(RejectInsufficientFee, str)
+		}
+	}
+
+	evictedFees := uint64(0)
+	for _, evictedTransaction := range evictedTransactions {
+		evictedFees += evictedTransaction.Transaction().Fee
+	}
+	if transaction.Fee <= evictedFees {
+		str := fmt.Sprintf("transaction replacement %s has a fee of %d, which is not higher than "+
+			"the total fee %d of the %d transactions it replaces", transactionID, transaction.Fee, evictedFees,
+			len(evictedTransactions))
+		return transactionRuleError(RejectInsufficientFee, str)
+	}
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
 	"github.com/kobradag/kobrad/domain/consensus/utils/consensushashing"
 	"github.com/kobradag/kobrad/domain/miningmanager/mempool/model"
+	miningmanagermodel "github.com/kobradag/kobrad/domain/miningmanager/model"
 )
 
 type transactionsPool struct {
@@ -53,6 +54,7 @@ func (tp *transactionsPool) addTransaction(transaction *externalapi.DomainTransa
 
 func (tp *transactionsPool) addMempoolTransaction(transaction *model.MempoolTransaction) error {
 	tp.allTransactions[*transaction.TransactionID()] = transaction
+	mempoolTransactionCount.Set(int64(len(tp.allTransactions)))
 
 	for _, parentTransactionInPool := range transaction.ParentTransactionsInPool() {
 		parentTransactionID := *parentTransactionInPool.TransactionID()
@@ -79,6 +81,7 @@ func (tp *transactionsPool) addMempoolTransaction(transaction *model.MempoolTran
 
 func (tp *transactionsPool) removeTransaction(transaction *model.MempoolTransaction) error {
 	delete(tp.allTransactions, *transaction.TransactionID())
+	mempoolTransactionCount.Set(int64(len(tp.allTransactions)))
 
 	err := tp.transactionsOrderedByFeeRate.Remove(transaction)
 	if err != nil {
@@ -172,6 +175,60 @@ func (tp *transactionsPool) getRedeemers(transaction *model.MempoolTransaction)
 	return redeemers
 }
 
+func (tp *transactionsPool) getAncestors(transaction *model.MempoolTransaction) model.IDToTransactionMap {
+	return tp.getAncestorsOfParents(transaction.ParentTransactionsInPool())
+}
+
+// getAncestorsOfParents returns the given parents in the pool along with all their ancestors
+func (tp *transactionsPool) getAncestorsOfParents(parentTransactionsInPool model.IDToTransactionMap) model.IDToTransactionMap {
+	stack := make([]*model.MempoolTransaction, 0, len(parentTransactionsInPool))
+	ancestors := model.IDToTransactionMap{}
+	for parentTransactionID, parentTransaction := range parentTransactionsInPool {
+		ancestors[parentTransactionID] = parentTransaction
+		stack = append(stack, parentTransaction)
+	}
+	for len(stack) > 0 {
+		var current *model.MempoolTransaction
+		last := len(stack) - 1
+		current, stack = stack[last], stack[:last]
+
+		for parentTransactionID, parentTransaction := range current.ParentTransactionsInPool() {
+			if _, ok := ancestors[parentTransactionID]; ok {
+				continue
+			}
+			ancestors[parentTransactionID] = parentTransaction
+			stack = append(stack, parentTransaction)
+		}
+	}
+	return ancestors
+}
+
+// ancestorPackages returns the ancestor package of every transaction in the pool
+// that spends the outputs of other transactions in the pool
+func (tp *transactionsPool) ancestorPackages() []*miningmanagermodel.TransactionPackage {
+	packages := []*miningmanagermodel.TransactionPackage{}
+	for _, mempoolTransaction := range tp.allTransactions {
+		if len(mempoolTransaction.ParentTransactionsInPool()) == 0 {
+			continue
+		}
+
+		transactionPackage := &miningmanagermodel.TransactionPackage{
+			Fee:  mempoolTransaction.Transaction().Fee,
+			Mass: mempoolTransaction.Transaction().Mass,
+		}
+		for _, ancestor := range tp.getAncestors(mempoolTransaction) {
+			transactionPackage.Fee += ancestor.Transaction().Fee
+			transactionPackage.Mass += ancestor.Transaction().Mass
+			if len(ancestor.ParentTransactionsInPool()) == 0 {
+				transactionPackage.ReadyTransactionIDs =
+					append(transactionPackage.ReadyTransactionIDs, ancestor.TransactionID())
+			}
+		}
+		packages = append(packages, transactionPackage)
+	}
+	return packages
+}
+
 func (tp *transactionsPool) limitTransactionCount() error {
 	currentIndex := 0
 
@@ -246,6 +303,20 @@ func (tp *transactionsPool) getAllTransactions() []*externalapi.DomainTransactio
 	return allTransactions
 }
 
+// feeRates returns the fee rates and masses of all the transactions in the pool,
+// ordered from the highest fee rate to the lowest
+func (tp *transactionsPool) feeRates() (feeRates []float64, masses []uint64) {
+	count := tp.transactionsOrderedByFeeRate.Len()
+	feeRates = make([]float64, 0, count)
+	masses = make([]uint64, 0, count)
+	for i := count - 1; i >= 0; i-- {
+		transaction := tp.transactionsOrderedByFeeRate.GetByIndex(i).Transaction()
+		feeRates = append(feeRates, float64(transaction.Fee)/float64(transaction.Mass))
+		masses = append(masses, transaction.Mass)
+	}
+	return feeRates, masses
+}
+
 func (tp *transactionsPool) transactionCount() int {
 	return len(tp.allTransactions)
 }
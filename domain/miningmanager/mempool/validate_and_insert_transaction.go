@@ -44,6 +44,11 @@ func (mp *mempool) validateAndInsertTransaction(transaction *externalapi.DomainT
 		return nil, err
 	}
 
+	err = mp.checkAncestorLimits(transaction, parentsInPool)
+	if err != nil {
+		return nil, err
+	}
+
 	mempoolTransaction, err := mp.transactionsPool.addTransaction(transaction, parentsInPool, isHighPriority)
 	if err != nil {
 		return nil, err
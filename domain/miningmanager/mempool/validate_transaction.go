@@ -6,6 +6,7 @@ import (
 	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
 	"github.com/kobradag/kobrad/domain/consensus/utils/consensushashing"
 	"github.com/kobradag/kobrad/domain/consensus/utils/constants"
+	"github.com/kobradag/kobrad/domain/miningmanager/mempool/model"
 )
 
 func (mp *mempool) validateTransactionPreUTXOEntry(transaction *externalapi.DomainTransaction) error {
@@ -77,3 +78,29 @@ func (mp *mempool) validateTransactionInContext(transaction *externalapi.DomainT
 
 	return nil
 }
+
+// checkAncestorLimits rejects a transaction that would have more ancestors in the mempool, or
+// ancestors of a higher total mass, than the mempool allows
+func (mp *mempool) checkAncestorLimits(transaction *externalapi.DomainTransaction,
+	parentTransactionsInPool model.IDToTransactionMap) error {
+
+	ancestors := mp.transactionsPool.getAncestorsOfParents(parentTransactionsInPool)
+	if uint64(len(ancestors)) > mp.config.MaximumAncestorCount {
+		str := fmt.Sprintf("transaction %s has %d ancestors in the mempool, which is more than the maximum of %d",
+			consensushashing.TransactionID(transaction), len(ancestors), mp.config.MaximumAncestorCount)
+		return transactionRuleError(RejectNonstandard, str)
+	}
+
+	ancestorMass := transaction.Mass
+	for _, ancestor := range ancestors {
+		ancestorMass += ancestor.Transaction().Mass
+	}
+	if ancestorMass > mp.config.MaximumAncestorMass {
+		str := fmt.Sprintf("transaction %s and its ancestors in the mempool have a mass of %d, which is more "+
+			"than the maximum of %d", consensushashing.TransactionID(transaction), ancestorMass,
+			mp.config.MaximumAncestorMass)
+		return transactionRuleError(RejectNonstandard, str)
+	}
+
+	return nil
+}
@@ -32,7 +32,11 @@ type MiningManager interface {
 	HandleNewBlockTransactions(txs []*externalapi.DomainTransaction) ([]*externalapi.DomainTransaction, error)
 	ValidateAndInsertTransaction(transaction *externalapi.DomainTransaction, isHighPriority bool, allowOrphan bool) (
 		acceptedTransactions []*externalapi.DomainTransaction, err error)
+	ValidateAndInsertTransactionReplacement(transaction *externalapi.DomainTransaction, isHighPriority bool) (
+		acceptedTransactions []*externalapi.DomainTransaction, replacedTransactions []*externalapi.DomainTransaction, err error)
 	RevalidateHighPriorityTransactions() (validTransactions []*externalapi.DomainTransaction, err error)
+	HighPriorityTransactionIDs() []*externalapi.DomainTransactionID
+	GetFeeEstimate() *miningmanagermodel.FeeEstimate
 }
 
 type miningManager struct {
@@ -117,6 +121,15 @@ func (mm *miningManager) ValidateAndInsertTransaction(transaction *externalapi.D
 	return mm.mempool.ValidateAndInsertTransaction(transaction, isHighPriority, allowOrphan)
 }
 
+// ValidateAndInsertTransactionReplacement validates the given transaction, and
+// adds it to the mempool in place of the transactions it double spends
+func (mm *miningManager) ValidateAndInsertTransactionReplacement(transaction *externalapi.DomainTransaction,
+	isHighPriority bool) (acceptedTransactions []*externalapi.DomainTransaction,
+	replacedTransactions []*externalapi.DomainTransaction, err error) {
+
+	return mm.mempool.ValidateAndInsertTransactionReplacement(transaction, isHighPriority)
+}
+
 func (mm *miningManager) GetTransaction(
 	transactionID *externalapi.DomainTransactionID,
 	includeTransactionPool bool,
@@ -154,3 +167,15 @@ func (mm *miningManager) RevalidateHighPriorityTransactions() (
 
 	return mm.mempool.RevalidateHighPriorityTransactions()
 }
+
+// HighPriorityTransactionIDs returns the IDs of the high priority transactions in the
+// mempool, including the orphan ones
+func (mm *miningManager) HighPriorityTransactionIDs() []*externalapi.DomainTransactionID {
+	return mm.mempool.HighPriorityTransactionIDs()
+}
+
+// GetFeeEstimate returns the fee rates required for a transaction to be
+// included within various amounts of blocks
+func (mm *miningManager) GetFeeEstimate() *miningmanagermodel.FeeEstimate {
+	return mm.blockTemplateBuilder.EstimateFees()
+}
@@ -173,6 +173,72 @@ func TestDoubleSpendInMempool(t *testing.T) {
 	})
 }
 
+// TestTransactionReplacement verifies that a transaction double-spending another transaction
+// in the mempool replaces it only if it pays a higher fee.
+func TestTransactionReplacement(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.BlockCoinbaseMaturity = 0
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestTransactionReplacement")
+		if err != nil {
+			t.Fatalf("Error setting up TestConsensus: %+v", err)
+		}
+		defer teardown(false)
+
+		miningFactory := miningmanager.NewFactory()
+		tcAsConsensus := tc.(externalapi.Consensus)
+		tcAsConsensusPointer := &tcAsConsensus
+		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
+		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params))
+		transaction, err := createChildAndParentTxsAndAddParentToConsensus(tc)
+		if err != nil {
+			t.Fatalf("Error creating transaction: %+v", err)
+		}
+
+		_, _, err = miningManager.ValidateAndInsertTransactionReplacement(transaction.Clone(), false)
+		if err == nil || !strings.Contains(err.Error(), "does not double spend any transaction") {
+			t.Fatalf("ValidateAndInsertTransactionReplacement: %v", err)
+		}
+
+		_, err = miningManager.ValidateAndInsertTransaction(transaction, false, true)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransaction: %v", err)
+		}
+
+		lowerFeeTransaction := transaction.Clone()
+		lowerFeeTransaction.ID = nil
+		lowerFeeTransaction.Outputs[0].Value++
+		_, _, err = miningManager.ValidateAndInsertTransactionReplacement(lowerFeeTransaction, false)
+		txRuleError := &mempool.TxRuleError{}
+		if !errors.As(err, txRuleError) || txRuleError.RejectCode != mempool.RejectInsufficientFee {
+			t.Fatalf("Unexpected error %+v", err)
+		}
+
+		higherFeeTransaction := transaction.Clone()
+		higherFeeTransaction.ID = nil
+		higherFeeTransaction.Outputs[0].Value -= 1000
+		_, err = miningManager.ValidateAndInsertTransaction(higherFeeTransaction, false, true)
+		if !errors.As(err, txRuleError) || txRuleError.RejectCode != mempool.RejectDoubleSpend {
+			t.Fatalf("Unexpected error %+v", err)
+		}
+		_, replacedTransactions, err := miningManager.ValidateAndInsertTransactionReplacement(higherFeeTransaction, false)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransactionReplacement: %v", err)
+		}
+		if len(replacedTransactions) != 1 ||
+			!consensushashing.TransactionID(replacedTransactions[0]).Equal(consensushashing.TransactionID(transaction)) {
+			t.Fatalf("Expected transaction %s to be replaced, but got %v",
+				consensushashing.TransactionID(transaction), domainBlocksToBlockIds(replacedTransactions))
+		}
+
+		transactionsFromMempool, _ := miningManager.AllTransactions(true, false)
+		if len(transactionsFromMempool) != 1 || !contains(higherFeeTransaction, transactionsFromMempool) {
+			t.Fatalf("Expected the mempool to contain only the replacement transaction %s",
+				consensushashing.TransactionID(higherFeeTransaction))
+		}
+	})
+}
+
 // TestHandleNewBlockTransactions verifies that all the transactions in the block were successfully removed from the mempool.
 func TestHandleNewBlockTransactions(t *testing.T) {
 	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
@@ -643,6 +709,63 @@ func TestRevalidateHighPriorityTransactionsWithChain(t *testing.T) {
 	})
 }
 
+// TestAncestorLimits verifies that transactions with too many ancestors in the mempool, or ancestors
+// of too much mass, are rejected.
+func TestAncestorLimits(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.BlockCoinbaseMaturity = 0
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestAncestorLimits")
+		if err != nil {
+			t.Fatalf("Failed setting up TestConsensus: %+v", err)
+		}
+		defer teardown(false)
+
+		const chainSize = 5
+		chain, err := createTxChain(tc, chainSize)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		miningFactory := miningmanager.NewFactory()
+		tcAsConsensus := tc.(externalapi.Consensus)
+		tcAsConsensusPointer := &tcAsConsensus
+		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
+
+		// Every transaction in the chain has one more ancestor than its parent
+		mempoolConfig := mempool.DefaultConfig(&consensusConfig.Params)
+		mempoolConfig.MaximumAncestorCount = chainSize - 2
+		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempoolConfig)
+		for _, transaction := range chain[:chainSize-1] {
+			_, err = miningManager.ValidateAndInsertTransaction(transaction.Clone(), false, false)
+			if err != nil {
+				t.Fatalf("ValidateAndInsertTransaction: %+v", err)
+			}
+		}
+		_, err = miningManager.ValidateAndInsertTransaction(chain[chainSize-1].Clone(), false, false)
+		txRuleError := &mempool.TxRuleError{}
+		if !errors.As(err, txRuleError) || txRuleError.RejectCode != mempool.RejectNonstandard {
+			t.Fatalf("Expected a transaction with too many ancestors to be rejected, but got: %+v", err)
+		}
+
+		// All the transactions in the chain have the same mass
+		tc.PopulateMass(chain[0])
+		mempoolConfig = mempool.DefaultConfig(&consensusConfig.Params)
+		mempoolConfig.MaximumAncestorMass = 2*chain[0].Mass + chain[0].Mass/2
+		miningManager = miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempoolConfig)
+		for _, transaction := range chain[:2] {
+			_, err = miningManager.ValidateAndInsertTransaction(transaction.Clone(), false, false)
+			if err != nil {
+				t.Fatalf("ValidateAndInsertTransaction: %+v", err)
+			}
+		}
+		_, err = miningManager.ValidateAndInsertTransaction(chain[2].Clone(), false, false)
+		if !errors.As(err, txRuleError) || txRuleError.RejectCode != mempool.RejectNonstandard {
+			t.Fatalf("Expected a transaction with ancestors of too much mass to be rejected, but got: %+v", err)
+		}
+	})
+}
+
 // TestModifyBlockTemplate verifies that modifying a block template changes coinbase data correctly.
 func TestModifyBlockTemplate(t *testing.T) {
 	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
@@ -0,0 +1,17 @@
+package model
+
+// FeeRateBucket is a fee rate, in leor per gram of mass, alongside the
+// number of blocks within which a transaction paying it is expected to be
+// included
+type FeeRateBucket struct {
+	FeeRate         float64
+	EstimatedBlocks uint64
+}
+
+// FeeEstimate holds the fee rates required for a transaction to be included
+// within various amounts of blocks
+type FeeEstimate struct {
+	PriorityBucket FeeRateBucket
+	NormalBucket   FeeRateBucket
+	LowBucket      FeeRateBucket
+}
@@ -10,8 +10,11 @@ import (
 type Mempool interface {
 	HandleNewBlockTransactions(txs []*externalapi.DomainTransaction) ([]*externalapi.DomainTransaction, error)
 	BlockCandidateTransactions() []*externalapi.DomainTransaction
+	AncestorPackages() []*TransactionPackage
 	ValidateAndInsertTransaction(transaction *externalapi.DomainTransaction, isHighPriority bool, allowOrphan bool) (
 		acceptedTransactions []*externalapi.DomainTransaction, err error)
+	ValidateAndInsertTransactionReplacement(transaction *externalapi.DomainTransaction, isHighPriority bool) (
+		acceptedTransactions []*externalapi.DomainTransaction, replacedTransactions []*externalapi.DomainTransaction, err error)
 	RemoveInvalidTransactions(err *ruleerrors.ErrInvalidTransactionsInNewBlock) error
 	GetTransaction(
 		transactionID *externalapi.DomainTransactionID,
@@ -39,5 +42,8 @@ type Mempool interface {
 		includeTransactionPool bool,
 		includeOrphanPool bool) int
 	RevalidateHighPriorityTransactions() (validTransactions []*externalapi.DomainTransaction, err error)
+	HighPriorityTransactionIDs() []*externalapi.DomainTransactionID
 	IsTransactionOutputDust(output *externalapi.DomainTransactionOutput) bool
+	TransactionFeeRates() (feeRates []float64, masses []uint64)
+	MinimumFeeRate() float64
 }
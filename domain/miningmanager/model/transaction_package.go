@@ -0,0 +1,21 @@
+package model
+
+import "github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+
+// TransactionPackage is a mempool transaction along with all of its
+// ancestors in the mempool. Mining the transactions of the package that have
+// no parents in the mempool is what eventually allows the rest of the package
+// to be mined, so these are worth the fee rate of the whole package.
+type TransactionPackage struct {
+	// ReadyTransactionIDs are the IDs of the transactions in the package
+	// that don't spend any other mempool transaction, and may therefore be
+	// included in the next block
+	ReadyTransactionIDs []*externalapi.DomainTransactionID
+	Fee                 uint64
+	Mass                uint64
+}
+
+// FeeRate returns the fee rate of the package as a whole, in leor per gram of mass
+func (tp *TransactionPackage) FeeRate() float64 {
+	return float64(tp.Fee) / float64(tp.Mass)
+}
@@ -9,8 +9,8 @@ var activePrefixKey = database.MakeBucket(nil).Key([]byte("active-prefix"))
 var inactivePrefixKey = database.MakeBucket(nil).Key([]byte("inactive-prefix"))
 
 // ActivePrefix returns the current active database prefix, and whether it exists
-func ActivePrefix(dataAccessor database.DataAccessor) (*prefix.Prefix, bool, error) {
-	prefixBytes, err := dataAccessor.Get(activePrefixKey)
+func ActivePrefix(dataReader database.DataReader) (*prefix.Prefix, bool, error) {
+	prefixBytes, err := dataReader.Get(activePrefixKey)
 	if database.IsNotFoundError(err) {
 		return nil, false, nil
 	}
@@ -28,8 +28,8 @@ func ActivePrefix(dataAccessor database.DataAccessor) (*prefix.Prefix, bool, err
 }
 
 // InactivePrefix returns the current inactive database prefix, and whether it exists
-func InactivePrefix(dataAccessor database.DataAccessor) (*prefix.Prefix, bool, error) {
-	prefixBytes, err := dataAccessor.Get(inactivePrefixKey)
+func InactivePrefix(dataReader database.DataReader) (*prefix.Prefix, bool, error) {
+	prefixBytes, err := dataReader.Get(inactivePrefixKey)
 	if database.IsNotFoundError(err) {
 		return nil, false, nil
 	}
@@ -0,0 +1,17 @@
+package txindex
+
+import (
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+)
+
+// TXAcceptanceData is the data the transaction index keeps per
+// accepted transaction: the block that included the transaction
+// and the chain block that accepted it
+type TXAcceptanceData struct {
+	IncludingBlockHash *externalapi.DomainHash
+	AcceptingBlockHash *externalapi.DomainHash
+}
+
+// TXAcceptanceDataMap is a map between transaction IDs and their
+// acceptance data
+type TXAcceptanceDataMap map[externalapi.DomainTransactionID]*TXAcceptanceData
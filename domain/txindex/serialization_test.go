@@ -0,0 +1,69 @@
+package txindex
+
+import (
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/pkg/errors"
+)
+
+func Test_serializeTXAcceptanceData(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+
+	for i := 0; i < 32; i++ {
+		var includingBlockHashBytes, acceptingBlockHashBytes [externalapi.DomainHashSize]byte
+		r.Read(includingBlockHashBytes[:])
+		r.Read(acceptingBlockHashBytes[:])
+		txAcceptanceData := &TXAcceptanceData{
+			IncludingBlockHash: externalapi.NewDomainHashFromByteArray(&includingBlockHashBytes),
+			AcceptingBlockHash: externalapi.NewDomainHashFromByteArray(&acceptingBlockHashBytes),
+		}
+
+		result, err := deserializeTXAcceptanceData(serializeTXAcceptanceData(txAcceptanceData))
+		if err != nil {
+			t.Fatalf("Failed deserializing transaction acceptance data: %v", err)
+		}
+		if !result.IncludingBlockHash.Equal(txAcceptanceData.IncludingBlockHash) {
+			t.Fatalf("Expected including block hash %s but got %s",
+				txAcceptanceData.IncludingBlockHash, result.IncludingBlockHash)
+		}
+		if !result.AcceptingBlockHash.Equal(txAcceptanceData.AcceptingBlockHash) {
+			t.Fatalf("Expected accepting block hash %s but got %s",
+				txAcceptanceData.AcceptingBlockHash, result.AcceptingBlockHash)
+		}
+	}
+}
+
+func Test_deserializeTXAcceptanceDataFailure(t *testing.T) {
+	txAcceptanceData := &TXAcceptanceData{
+		IncludingBlockHash: externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{1}),
+		AcceptingBlockHash: externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{2}),
+	}
+	serialized := serializeTXAcceptanceData(txAcceptanceData)
+	_, err := deserializeTXAcceptanceData(serialized[:len(serialized)-1])
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("Expected error to be EOF, instead got: %v", err)
+	}
+}
+
+func Test_serializeHashes(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+
+	for length := 0; length < 32; length++ {
+		hashes := make([]*externalapi.DomainHash, length)
+		for i := range hashes {
+			var hashBytes [externalapi.DomainHashSize]byte
+			r.Read(hashBytes[:])
+			hashes[i] = externalapi.NewDomainHashFromByteArray(&hashBytes)
+		}
+		result, err := deserializeHashes(serializeHashes(hashes))
+		if err != nil {
+			t.Fatalf("Failed deserializing hashes: %v", err)
+		}
+		if !externalapi.HashesEqual(hashes, result) {
+			t.Fatalf("Expected \n %s \n==\n %s\n", hashes, result)
+		}
+	}
+}
@@ -0,0 +1,206 @@
+package txindex
+
+import (
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/infrastructure/db/database"
+	"github.com/kobradag/kobrad/infrastructure/logger"
+	"github.com/pkg/errors"
+)
+
+var txIndexBucket = database.MakeBucket([]byte("tx-index"))
+var virtualParentsKey = database.MakeBucket([]byte("")).Key([]byte("tx-index-virtual-parents"))
+
+type txIndexStore struct {
+	database database.Database
+	toAdd    TXAcceptanceDataMap
+	toRemove map[externalapi.DomainTransactionID]*externalapi.DomainHash
+
+	virtualParents []*externalapi.DomainHash
+}
+
+func newTXIndexStore(database database.Database) *txIndexStore {
+	return &txIndexStore{
+		database: database,
+		toAdd:    make(TXAcceptanceDataMap),
+		toRemove: make(map[externalapi.DomainTransactionID]*externalapi.DomainHash),
+	}
+}
+
+func (tis *txIndexStore) add(transactionID *externalapi.DomainTransactionID, txAcceptanceData *TXAcceptanceData) {
+	log.Tracef("Adding transaction %s accepted by block %s to the TX index",
+		transactionID, txAcceptanceData.AcceptingBlockHash)
+
+	// A transaction that is added after being removed in the same update
+	// was re-accepted by a different chain block
+	delete(tis.toRemove, *transactionID)
+	tis.toAdd[*transactionID] = txAcceptanceData
+}
+
+func (tis *txIndexStore) remove(transactionID *externalapi.DomainTransactionID, acceptingBlockHash *externalapi.DomainHash) {
+	log.Tracef("Removing transaction %s accepted by block %s from the TX index",
+		transactionID, acceptingBlockHash)
+
+	if toAddTXAcceptanceData, ok := tis.toAdd[*transactionID]; ok &&
+		toAddTXAcceptanceData.AcceptingBlockHash.Equal(acceptingBlockHash) {
+
+		delete(tis.toAdd, *transactionID)
+		return
+	}
+	tis.toRemove[*transactionID] = acceptingBlockHash
+}
+
+func (tis *txIndexStore) updateVirtualParents(virtualParents []*externalapi.DomainHash) {
+	tis.virtualParents = virtualParents
+}
+
+func (tis *txIndexStore) discard() {
+	tis.toAdd = make(TXAcceptanceDataMap)
+	tis.toRemove = make(map[externalapi.DomainTransactionID]*externalapi.DomainHash)
+	tis.virtualParents = nil
+}
+
+func (tis *txIndexStore) commit() error {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "txIndexStore.commit")
+	defer onEnd()
+
+	dbTransaction, err := tis.database.Begin()
+	if err != nil {
+		return err
+	}
+	defer dbTransaction.RollbackUnlessClosed()
+
+	for transactionID, acceptingBlockHash := range tis.toRemove {
+		key := tis.convertTransactionIDToKey(&transactionID)
+
+		// The transaction might have been accepted by some other chain block
+		// in the meantime, in which case its entry must not be touched
+		serializedTXAcceptanceData, err := dbTransaction.Get(key)
+		if err != nil {
+			if database.IsNotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		txAcceptanceData, err := deserializeTXAcceptanceData(serializedTXAcceptanceData)
+		if err != nil {
+			return err
+		}
+		if !txAcceptanceData.AcceptingBlockHash.Equal(acceptingBlockHash) {
+			continue
+		}
+
+		err = dbTransaction.Delete(key)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tis.putTXAcceptanceData(dbTransaction, tis.toAdd)
+	if err != nil {
+		return err
+	}
+
+	if tis.virtualParents != nil {
+		err = dbTransaction.Put(virtualParentsKey, serializeHashes(tis.virtualParents))
+		if err != nil {
+			return err
+		}
+	}
+
+	err = dbTransaction.Commit()
+	if err != nil {
+		return err
+	}
+
+	tis.discard()
+	return nil
+}
+
+func (tis *txIndexStore) addAndCommitTXAcceptanceDataWithoutTransaction(txAcceptanceDataMap TXAcceptanceDataMap) error {
+	return tis.putTXAcceptanceData(tis.database, txAcceptanceDataMap)
+}
+
+func (tis *txIndexStore) putTXAcceptanceData(dataAccessor database.DataAccessor, txAcceptanceDataMap TXAcceptanceDataMap) error {
+	for transactionID, txAcceptanceData := range txAcceptanceDataMap {
+		key := tis.convertTransactionIDToKey(&transactionID)
+		err := dataAccessor.Put(key, serializeTXAcceptanceData(txAcceptanceData))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (tis *txIndexStore) updateAndCommitVirtualParentsWithoutTransaction(virtualParents []*externalapi.DomainHash) error {
+	serializeParentHashes := serializeHashes(virtualParents)
+	return tis.database.Put(virtualParentsKey, serializeParentHashes)
+}
+
+func (tis *txIndexStore) convertTransactionIDToKey(transactionID *externalapi.DomainTransactionID) *database.Key {
+	return txIndexBucket.Key(transactionID.ByteSlice())
+}
+
+func (tis *txIndexStore) isAnythingStaged() bool {
+	return len(tis.toAdd) > 0 || len(tis.toRemove) > 0
+}
+
+func (tis *txIndexStore) getTXAcceptanceData(transactionID *externalapi.DomainTransactionID) (*TXAcceptanceData, bool, error) {
+	if tis.isAnythingStaged() {
+		return nil, false, errors.Errorf("cannot get transaction acceptance data while staging isn't empty")
+	}
+
+	serializedTXAcceptanceData, err := tis.database.Get(tis.convertTransactionIDToKey(transactionID))
+	if err != nil {
+		if database.IsNotFoundError(err) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	txAcceptanceData, err := deserializeTXAcceptanceData(serializedTXAcceptanceData)
+	if err != nil {
+		return nil, false, err
+	}
+	return txAcceptanceData, true, nil
+}
+
+func (tis *txIndexStore) getVirtualParents() ([]*externalapi.DomainHash, error) {
+	if tis.isAnythingStaged() {
+		return nil, errors.Errorf("cannot get the virtual parents while staging isn't empty")
+	}
+
+	serializedHashes, err := tis.database.Get(virtualParentsKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return deserializeHashes(serializedHashes)
+}
+
+func (tis *txIndexStore) deleteAll() error {
+	// First we delete the virtual parents, so if anything goes wrong, the TX index will be marked as "not synced"
+	// and will be reset.
+	err := tis.database.Delete(virtualParentsKey)
+	if err != nil {
+		return err
+	}
+
+	cursor, err := tis.database.Cursor(txIndexBucket)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+	for cursor.Next() {
+		key, err := cursor.Key()
+		if err != nil {
+			return err
+		}
+
+		err = tis.database.Delete(key)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,191 @@
+package txindex
+
+import (
+	"sync"
+
+	"github.com/kobradag/kobrad/domain"
+	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
+	"github.com/kobradag/kobrad/domain/consensus/utils/consensushashing"
+	"github.com/kobradag/kobrad/infrastructure/db/database"
+	"github.com/kobradag/kobrad/infrastructure/logger"
+)
+
+// TXIndex maintains an index between transaction IDs and the
+// blocks that included and accepted them
+type TXIndex struct {
+	domain domain.Domain
+	store  *txIndexStore
+
+	mutex sync.Mutex
+}
+
+// New creates a new TX index.
+//
+// NOTE: While this is called no new blocks can be added to the consensus.
+func New(domain domain.Domain, database database.Database) (*TXIndex, error) {
+	txIndex := &TXIndex{
+		domain: domain,
+		store:  newTXIndexStore(database),
+	}
+	isSynced, err := txIndex.isSynced()
+	if err != nil {
+		return nil, err
+	}
+
+	if !isSynced {
+		err := txIndex.Reset()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return txIndex, nil
+}
+
+// Reset deletes the whole TX index and resyncs it from consensus.
+func (ti *TXIndex) Reset() error {
+	ti.mutex.Lock()
+	defer ti.mutex.Unlock()
+	log.Infof("Starting TX index reset")
+
+	err := ti.store.deleteAll()
+	if err != nil {
+		return err
+	}
+
+	virtualInfo, err := ti.domain.Consensus().GetVirtualInfo()
+	if err != nil {
+		return err
+	}
+
+	pruningPoint, err := ti.domain.Consensus().PruningPoint()
+	if err != nil {
+		return err
+	}
+
+	// Acceptance data is only kept for blocks above the pruning point,
+	// so that's where the index starts
+	chainPath, err := ti.domain.Consensus().GetVirtualSelectedParentChainFromBlock(pruningPoint)
+	if err != nil {
+		return err
+	}
+
+	const step = 1000
+	for position := 0; position < len(chainPath.Added); position += step {
+		end := position + step
+		if end > len(chainPath.Added) {
+			end = len(chainPath.Added)
+		}
+		// We use chunks in order to avoid blocking consensus for too long
+		chainBlocksChunk := chainPath.Added[position:end]
+		chainBlocksAcceptanceData, err := ti.domain.Consensus().GetBlocksAcceptanceData(chainBlocksChunk)
+		if err != nil {
+			return err
+		}
+
+		txAcceptanceDataMap := make(TXAcceptanceDataMap)
+		for i, chainBlockHash := range chainBlocksChunk {
+			forEachAcceptedTransaction(chainBlockHash, chainBlocksAcceptanceData[i],
+				func(transactionID *externalapi.DomainTransactionID, txAcceptanceData *TXAcceptanceData) {
+					txAcceptanceDataMap[*transactionID] = txAcceptanceData
+				})
+		}
+
+		err = ti.store.addAndCommitTXAcceptanceDataWithoutTransaction(txAcceptanceDataMap)
+		if err != nil {
+			return err
+		}
+	}
+
+	// This has to be done last to mark that the reset went smoothly and no reset has to be called next time.
+	err = ti.store.updateAndCommitVirtualParentsWithoutTransaction(virtualInfo.ParentHashes)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Finished TX index reset")
+	return nil
+}
+
+func (ti *TXIndex) isSynced() (bool, error) {
+	txIndexVirtualParents, err := ti.store.getVirtualParents()
+	if err != nil {
+		if database.IsNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	virtualInfo, err := ti.domain.Consensus().GetVirtualInfo()
+	if err != nil {
+		return false, err
+	}
+
+	return externalapi.HashesEqual(virtualInfo.ParentHashes, txIndexVirtualParents), nil
+}
+
+// Update updates the TX index with the given DAG selected parent chain changes
+func (ti *TXIndex) Update(virtualChangeSet *externalapi.VirtualChangeSet) error {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "TXIndex.Update")
+	defer onEnd()
+
+	ti.mutex.Lock()
+	defer ti.mutex.Unlock()
+
+	chainChanges := virtualChangeSet.VirtualSelectedParentChainChanges
+	log.Tracef("Updating TX index with VirtualSelectedParentChainChanges: %+v", chainChanges)
+
+	if chainChanges != nil {
+		removedChainBlocksAcceptanceData, err := ti.domain.Consensus().GetBlocksAcceptanceData(chainChanges.Removed)
+		if err != nil {
+			return err
+		}
+		for i, removedChainBlockHash := range chainChanges.Removed {
+			forEachAcceptedTransaction(removedChainBlockHash, removedChainBlocksAcceptanceData[i],
+				func(transactionID *externalapi.DomainTransactionID, txAcceptanceData *TXAcceptanceData) {
+					ti.store.remove(transactionID, txAcceptanceData.AcceptingBlockHash)
+				})
+		}
+
+		addedChainBlocksAcceptanceData, err := ti.domain.Consensus().GetBlocksAcceptanceData(chainChanges.Added)
+		if err != nil {
+			return err
+		}
+		for i, addedChainBlockHash := range chainChanges.Added {
+			forEachAcceptedTransaction(addedChainBlockHash, addedChainBlocksAcceptanceData[i], ti.store.add)
+		}
+	}
+
+	ti.store.updateVirtualParents(virtualChangeSet.VirtualParents)
+
+	return ti.store.commit()
+}
+
+// TXAcceptanceData returns the including and accepting blocks of the
+// transaction with the given ID. The returned boolean is false if the
+// transaction is not in the index.
+func (ti *TXIndex) TXAcceptanceData(transactionID *externalapi.DomainTransactionID) (*TXAcceptanceData, bool, error) {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "TXIndex.TXAcceptanceData")
+	defer onEnd()
+
+	ti.mutex.Lock()
+	defer ti.mutex.Unlock()
+
+	return ti.store.getTXAcceptanceData(transactionID)
+}
+
+func forEachAcceptedTransaction(chainBlockHash *externalapi.DomainHash, acceptanceData externalapi.AcceptanceData,
+	callback func(transactionID *externalapi.DomainTransactionID, txAcceptanceData *TXAcceptanceData)) {
+
+	for _, blockAcceptanceData := range acceptanceData {
+		for _, transactionAcceptanceData := range blockAcceptanceData.TransactionAcceptanceData {
+			if !transactionAcceptanceData.IsAccepted {
+				continue
+			}
+			callback(consensushashing.TransactionID(transactionAcceptanceData.Transaction), &TXAcceptanceData{
+				IncludingBlockHash: blockAcceptanceData.BlockHash,
+				AcceptingBlockHash: chainBlockHash,
+			})
+		}
+	}
+}
@@ -14,6 +14,12 @@ var utxoIndexBucket = database.MakeBucket([]byte("utxo-index"))
 var virtualParentsKey = database.MakeBucket([]byte("")).Key([]byte("utxo-index-virtual-parents"))
 var circulatingSupplyKey = database.MakeBucket([]byte("")).Key([]byte("utxo-index-circulating-supply"))
 
+// StoredData returns the buckets and the keys in which the UTXO index
+// stores its data
+func StoredData() (buckets []*database.Bucket, keys []*database.Key) {
+	return []*database.Bucket{utxoIndexBucket}, []*database.Key{virtualParentsKey, circulatingSupplyKey}
+}
+
 type utxoIndexStore struct {
 	database database.Database
 	toAdd    map[ScriptPublicKeyString]UTXOOutpointEntryPairs
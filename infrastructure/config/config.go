@@ -18,6 +18,8 @@ import (
 
 	"github.com/kobradag/kobrad/domain/consensus/model/externalapi"
 	"github.com/kobradag/kobrad/domain/dagconfig"
+	"github.com/kobradag/kobrad/infrastructure/db/database/backends"
+	"github.com/kobradag/kobrad/infrastructure/db/database/ldb"
 	"github.com/kobradag/kobrad/infrastructure/logger"
 	"github.com/kobradag/kobrad/util"
 	"github.com/kobradag/kobrad/util/network"
@@ -40,20 +42,23 @@ const (
 	//DefaultConnectTimeout is the default connection timeout when dialing
 	DefaultConnectTimeout = time.Second * 3000
 	//DefaultMaxRPCClients is the default max number of RPC clients
-	DefaultMaxRPCClients         = 128
-	defaultMaxRPCWebsockets      = 25
-	defaultMaxRPCConcurrentReqs  = 20
-	defaultBlockMaxMass          = 10_000_000
-	blockMaxMassMin              = 1000
-	blockMaxMassMax              = 10_000_000
-	defaultMinRelayTxFee         = 1e-5 // 1 leor per byte
-	defaultMaxOrphanTransactions = 100
+	DefaultMaxRPCClients          = 128
+	defaultMaxRPCWebsockets       = 25
+	defaultMaxRPCConcurrentReqs   = 20
+	defaultRPCRateLimitBurst      = 100
+	defaultRPCRateLimitPerIPBurst = 200
+	defaultBlockMaxMass           = 10_000_000
+	blockMaxMassMin               = 1000
+	blockMaxMassMax               = 10_000_000
+	defaultMinRelayTxFee          = 1e-5 // 1 leor per byte
+	defaultMaxOrphanTransactions  = 100
 	//DefaultMaxOrphanTxSize is the default maximum size for an orphan transaction
 	DefaultMaxOrphanTxSize  = 100_000
 	defaultSigCacheMaxSize  = 100_000
 	sampleConfigFilename    = "sample-kobra.conf"
 	defaultMaxUTXOCacheSize = 5_000_000_000
 	defaultProtocolVersion  = 5
+	defaultDbCacheSizeMiB   = 256
 )
 
 var (
@@ -92,10 +97,19 @@ type Flags struct {
 	BanThreshold                    uint32        `long:"banthreshold" description:"Maximum allowed ban score before disconnecting and banning misbehaving peers."`
 	Whitelists                      []string      `long:"whitelist" description:"Add an IP network or IP that will not be banned. (eg. 192.168.1.0/24 or ::1)"`
 	RPCListeners                    []string      `long:"rpclisten" description:"Add an interface/port to listen for RPC connections (default port: 44448, testnet: 16210)"`
+	RPCListenersJSON                []string      `long:"rpclisten-json" description:"Add an interface/port to listen for JSON-RPC 2.0 requests over HTTP and WebSocket (default: disabled)"`
+	RPCAllowedOrigins               []string      `long:"rpcallowedorigin" description:"Add a web page origin, e.g. https://explorer.example.com, that may send JSON-RPC requests from a browser, or * to allow every origin (default: only the origin of the JSON-RPC gateway itself)"`
+	RPCUsers                        []string      `long:"rpcuser" description:"Add an RPC user in the form <username>:<password>[:<role>] (default role: admin). Once any user or token is added, RPC clients must authenticate"`
+	RPCTokens                       []string      `long:"rpctoken" description:"Add an RPC bearer token in the form <token>[:<role>] (default role: admin)"`
+	RPCRoles                        []string      `long:"rpcrole" description:"Define an RPC role in the form <name>:<method>[,<method>...], e.g. explorer:GetBlock,GetBlockDAGInfo. The built-in roles are admin, which may call every method, and readonly, which may call every method that doesn't affect the state of the node"`
 	RPCCert                         string        `long:"rpccert" description:"File containing the certificate file"`
 	RPCKey                          string        `long:"rpckey" description:"File containing the certificate key"`
 	RPCMaxClients                   int           `long:"rpcmaxclients" description:"Max number of RPC clients for standard connections"`
 	RPCMaxWebsockets                int           `long:"rpcmaxwebsockets" description:"Max number of RPC websocket connections"`
+	RPCRateLimit                    float64       `long:"rpcratelimit" description:"Max average cost of the RPC requests of a single connection per second, where most methods cost 1 and expensive ones like GetUTXOsByAddresses and GetBlocks cost more (0 to disable)"`
+	RPCRateLimitBurst               float64       `long:"rpcratelimitburst" description:"Max total cost of the RPC requests a single connection may make in a burst"`
+	RPCRateLimitPerIP               float64       `long:"rpcratelimitperip" description:"Max average cost of the RPC requests of all the connections from a single IP per second (0 to disable)"`
+	RPCRateLimitPerIPBurst          float64       `long:"rpcratelimitperipburst" description:"Max total cost of the RPC requests all the connections from a single IP may make in a burst"`
 	RPCMaxConcurrentReqs            int           `long:"rpcmaxconcurrentreqs" description:"Max number of concurrent RPC requests that may be processed concurrently"`
 	DisableRPC                      bool          `long:"norpc" description:"Disable built-in RPC server"`
 	SafeRPC                         bool          `long:"saferpc" description:"Disable RPC commands which affect the state of the node"`
@@ -106,12 +120,21 @@ type Flags struct {
 	Proxy                           string        `long:"proxy" description:"Connect via SOCKS5 proxy (eg. 127.0.0.1:9050)"`
 	ProxyUser                       string        `long:"proxyuser" description:"Username for proxy server"`
 	ProxyPass                       string        `long:"proxypass" default-mask:"-" description:"Password for proxy server"`
-	DbType                          string        `long:"dbtype" description:"Database backend to use for the Block DAG"`
+	DbType                          string        `long:"dbtype" description:"Database backend to use for the Block DAG {ldb, logdb} -- An existing database can only be opened with the backend that created it"`
+	DbCacheSizeMiB                  int           `long:"dbcachesize" description:"Size of the database cache in MiB"`
+	LdbWriteBufferMiB               int           `long:"ldbwritebuffer" description:"Size of the leveldb write buffer in MiB, which is written to the disk as a table once it's full (default: half of the cache size)"`
+	LdbCompression                  string        `long:"ldbcompression" description:"Compression of the leveldb tables {none, snappy} -- snappy saves disk space at the cost of some CPU time, and applies only to tables that are written from now on"`
+	LdbSync                         bool          `long:"ldbsync" description:"Flush every leveldb write to the disk before it completes, so that the database survives a crash of the machine, at the cost of write throughput"`
+	LdbCompactionL0Trigger          int           `long:"ldbcompactionl0trigger" description:"Number of leveldb level 0 tables that triggers their compaction (default: 4)"`
+	LdbCompactionTableSizeMiB       int           `long:"ldbcompactiontablesize" description:"Size in MiB of the tables that leveldb compactions produce (default: 2)"`
+	LdbSeekCompaction               bool          `long:"ldbseekcompaction" description:"Enable leveldb compactions that are triggered by reads that seek through too many tables"`
 	Profile                         string        `long:"profile" description:"Enable HTTP profiling on given port -- NOTE port must be between 1024 and 65536"`
+	MetricsListen                   string        `long:"metrics-listen" description:"Expose metrics in the Prometheus text format over HTTP on the given interface/port (e.g. 127.0.0.1:9100)"`
 	LogLevel                        string        `short:"d" long:"loglevel" description:"Logging level for all subsystems {trace, debug, info, warn, error, critical} -- You may also specify <subsystem>=<level>,<subsystem2>=<level>,... to set the log level for individual subsystems -- Use show to list available subsystems"`
 	Upnp                            bool          `long:"upnp" description:"Use UPnP to map our listening port outside of NAT"`
 	MinRelayTxFee                   float64       `long:"minrelaytxfee" description:"The minimum transaction fee in KODA/kB to be considered a non-zero fee."`
 	MaxOrphanTxs                    uint64        `long:"maxorphantx" description:"Max number of orphan transactions to keep in memory"`
+	NoMempoolPersistence            bool          `long:"nomempoolpersistence" description:"Don't persist the mempool to the database in order to reload it when the node restarts"`
 	BlockMaxMass                    uint64        `long:"blockmaxmass" description:"Maximum transaction mass to be used when creating a block"`
 	UserAgentComments               []string      `long:"uacomment" description:"Comment to add to the user agent -- See BIP 14 for more information."`
 	NoPeerBloomFilters              bool          `long:"nopeerbloomfilters" description:"Disable bloom filtering support"`
@@ -122,6 +145,8 @@ type Flags struct {
 	ResetDatabase                   bool          `long:"reset-db" description:"Reset database before starting node. It's needed when switching between subnetworks."`
 	MaxUTXOCacheSize                uint64        `long:"maxutxocachesize" description:"Max size of loaded UTXO into ram from the disk in bytes"`
 	UTXOIndex                       bool          `long:"utxoindex" description:"Enable the UTXO index"`
+	TXIndex                         bool          `long:"txindex" description:"Enable the transaction index"`
+	AddressIndex                    bool          `long:"addressindex" description:"Enable the address index, which keeps the history of the transactions that credited or debited each address (from the pruning point onward, unless the node has synced past it with the index enabled)"`
 	IsArchivalNode                  bool          `long:"archival" description:"Run as an archival node: don't delete old block data when moving the pruning point (Warning: heavy disk usage)'"`
 	AllowSubmitBlockWhenNotSynced   bool          `long:"allow-submit-block-when-not-synced" hidden:"true" description:"Allow the node to accept blocks from RPC while not synced (this flag is mainly used for testing)"`
 	EnableSanityCheckPruningUTXOSet bool          `long:"enable-sanity-check-pruning-utxo" hidden:"true" description:"When moving the pruning point - check that the utxo set matches the utxo commitment"`
@@ -174,25 +199,30 @@ func newConfigParser(cfgFlags *Flags, options flags.Options) *flags.Parser {
 
 func defaultFlags() *Flags {
 	return &Flags{
-		ConfigFile:           defaultConfigFile,
-		LogLevel:             defaultLogLevel,
-		TargetOutboundPeers:  defaultTargetOutboundPeers,
-		MaxInboundPeers:      defaultMaxInboundPeers,
-		BanDuration:          defaultBanDuration,
-		BanThreshold:         defaultBanThreshold,
-		RPCMaxClients:        DefaultMaxRPCClients,
-		RPCMaxWebsockets:     defaultMaxRPCWebsockets,
-		RPCMaxConcurrentReqs: defaultMaxRPCConcurrentReqs,
-		AppDir:               defaultDataDir,
-		RPCKey:               defaultRPCKeyFile,
-		RPCCert:              defaultRPCCertFile,
-		BlockMaxMass:         defaultBlockMaxMass,
-		MaxOrphanTxs:         defaultMaxOrphanTransactions,
-		SigCacheMaxSize:      defaultSigCacheMaxSize,
-		MinRelayTxFee:        defaultMinRelayTxFee,
-		MaxUTXOCacheSize:     defaultMaxUTXOCacheSize,
-		ServiceOptions:       &ServiceOptions{},
-		ProtocolVersion:      defaultProtocolVersion,
+		ConfigFile:             defaultConfigFile,
+		LogLevel:               defaultLogLevel,
+		TargetOutboundPeers:    defaultTargetOutboundPeers,
+		MaxInboundPeers:        defaultMaxInboundPeers,
+		BanDuration:            defaultBanDuration,
+		BanThreshold:           defaultBanThreshold,
+		RPCMaxClients:          DefaultMaxRPCClients,
+		RPCMaxWebsockets:       defaultMaxRPCWebsockets,
+		RPCMaxConcurrentReqs:   defaultMaxRPCConcurrentReqs,
+		RPCRateLimitBurst:      defaultRPCRateLimitBurst,
+		RPCRateLimitPerIPBurst: defaultRPCRateLimitPerIPBurst,
+		AppDir:                 defaultDataDir,
+		RPCKey:                 defaultRPCKeyFile,
+		RPCCert:                defaultRPCCertFile,
+		BlockMaxMass:           defaultBlockMaxMass,
+		MaxOrphanTxs:           defaultMaxOrphanTransactions,
+		SigCacheMaxSize:        defaultSigCacheMaxSize,
+		MinRelayTxFee:          defaultMinRelayTxFee,
+		MaxUTXOCacheSize:       defaultMaxUTXOCacheSize,
+		ServiceOptions:         &ServiceOptions{},
+		ProtocolVersion:        defaultProtocolVersion,
+		DbType:                 backends.DefaultType,
+		DbCacheSizeMiB:         defaultDbCacheSizeMiB,
+		LdbCompression:         ldb.CompressionNone,
 	}
 }
 
@@ -449,6 +479,11 @@ func LoadConfig() (*Config, error) {
 		}
 	}
 
+	// The JSON-RPC gateway is a part of the RPC server, so it's disabled along with it
+	if cfg.DisableRPC {
+		cfg.RPCListenersJSON = nil
+	}
+
 	if cfg.RPCMaxConcurrentReqs < 0 {
 		str := "%s: The rpcmaxwebsocketconcurrentrequests option may " +
 			"not be less than 0 -- parsed [%d]"
@@ -458,6 +493,33 @@ func LoadConfig() (*Config, error) {
 		return nil, err
 	}
 
+	if cfg.RPCRateLimit < 0 || cfg.RPCRateLimitBurst < 0 || cfg.RPCRateLimitPerIP < 0 || cfg.RPCRateLimitPerIPBurst < 0 {
+		str := "%s: The RPC rate limit options may not be negative"
+		err := errors.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+
+	// Validate the database type.
+	if !backends.IsSupported(cfg.DbType) {
+		str := "%s: unknown dbtype %s -- supported types are: %s"
+		err := errors.Errorf(str, funcName, cfg.DbType, strings.Join(backends.Types(), ", "))
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+
+	// Validate the database tuning options.
+	err = cfg.DatabaseOptions().LevelDB.Validate()
+	if err != nil {
+		str := "%s: invalid leveldb options: %s"
+		err := errors.Errorf(str, funcName, err)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+
 	// Validate the the minrelaytxfee.
 	cfg.MinRelayTxFee, err = util.NewAmount(cfg.Flags.MinRelayTxFee)
 	if err != nil {
@@ -595,3 +657,18 @@ func createDefaultConfigFile(destinationPath string) error {
 
 	return err
 }
+
+// DatabaseOptions returns the settings of the database backends
+func (cfg *Flags) DatabaseOptions() *backends.Options {
+	return &backends.Options{
+		LevelDB: &ldb.Config{
+			CacheSizeMiB:           cfg.DbCacheSizeMiB,
+			WriteBufferMiB:         cfg.LdbWriteBufferMiB,
+			Compression:            cfg.LdbCompression,
+			Sync:                   cfg.LdbSync,
+			CompactionL0Trigger:    cfg.LdbCompactionL0Trigger,
+			CompactionTableSizeMiB: cfg.LdbCompactionTableSizeMiB,
+			SeekCompaction:         cfg.LdbSeekCompaction,
+		},
+	}
+}
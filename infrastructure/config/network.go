@@ -34,6 +34,7 @@ type overrideDAGParamsConfig struct {
 	MassPerScriptPubKeyByte                 *uint64            `json:"massPerScriptPubKeyByte"`
 	MassPerSigOp                            *uint64            `json:"massPerSigOp"`
 	CoinbasePayloadScriptPublicKeyMaxLength *uint8             `json:"coinbasePayloadScriptPublicKeyMaxLength"`
+	CoinbasePayeesActivationBlueScore       *uint64            `json:"coinbasePayeesActivationBlueScore"`
 	PowMax                                  *string            `json:"powMax"`
 	BlockCoinbaseMaturity                   *uint64            `json:"blockCoinbaseMaturity"`
 	SubsidyGenesisReward                    *uint64            `json:"subsidyGenesisReward"`
@@ -154,6 +155,10 @@ func (networkFlags *NetworkFlags) overrideDAGParams() error {
 		networkFlags.ActiveNetParams.CoinbasePayloadScriptPublicKeyMaxLength = *config.CoinbasePayloadScriptPublicKeyMaxLength
 	}
 
+	if config.CoinbasePayeesActivationBlueScore != nil {
+		networkFlags.ActiveNetParams.CoinbasePayeesActivationBlueScore = *config.CoinbasePayeesActivationBlueScore
+	}
+
 	if config.PowMax != nil {
 		powMax, ok := big.NewInt(0).SetString(*config.PowMax, 16)
 		if !ok {
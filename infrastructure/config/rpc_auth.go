@@ -0,0 +1,38 @@
+package config
+
+import (
+	"encoding/base64"
+)
+
+// RPCAuthFlags holds the credentials an RPC client uses to authenticate
+// with an RPC server that requires authentication
+type RPCAuthFlags struct {
+	RPCUser     string `long:"rpcuser" description:"Username for RPC authentication"`
+	RPCPassword string `long:"rpcpass" default-mask:"-" description:"Password for RPC authentication"`
+	RPCToken    string `long:"rpctoken" default-mask:"-" description:"Bearer token for RPC authentication, used instead of a username and password"`
+}
+
+// RPCAuthorization returns the authorization to present to the RPC server,
+// in the format of an HTTP Authorization header, or an empty string if no
+// credentials were given
+func (rpcAuthFlags *RPCAuthFlags) RPCAuthorization() string {
+	if rpcAuthFlags.RPCToken != "" {
+		return BearerAuthorization(rpcAuthFlags.RPCToken)
+	}
+	if rpcAuthFlags.RPCUser != "" {
+		return BasicAuthorization(rpcAuthFlags.RPCUser, rpcAuthFlags.RPCPassword)
+	}
+	return ""
+}
+
+// BasicAuthorization returns the authorization an RPC client presents
+// to authenticate as the given user
+func BasicAuthorization(username string, password string) string {
+	return "Basic " + base64.StdEncoding.EncodeToString([]byte(username+":"+password))
+}
+
+// BearerAuthorization returns the authorization an RPC client presents
+// to authenticate with the given token
+func BearerAuthorization(token string) string {
+	return "Bearer " + token
+}
@@ -0,0 +1,159 @@
+// Package backends keeps the registry of the database backends, keyed by
+// the database types that are given to --dbtype.
+package backends
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/kobradag/kobrad/infrastructure/db/database"
+	"github.com/kobradag/kobrad/infrastructure/db/database/ldb"
+	"github.com/kobradag/kobrad/infrastructure/db/database/logdb"
+	"github.com/pkg/errors"
+)
+
+// DefaultType is the type of the backend that is used when no type is given
+const DefaultType = "ldb"
+
+// typeFileName is the name of the file, in the directory of a database, that
+// records the type of the database. Databases that were created before it was
+// recorded are all of the default type.
+const typeFileName = "dbtype"
+
+// Options holds the settings of the backends. Each backend uses the settings
+// that apply to it.
+type Options struct {
+	LevelDB *ldb.Config
+}
+
+// DefaultOptions returns the default settings of the backends, which may use
+// caches of the given size
+func DefaultOptions(cacheSizeMiB int) *Options {
+	return &Options{
+		LevelDB: ldb.DefaultConfig(cacheSizeMiB),
+	}
+}
+
+// OpenFunc opens the database at the given path, and creates it if it doesn't
+// exist
+type OpenFunc func(path string, options *Options) (database.Database, error)
+
+var (
+	registry = map[string]OpenFunc{
+		"ldb": func(path string, options *Options) (database.Database, error) {
+			return ldb.NewLevelDBWithConfig(path, options.LevelDB)
+		},
+		"logdb": func(path string, _ *Options) (database.Database, error) {
+			return logdb.NewLogDB(path)
+		},
+	}
+	registryLock sync.RWMutex
+)
+
+// Register adds a backend of the given type to the registry
+func Register(dbType string, open OpenFunc) error {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+
+	if _, ok := registry[dbType]; ok {
+		return errors.Errorf("a database backend of type %s is already registered", dbType)
+	}
+	registry[dbType] = open
+	return nil
+}
+
+// IsSupported returns whether there's a backend of the given type
+func IsSupported(dbType string) bool {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+
+	_, ok := registry[dbType]
+	return ok
+}
+
+// Types returns the types of the registered backends, sorted
+func Types() []string {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+
+	types := make([]string, 0, len(registry))
+	for dbType := range registry {
+		types = append(types, dbType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// Open opens the database of the given type at the given path, and creates
+// it if it doesn't exist. It fails if the database at the path was created
+// with a backend of another type.
+func Open(dbType string, path string, options *Options) (database.Database, error) {
+	registryLock.RLock()
+	open, ok := registry[dbType]
+	registryLock.RUnlock()
+	if !ok {
+		return nil, errors.Errorf("unknown database type %s. Supported types are: %s",
+			dbType, strings.Join(Types(), ", "))
+	}
+
+	existingType, err := TypeOf(path)
+	if err != nil {
+		return nil, err
+	}
+	if existingType != "" && existingType != dbType {
+		return nil, errors.Errorf("the database at %s is of type %s, and can't be opened as %s",
+			path, existingType, dbType)
+	}
+
+	db, err := open(path, options)
+	if err != nil {
+		return nil, err
+	}
+	if existingType == "" {
+		err := os.WriteFile(filepath.Join(path, typeFileName), []byte(dbType), 0600)
+		if err != nil {
+			db.Close()
+			return nil, errors.WithStack(err)
+		}
+	}
+	return db, nil
+}
+
+// OpenExisting opens the existing database at the given path with the
+// backend of its type
+func OpenExisting(path string, options *Options) (database.Database, error) {
+	dbType, err := TypeOf(path)
+	if err != nil {
+		return nil, err
+	}
+	if dbType == "" {
+		return nil, errors.Errorf("there's no database at %s", path)
+	}
+	return Open(dbType, path, options)
+}
+
+// TypeOf returns the type of the database at the given path, or an empty
+// string if there's no database there
+func TypeOf(path string) (string, error) {
+	typeBytes, err := os.ReadFile(filepath.Join(path, typeFileName))
+	if err == nil {
+		return strings.TrimSpace(string(typeBytes)), nil
+	}
+	if !os.IsNotExist(err) {
+		return "", errors.WithStack(err)
+	}
+
+	// A database of the default type that was created before the type
+	// was recorded
+	_, err = os.Stat(filepath.Join(path, "CURRENT"))
+	if err == nil {
+		return DefaultType, nil
+	}
+	if !os.IsNotExist(err) {
+		return "", errors.WithStack(err)
+	}
+	return "", nil
+}
@@ -0,0 +1,84 @@
+package backends
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestOpenRecordsType(t *testing.T) {
+	path := t.TempDir()
+
+	_, err := Open("nosuchdb", path, DefaultOptions(8))
+	if err == nil || !strings.Contains(err.Error(), "unknown database type") {
+		t.Fatalf("Expected an unknown database type error, but got %v", err)
+	}
+
+	db, err := Open("logdb", path, DefaultOptions(8))
+	if err != nil {
+		t.Fatalf("Open: %+v", err)
+	}
+	err = db.Close()
+	if err != nil {
+		t.Fatalf("Close: %+v", err)
+	}
+
+	dbType, err := TypeOf(path)
+	if err != nil {
+		t.Fatalf("TypeOf: %+v", err)
+	}
+	if dbType != "logdb" {
+		t.Fatalf("Expected the database type to be logdb, but got %s", dbType)
+	}
+
+	// A database can't be opened with a backend of another type
+	_, err = Open("ldb", path, DefaultOptions(8))
+	if err == nil || !strings.Contains(err.Error(), "is of type logdb") {
+		t.Fatalf("Expected a database type mismatch error, but got %v", err)
+	}
+
+	db, err = OpenExisting(path, DefaultOptions(8))
+	if err != nil {
+		t.Fatalf("OpenExisting: %+v", err)
+	}
+	err = db.Close()
+	if err != nil {
+		t.Fatalf("Close: %+v", err)
+	}
+}
+
+func TestTypeOfLegacyDatabase(t *testing.T) {
+	path := t.TempDir()
+
+	dbType, err := TypeOf(path)
+	if err != nil {
+		t.Fatalf("TypeOf: %+v", err)
+	}
+	if dbType != "" {
+		t.Fatalf("Expected no database type for an empty directory, but got %s", dbType)
+	}
+
+	// Databases that were created before the type was recorded are
+	// LevelDB databases
+	db, err := Open("ldb", path, DefaultOptions(8))
+	if err != nil {
+		t.Fatalf("Open: %+v", err)
+	}
+	err = db.Close()
+	if err != nil {
+		t.Fatalf("Close: %+v", err)
+	}
+	err = os.Remove(filepath.Join(path, typeFileName))
+	if err != nil {
+		t.Fatalf("Remove: %+v", err)
+	}
+
+	dbType, err = TypeOf(path)
+	if err != nil {
+		t.Fatalf("TypeOf: %+v", err)
+	}
+	if dbType != DefaultType {
+		t.Fatalf("Expected the type of a legacy database to be %s, but got %s", DefaultType, dbType)
+	}
+}
@@ -14,6 +14,10 @@ type Database interface {
 	// Begin begins a new database transaction.
 	Begin() (Transaction, error)
 
+	// Snapshot takes a consistent read-only view of the database
+	// instance, which isn't affected by the writes that follow it.
+	Snapshot() (Snapshot, error)
+
 	// Compact compacts the database instance.
 	Compact() error
 
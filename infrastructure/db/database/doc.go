@@ -6,10 +6,14 @@ Package database provides a database for kobrad.
 This package provides a database layer to store and retrieve data in a simple
 and efficient manner.
 
-The current backend is ffldb, which makes use of leveldb, flat files, and strict
-checksums in key areas to ensure data integrity.
-
-Implementors of additional backends are required to implement the following interfaces:
+The backend is chosen with --dbtype, out of the backends in the registry of the
+backends package: ldb, the default, which makes use of goleveldb, and logdb, which
+appends every batch of changes to a checksummed log file and keeps the locations of
+the values in an in-memory index. An existing database can only be opened with the
+backend that created it.
+
+Implementors of additional backends are required to implement the following interfaces,
+and to register the backend in the backends package:
 
 # DataAccessor
 
@@ -5,7 +5,6 @@ import (
 	"github.com/pkg/errors"
 	"github.com/syndtr/goleveldb/leveldb"
 	ldbErrors "github.com/syndtr/goleveldb/leveldb/errors"
-	"github.com/syndtr/goleveldb/leveldb/opt"
 	"github.com/syndtr/goleveldb/leveldb/util"
 )
 
@@ -16,18 +15,27 @@ type LevelDB struct {
 
 // NewLevelDB opens a leveldb instance defined by the given path.
 func NewLevelDB(path string, cacheSizeMiB int) (*LevelDB, error) {
+	return NewLevelDBWithConfig(path, DefaultConfig(cacheSizeMiB))
+}
+
+// NewLevelDBWithConfig opens a leveldb instance defined by the given path,
+// tuned by the given config.
+func NewLevelDBWithConfig(path string, config *Config) (*LevelDB, error) {
+	err := config.Validate()
+	if err != nil {
+		return nil, err
+	}
+
 	// Open leveldb. If it doesn't exist, create it.
-	options := Options()
-	options.BlockCacheCapacity = cacheSizeMiB * opt.MiB
-	options.WriteBuffer = (cacheSizeMiB * opt.MiB) / 2
-	ldb, err := leveldb.OpenFile(path, &options)
+	options := config.options()
+	ldb, err := leveldb.OpenFile(path, options)
 
 	// If the database is corrupted, attempt to recover.
 	if _, corrupted := err.(*ldbErrors.ErrCorrupted); corrupted {
 		log.Warnf("LevelDB corruption detected for path %s: %s",
 			path, err)
 		var recoverErr error
-		ldb, recoverErr = leveldb.RecoverFile(path, nil)
+		ldb, recoverErr = leveldb.RecoverFile(path, options)
 		if recoverErr != nil {
 			return nil, errors.Wrapf(err, "failed recovering from "+
 				"database corruption: %s", recoverErr)
@@ -63,6 +71,8 @@ func (db *LevelDB) Close() error {
 // Put sets the value for the given key. It overwrites
 // any previous value for that key.
 func (db *LevelDB) Put(key *database.Key, value []byte) error {
+	defer writeDuration.MeasureExecutionTime()()
+
 	err := db.ldb.Put(key.Bytes(), value, nil)
 	return errors.WithStack(err)
 }
@@ -0,0 +1,12 @@
+package ldb
+
+import (
+	"github.com/kobradag/kobrad/infrastructure/metrics"
+)
+
+var (
+	writeDuration = metrics.NewHistogram("kobrad_db_write_duration_seconds",
+		"The time it took to write single keys to the database", metrics.DurationBuckets)
+	commitDuration = metrics.NewHistogram("kobrad_db_commit_duration_seconds",
+		"The time it took to commit database transactions", metrics.DurationBuckets)
+)
@@ -1,6 +1,18 @@
 package ldb
 
-import "github.com/syndtr/goleveldb/leveldb/opt"
+import (
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+const (
+	// CompressionNone stores the tables uncompressed
+	CompressionNone = "none"
+
+	// CompressionSnappy compresses the tables with snappy, which saves disk
+	// space at the cost of some CPU time
+	CompressionSnappy = "snappy"
+)
 
 // Options is a function that returns a leveldb
 // opt.Options struct for opening a database.
@@ -11,3 +23,80 @@ func Options() opt.Options {
 		NoSync:                 true,
 	}
 }
+
+// Config holds the tunable settings of a leveldb instance
+type Config struct {
+	// CacheSizeMiB is the size of the block cache
+	CacheSizeMiB int
+
+	// WriteBufferMiB is the size of the memtable, which is written to a
+	// level 0 table once it's full. Zero means half of the cache size.
+	WriteBufferMiB int
+
+	// Compression is either CompressionNone or CompressionSnappy
+	Compression string
+
+	// Sync makes every write wait until it's flushed to the disk, so that
+	// it survives a crash of the machine and not only of the process
+	Sync bool
+
+	// CompactionL0Trigger is the number of level 0 tables that triggers a
+	// compaction of level 0. Zero means the leveldb default.
+	CompactionL0Trigger int
+
+	// CompactionTableSizeMiB is the size of the tables that compactions
+	// produce. Zero means the leveldb default.
+	CompactionTableSizeMiB int
+
+	// SeekCompaction enables compactions that are triggered by reads that
+	// seek through too many tables
+	SeekCompaction bool
+}
+
+// DefaultConfig returns the config that NewLevelDB uses, with a block cache
+// of the given size
+func DefaultConfig(cacheSizeMiB int) *Config {
+	return &Config{
+		CacheSizeMiB: cacheSizeMiB,
+		Compression:  CompressionNone,
+	}
+}
+
+// Validate returns an error if the config has invalid settings
+func (config *Config) Validate() error {
+	if config.CacheSizeMiB <= 0 {
+		return errors.Errorf("the cache size must be positive, got %d MiB", config.CacheSizeMiB)
+	}
+	if config.WriteBufferMiB < 0 {
+		return errors.Errorf("the write buffer size must not be negative, got %d MiB", config.WriteBufferMiB)
+	}
+	if config.Compression != CompressionNone && config.Compression != CompressionSnappy {
+		return errors.Errorf("unknown compression %s -- supported compressions are: %s, %s",
+			config.Compression, CompressionNone, CompressionSnappy)
+	}
+	if config.CompactionL0Trigger < 0 {
+		return errors.Errorf("the compaction L0 trigger must not be negative, got %d", config.CompactionL0Trigger)
+	}
+	if config.CompactionTableSizeMiB < 0 {
+		return errors.Errorf("the compaction table size must not be negative, got %d MiB", config.CompactionTableSizeMiB)
+	}
+	return nil
+}
+
+// options returns the leveldb options of the config
+func (config *Config) options() *opt.Options {
+	options := Options()
+	options.BlockCacheCapacity = config.CacheSizeMiB * opt.MiB
+	options.WriteBuffer = config.WriteBufferMiB * opt.MiB
+	if options.WriteBuffer == 0 {
+		options.WriteBuffer = options.BlockCacheCapacity / 2
+	}
+	if config.Compression == CompressionSnappy {
+		options.Compression = opt.SnappyCompression
+	}
+	options.NoSync = !config.Sync
+	options.CompactionL0Trigger = config.CompactionL0Trigger
+	options.CompactionTableSize = config.CompactionTableSizeMiB * opt.MiB
+	options.DisableSeeksCompaction = !config.SeekCompaction
+	return &options
+}
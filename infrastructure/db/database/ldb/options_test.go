@@ -0,0 +1,78 @@
+package ldb
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/kobradag/kobrad/infrastructure/db/database"
+)
+
+func TestLevelDBWithConfig(t *testing.T) {
+	config := &Config{
+		CacheSizeMiB:           8,
+		WriteBufferMiB:         1,
+		Compression:            CompressionSnappy,
+		Sync:                   true,
+		CompactionL0Trigger:    2,
+		CompactionTableSizeMiB: 1,
+		SeekCompaction:         true,
+	}
+	ldb, err := NewLevelDBWithConfig(t.TempDir(), config)
+	if err != nil {
+		t.Fatalf("NewLevelDBWithConfig unexpectedly failed: %s", err)
+	}
+	defer ldb.Close()
+
+	bucket := database.MakeBucket([]byte("bucket"))
+	value := bytes.Repeat([]byte{1}, 1024)
+	for i := 0; i < 2048; i++ {
+		err := ldb.Put(bucket.Key([]byte{byte(i >> 8), byte(i)}), value)
+		if err != nil {
+			t.Fatalf("Put unexpectedly failed: %s", err)
+		}
+	}
+	err = ldb.Compact()
+	if err != nil {
+		t.Fatalf("Compact unexpectedly failed: %s", err)
+	}
+
+	stats, err := ldb.Stats()
+	if err != nil {
+		t.Fatalf("Stats unexpectedly failed: %s", err)
+	}
+	totalSize := int64(0)
+	for _, level := range stats.Levels {
+		totalSize += level.Size
+	}
+	// The values compress well, so the tables must be much smaller than
+	// the 2 MiB that was written
+	if totalSize == 0 || totalSize > 1024*1024 {
+		t.Fatalf("Unexpected total size of the levels %d", totalSize)
+	}
+	if stats.MemCompactionCount == 0 {
+		t.Fatalf("Expected the memtable to have been compacted")
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(config *Config)
+		expectOK bool
+	}{
+		{name: "default", modify: func(*Config) {}, expectOK: true},
+		{name: "zero cache", modify: func(config *Config) { config.CacheSizeMiB = 0 }},
+		{name: "negative write buffer", modify: func(config *Config) { config.WriteBufferMiB = -1 }},
+		{name: "unknown compression", modify: func(config *Config) { config.Compression = "zstd" }},
+		{name: "negative L0 trigger", modify: func(config *Config) { config.CompactionL0Trigger = -1 }},
+		{name: "negative table size", modify: func(config *Config) { config.CompactionTableSizeMiB = -1 }},
+	}
+	for _, test := range tests {
+		config := DefaultConfig(8)
+		test.modify(config)
+		err := config.Validate()
+		if (err == nil) != test.expectOK {
+			t.Errorf("%s: Validate returned %v, expected success: %t", test.name, err, test.expectOK)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package ldb
+
+import (
+	"github.com/kobradag/kobrad/infrastructure/db/database"
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+// Stats returns the statistics of the levels, the compactions and the IO
+// of the leveldb instance.
+func (db *LevelDB) Stats() (*database.Stats, error) {
+	var ldbStats leveldb.DBStats
+	err := db.ldb.Stats(&ldbStats)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	levels := make([]*database.LevelStats, len(ldbStats.LevelSizes))
+	for level := range levels {
+		levels[level] = &database.LevelStats{
+			Level:              level,
+			TableCount:         ldbStats.LevelTablesCounts[level],
+			Size:               ldbStats.LevelSizes[level],
+			ReadBytes:          ldbStats.LevelRead[level],
+			WriteBytes:         ldbStats.LevelWrite[level],
+			CompactionDuration: ldbStats.LevelDurations[level],
+		}
+	}
+
+	return &database.Stats{
+		Levels:                   levels,
+		MemCompactionCount:       ldbStats.MemComp,
+		Level0CompactionCount:    ldbStats.Level0Comp,
+		NonLevel0CompactionCount: ldbStats.NonLevel0Comp,
+		SeekCompactionCount:      ldbStats.SeekComp,
+		WriteDelayCount:          ldbStats.WriteDelayCount,
+		WriteDelayDuration:       ldbStats.WriteDelayDuration,
+		IsWritePaused:            ldbStats.WritePaused,
+		ReadBytes:                ldbStats.IORead,
+		WriteBytes:               ldbStats.IOWrite,
+		BlockCacheSize:           ldbStats.BlockCacheSize,
+		OpenedTableCount:         ldbStats.OpenedTablesCount,
+		AliveSnapshotCount:       ldbStats.AliveSnapshots,
+		AliveIteratorCount:       ldbStats.AliveIterators,
+	}, nil
+}
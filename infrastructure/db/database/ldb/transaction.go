@@ -40,6 +40,7 @@ func (tx *LevelDBTransaction) Commit() error {
 	}
 
 	tx.isClosed = true
+	defer commitDuration.MeasureExecutionTime()()
 	return errors.WithStack(tx.db.ldb.Write(tx.batch, nil))
 }
 
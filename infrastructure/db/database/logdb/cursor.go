@@ -0,0 +1,147 @@
+package logdb
+
+import (
+	"bytes"
+
+	"github.com/kobradag/kobrad/infrastructure/db/database"
+	"github.com/pkg/errors"
+)
+
+// LogDBCursor iterates over a snapshot of the index, which is taken
+// when the cursor is opened.
+type LogDBCursor struct {
+	iterator *indexIterator
+	segment  *segment
+	bucket   *database.Bucket
+
+	// isStarted is false until the cursor is first moved, in which
+	// case Next moves it to the first key/value pair
+	isStarted bool
+	isClosed  bool
+}
+
+// Cursor begins a new cursor over the given prefix.
+func (db *LogDB) Cursor(bucket *database.Bucket) (database.Cursor, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.isClosed {
+		return nil, errors.New("cannot open a cursor from a closed database")
+	}
+
+	// The cursor holds the segment, so that it isn't removed by a
+	// compaction while it's read from
+	db.segment.acquire()
+	return &LogDBCursor{
+		iterator: newIndexIterator(db.root),
+		segment:  db.segment,
+		bucket:   bucket,
+		isClosed: false,
+	}, nil
+}
+
+// Next moves the iterator to the next key/value pair. It returns whether the
+// iterator is exhausted. Panics if the cursor is closed.
+func (c *LogDBCursor) Next() bool {
+	if c.isClosed {
+		panic("cannot call next on a closed cursor")
+	}
+	if !c.isStarted {
+		return c.First()
+	}
+	return c.iterator.next() && c.isInBucket()
+}
+
+// First moves the iterator to the first key/value pair. It returns false if
+// such a pair does not exist. Panics if the cursor is closed.
+func (c *LogDBCursor) First() bool {
+	if c.isClosed {
+		panic("cannot call first on a closed cursor")
+	}
+	c.isStarted = true
+	return c.iterator.seek(c.bucket.Path()) && c.isInBucket()
+}
+
+// Seek moves the iterator to the first key/value pair whose key is greater
+// than or equal to the given key. It returns ErrNotFound if such pair does not
+// exist.
+func (c *LogDBCursor) Seek(key *database.Key) error {
+	if c.isClosed {
+		return errors.New("cannot seek a closed cursor")
+	}
+	c.isStarted = true
+
+	keyBytes := key.Bytes()
+	seekKey := keyBytes
+	if bytes.Compare(seekKey, c.bucket.Path()) < 0 {
+		seekKey = c.bucket.Path()
+	}
+	found := c.iterator.seek(seekKey) && c.isInBucket()
+	if !found {
+		return errors.Wrapf(database.ErrNotFound, "key %s not found", key)
+	}
+
+	if !bytes.Equal(c.iterator.current().key, keyBytes) {
+		return errors.Wrapf(database.ErrNotFound, "key %s not found", key)
+	}
+
+	return nil
+}
+
+// isInBucket returns whether the iterator is at a key of the bucket of the
+// cursor. The keys of the bucket are consecutive, so once the iterator passes
+// them, it's exhausted.
+func (c *LogDBCursor) isInBucket() bool {
+	node := c.iterator.current()
+	if node == nil {
+		return false
+	}
+	if !bytes.HasPrefix(node.key, c.bucket.Path()) {
+		c.iterator.stack = c.iterator.stack[:0]
+		return false
+	}
+	return true
+}
+
+// Key returns the key of the current key/value pair, or ErrNotFound if done.
+// Note that the key is trimmed to not include the prefix the cursor was opened
+// with. The caller should not modify the contents of the returned slice, and
+// its contents may change on the next call to Next.
+func (c *LogDBCursor) Key() (*database.Key, error) {
+	if c.isClosed {
+		return nil, errors.New("cannot get the key of a closed cursor")
+	}
+	node := c.iterator.current()
+	if node == nil {
+		return nil, errors.Wrapf(database.ErrNotFound, "cannot get the "+
+			"key of an exhausted cursor")
+	}
+	suffix := bytes.TrimPrefix(node.key, c.bucket.Path())
+	return c.bucket.Key(suffix), nil
+}
+
+// Value returns the value of the current key/value pair, or ErrNotFound if done.
+// The caller should not modify the contents of the returned slice, and its
+// contents may change on the next call to Next.
+func (c *LogDBCursor) Value() ([]byte, error) {
+	if c.isClosed {
+		return nil, errors.New("cannot get the value of a closed cursor")
+	}
+	node := c.iterator.current()
+	if node == nil {
+		return nil, errors.Wrapf(database.ErrNotFound, "cannot get the "+
+			"value of an exhausted cursor")
+	}
+	return c.segment.read(node.location)
+}
+
+// Close releases associated resources.
+func (c *LogDBCursor) Close() error {
+	if c.isClosed {
+		return errors.New("cannot close an already closed cursor")
+	}
+	c.isClosed = true
+	c.iterator = nil
+	c.bucket = nil
+	return c.segment.release()
+}
@@ -0,0 +1,198 @@
+package logdb
+
+import (
+	"bytes"
+	"math/rand"
+)
+
+// valueLocation is the location of a value in the data file
+type valueLocation struct {
+	offset int64
+	length uint32
+}
+
+// indexNode is a node of a persistent treap that maps keys to the locations
+// of their values. Nodes are never modified once they are part of a tree:
+// every modification copies the nodes along its path and returns a new root,
+// so any root that was taken before the modification remains a consistent
+// snapshot of the index.
+type indexNode struct {
+	key      []byte
+	location valueLocation
+	priority uint32
+	left     *indexNode
+	right    *indexNode
+}
+
+// indexGet returns the node of the given key, or nil if the key isn't in
+// the tree with the given root
+func indexGet(root *indexNode, key []byte) *indexNode {
+	node := root
+	for node != nil {
+		comparison := bytes.Compare(key, node.key)
+		switch {
+		case comparison < 0:
+			node = node.left
+		case comparison > 0:
+			node = node.right
+		default:
+			return node
+		}
+	}
+	return nil
+}
+
+// indexPut returns the root of a tree that maps the given key to the given
+// location in addition to the mappings of the tree with the given root. It
+// also returns the node that was replaced, if the key was already mapped.
+func indexPut(root *indexNode, key []byte, location valueLocation) (newRoot *indexNode, replaced *indexNode) {
+	if root == nil {
+		return &indexNode{key: key, location: location, priority: rand.Uint32()}, nil
+	}
+
+	comparison := bytes.Compare(key, root.key)
+	if comparison == 0 {
+		newRoot = root.clone()
+		newRoot.key = key
+		newRoot.location = location
+		return newRoot, root
+	}
+
+	newRoot = root.clone()
+	if comparison < 0 {
+		newRoot.left, replaced = indexPut(root.left, key, location)
+		if newRoot.left.priority > newRoot.priority {
+			newRoot = rotateRight(newRoot)
+		}
+	} else {
+		newRoot.right, replaced = indexPut(root.right, key, location)
+		if newRoot.right.priority > newRoot.priority {
+			newRoot = rotateLeft(newRoot)
+		}
+	}
+	return newRoot, replaced
+}
+
+// indexDelete returns the root of a tree that has the mappings of the tree
+// with the given root, except for the given key. It also returns the node
+// that was removed, or nil if the key wasn't mapped.
+func indexDelete(root *indexNode, key []byte) (newRoot *indexNode, removed *indexNode) {
+	if root == nil {
+		return nil, nil
+	}
+
+	comparison := bytes.Compare(key, root.key)
+	if comparison == 0 {
+		return mergeIndexNodes(root.left, root.right), root
+	}
+
+	if comparison < 0 {
+		left, removed := indexDelete(root.left, key)
+		if removed == nil {
+			return root, nil
+		}
+		newRoot = root.clone()
+		newRoot.left = left
+		return newRoot, removed
+	}
+
+	right, removed := indexDelete(root.right, key)
+	if removed == nil {
+		return root, nil
+	}
+	newRoot = root.clone()
+	newRoot.right = right
+	return newRoot, removed
+}
+
+// mergeIndexNodes merges two trees, where all the keys of left are smaller
+// than all the keys of right
+func mergeIndexNodes(left *indexNode, right *indexNode) *indexNode {
+	if left == nil {
+		return right
+	}
+	if right == nil {
+		return left
+	}
+
+	if left.priority > right.priority {
+		newLeft := left.clone()
+		newLeft.right = mergeIndexNodes(left.right, right)
+		return newLeft
+	}
+	newRight := right.clone()
+	newRight.left = mergeIndexNodes(left, right.left)
+	return newRight
+}
+
+// rotateRight and rotateLeft rotate a node that was already copied, and copy
+// the child that takes its place
+func rotateRight(node *indexNode) *indexNode {
+	newRoot := node.left.clone()
+	node.left = newRoot.right
+	newRoot.right = node
+	return newRoot
+}
+
+func rotateLeft(node *indexNode) *indexNode {
+	newRoot := node.right.clone()
+	node.right = newRoot.left
+	newRoot.left = node
+	return newRoot
+}
+
+func (n *indexNode) clone() *indexNode {
+	clone := *n
+	return &clone
+}
+
+// indexIterator iterates over the nodes of a tree in key order
+type indexIterator struct {
+	root *indexNode
+
+	// stack holds the current node on top of its ancestors whose keys are
+	// greater than its key, which are the next ones to visit
+	stack []*indexNode
+}
+
+func newIndexIterator(root *indexNode) *indexIterator {
+	return &indexIterator{root: root}
+}
+
+// seek moves the iterator to the first node whose key is greater than or
+// equal to the given key. It returns false if there's no such node.
+func (it *indexIterator) seek(key []byte) bool {
+	it.stack = it.stack[:0]
+	node := it.root
+	for node != nil {
+		if bytes.Compare(node.key, key) >= 0 {
+			it.stack = append(it.stack, node)
+			node = node.left
+		} else {
+			node = node.right
+		}
+	}
+	return len(it.stack) > 0
+}
+
+// next moves the iterator to the following node. It returns false if there
+// is no such node.
+func (it *indexIterator) next() bool {
+	if len(it.stack) == 0 {
+		return false
+	}
+	node := it.stack[len(it.stack)-1]
+	it.stack = it.stack[:len(it.stack)-1]
+	for node = node.right; node != nil; node = node.left {
+		it.stack = append(it.stack, node)
+	}
+	return len(it.stack) > 0
+}
+
+// current returns the node the iterator is at, or nil if it's exhausted
+func (it *indexIterator) current() *indexNode {
+	if len(it.stack) == 0 {
+		return nil
+	}
+	return it.stack[len(it.stack)-1]
+}
@@ -0,0 +1,95 @@
+package logdb
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestIndex(t *testing.T) {
+	random := rand.New(rand.NewSource(0))
+	expected := make(map[string]int64)
+	var root *indexNode
+
+	for i := 0; i < 5000; i++ {
+		key := fmt.Sprintf("%04d", random.Intn(1000))
+
+		// Modifications must leave the snapshots that were taken before
+		// them intact
+		snapshot := root
+		var snapshotEntries []indexEntry
+		isSnapshotChecked := i%50 == 0
+		if isSnapshotChecked {
+			snapshotEntries = indexEntries(snapshot)
+		}
+
+		if random.Intn(3) == 0 {
+			var removed *indexNode
+			root, removed = indexDelete(root, []byte(key))
+			if _, ok := expected[key]; ok != (removed != nil) {
+				t.Fatalf("indexDelete of %s returned removed node %v, while the key exists: %t", key, removed, ok)
+			}
+			delete(expected, key)
+		} else {
+			location := valueLocation{offset: int64(i)}
+			var replaced *indexNode
+			root, replaced = indexPut(root, []byte(key), location)
+			if _, ok := expected[key]; ok != (replaced != nil) {
+				t.Fatalf("indexPut of %s returned replaced node %v, while the key exists: %t", key, replaced, ok)
+			}
+			expected[key] = location.offset
+		}
+
+		if isSnapshotChecked && fmt.Sprint(indexEntries(snapshot)) != fmt.Sprint(snapshotEntries) {
+			t.Fatalf("A snapshot of the index changed after modifying the index")
+		}
+	}
+
+	expectedKeys := make([]string, 0, len(expected))
+	for key := range expected {
+		expectedKeys = append(expectedKeys, key)
+	}
+	sort.Strings(expectedKeys)
+
+	entries := indexEntries(root)
+	if len(entries) != len(expectedKeys) {
+		t.Fatalf("Expected %d entries but got %d", len(expectedKeys), len(entries))
+	}
+	for i, entry := range entries {
+		if entry.key != expectedKeys[i] || entry.offset != expected[entry.key] {
+			t.Fatalf("Entry %d is %s:%d, want %s:%d", i, entry.key, entry.offset, expectedKeys[i], expected[expectedKeys[i]])
+		}
+		node := indexGet(root, []byte(entry.key))
+		if node == nil || node.location.offset != entry.offset {
+			t.Fatalf("indexGet of %s returned %v", entry.key, node)
+		}
+	}
+
+	// Seeking to a missing key moves the iterator to the key after it
+	iterator := newIndexIterator(root)
+	if len(expectedKeys) > 1 {
+		seekKey := expectedKeys[0] + "0"
+		if !iterator.seek([]byte(seekKey)) || string(iterator.current().key) != expectedKeys[1] {
+			t.Fatalf("Seeking to %s didn't move the iterator to %s", seekKey, expectedKeys[1])
+		}
+	}
+	if iterator.seek([]byte("9999z")) {
+		t.Fatalf("Seeking after the last key unexpectedly succeeded")
+	}
+}
+
+type indexEntry struct {
+	key    string
+	offset int64
+}
+
+func indexEntries(root *indexNode) []indexEntry {
+	var entries []indexEntry
+	iterator := newIndexIterator(root)
+	for isValid := iterator.seek(nil); isValid; isValid = iterator.next() {
+		node := iterator.current()
+		entries = append(entries, indexEntry{key: string(node.key), offset: node.location.offset})
+	}
+	return entries
+}
@@ -0,0 +1,7 @@
+package logdb
+
+import (
+	"github.com/kobradag/kobrad/infrastructure/logger"
+)
+
+var log = logger.RegisterSubSystem("KSDB")
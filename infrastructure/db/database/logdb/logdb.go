@@ -0,0 +1,316 @@
+package logdb
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/gofrs/flock"
+	"github.com/kobradag/kobrad/infrastructure/db/database"
+	"github.com/pkg/errors"
+)
+
+// minGarbageSizeForCompactionOnOpen is the minimal size of the data that
+// was overwritten or deleted, above which the database is compacted when it
+// is opened, if it also exceeds the size of the live data
+const minGarbageSizeForCompactionOnOpen = 64 * 1024 * 1024
+
+// LogDB is a database that appends every batch of changes to a log file,
+// and keeps the locations of the values in the log in an in-memory index,
+// in the style of Bitcask. Reads cost a single read from the log file, and
+// writes a single append to it. The index holds every key, so it's rebuilt
+// from the log whenever the database is opened, and the log keeps growing
+// with overwritten and deleted data until the database is compacted.
+type LogDB struct {
+	path     string
+	fileLock *flock.Flock
+
+	// writeLock serializes the writes to the active segment
+	writeLock sync.Mutex
+
+	// lock guards the index root and the active segment, which readers
+	// take together
+	lock    sync.RWMutex
+	root    *indexNode
+	segment *segment
+
+	// liveSize is the size of the keys and values in the index. The rest
+	// of the size of the segment is garbage, which compaction removes.
+	liveSize int64
+
+	isClosed bool
+}
+
+// NewLogDB opens a logdb instance defined by the given path. There's no
+// cache to size, since values are read from the log file through the cache
+// of the operating system.
+func NewLogDB(path string) (*LogDB, error) {
+	err := os.MkdirAll(path, 0700)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	fileLock := flock.New(filepath.Join(path, "LOCK"))
+	isLocked, err := fileLock.TryLock()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if !isLocked {
+		return nil, errors.Errorf("the database at %s is used by another process", path)
+	}
+
+	db := &LogDB{
+		path:     path,
+		fileLock: fileLock,
+	}
+	err = db.load()
+	if err != nil {
+		fileLock.Unlock()
+		return nil, err
+	}
+
+	garbageSize := db.segment.size - int64(len(segmentMagic)) - db.liveSize
+	if garbageSize > minGarbageSizeForCompactionOnOpen && garbageSize > db.liveSize {
+		log.Infof("Compacting the database at %s", path)
+		err := db.Compact()
+		if err != nil {
+			db.Close()
+			return nil, err
+		}
+	}
+	return db, nil
+}
+
+// load opens the latest segment and builds the index out of it, or creates
+// the first segment of a new database
+func (db *LogDB) load() error {
+	id, found, err := latestSegmentID(db.path)
+	if err != nil {
+		return err
+	}
+	if !found {
+		db.segment, err = createSegment(1, filepath.Join(db.path, segmentFileName(1)))
+		return err
+	}
+
+	db.segment, err = openSegment(id, filepath.Join(db.path, segmentFileName(id)),
+		func(operationType byte, key []byte, location valueLocation) {
+			// The key is copied so that the index doesn't keep the whole
+			// record it was read from in memory
+			db.apply(operationType, append([]byte(nil), key...), location)
+		})
+	return err
+}
+
+// apply applies a single operation to the index
+func (db *LogDB) apply(operationType byte, key []byte, location valueLocation) {
+	var previous *indexNode
+	if operationType == operationPut {
+		db.root, previous = indexPut(db.root, key, location)
+		db.liveSize += int64(len(key)) + int64(location.length)
+	} else {
+		db.root, previous = indexDelete(db.root, key)
+	}
+	if previous != nil {
+		db.liveSize -= int64(len(previous.key)) + int64(previous.location.length)
+	}
+}
+
+// write appends the given batch to the log and applies it to the index
+func (db *LogDB) write(b *batch) error {
+	if len(b.operations) == 0 {
+		return nil
+	}
+
+	db.writeLock.Lock()
+	defer db.writeLock.Unlock()
+
+	if db.isClosed {
+		return errors.New("cannot write to a closed database")
+	}
+
+	payloadOffset, err := db.segment.append(b)
+	if err != nil {
+		return err
+	}
+
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	for _, operation := range b.operations {
+		// The keys are copied, since they are owned by the callers
+		key := append([]byte(nil), operation.key...)
+		db.apply(operation.operationType, key, valueLocation{
+			offset: payloadOffset + int64(operation.valuePosition),
+			length: uint32(operation.valueLength),
+		})
+	}
+	return nil
+}
+
+// Compact rewrites the live data of the database into a new log file, and
+// removes the old one once the cursors that read from it are closed. Writes
+// wait until the compaction is done, while reads continue from the old log.
+func (db *LogDB) Compact() error {
+	db.writeLock.Lock()
+	defer db.writeLock.Unlock()
+
+	if db.isClosed {
+		return errors.New("cannot compact a closed database")
+	}
+
+	db.lock.RLock()
+	root := db.root
+	oldSegment := db.segment
+	db.lock.RUnlock()
+
+	newID := oldSegment.id + 1
+	tempPath := filepath.Join(db.path, segmentFileName(newID)+".tmp")
+	newSegment, err := createSegment(newID, tempPath)
+	if err != nil {
+		return err
+	}
+	newRoot, newLiveSize, err := copyLiveData(root, oldSegment, newSegment)
+	if err == nil {
+		err = errors.WithStack(newSegment.file.Sync())
+	}
+	if err == nil {
+		newSegment.path = filepath.Join(db.path, segmentFileName(newID))
+		err = errors.WithStack(os.Rename(tempPath, newSegment.path))
+	}
+	if err != nil {
+		newSegment.file.Close()
+		os.Remove(tempPath)
+		return err
+	}
+
+	db.lock.Lock()
+	db.root = newRoot
+	db.segment = newSegment
+	db.liveSize = newLiveSize
+	db.lock.Unlock()
+
+	oldSegment.markObsolete()
+	return oldSegment.release()
+}
+
+// copyLiveData writes the data of the index with the given root from one
+// segment to another, in batches, and returns the index of the new segment
+func copyLiveData(root *indexNode, from *segment, to *segment) (*indexNode, int64, error) {
+	const maxBatchSize = 4 * 1024 * 1024
+
+	var newRoot *indexNode
+	liveSize := int64(0)
+	b := &batch{}
+	flush := func() error {
+		payloadOffset, err := to.append(b)
+		if err != nil {
+			return err
+		}
+		for _, operation := range b.operations {
+			location := valueLocation{
+				offset: payloadOffset + int64(operation.valuePosition),
+				length: uint32(operation.valueLength),
+			}
+			newRoot, _ = indexPut(newRoot, operation.key, location)
+			liveSize += int64(len(operation.key)) + int64(location.length)
+		}
+		b.reset()
+		return nil
+	}
+
+	iterator := newIndexIterator(root)
+	for isValid := iterator.seek(nil); isValid; isValid = iterator.next() {
+		node := iterator.current()
+		value, err := from.read(node.location)
+		if err != nil {
+			return nil, 0, err
+		}
+		b.put(node.key, value)
+		if len(b.payload) >= maxBatchSize {
+			err := flush()
+			if err != nil {
+				return nil, 0, err
+			}
+		}
+	}
+	if len(b.operations) > 0 {
+		err := flush()
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+	return newRoot, liveSize, nil
+}
+
+// Close closes the logdb instance.
+func (db *LogDB) Close() error {
+	db.writeLock.Lock()
+	defer db.writeLock.Unlock()
+
+	db.lock.Lock()
+	if db.isClosed {
+		db.lock.Unlock()
+		return errors.New("cannot close an already closed database")
+	}
+	db.isClosed = true
+	db.lock.Unlock()
+
+	err := db.segment.file.Sync()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	err = db.segment.release()
+	if err != nil {
+		return err
+	}
+	return errors.WithStack(db.fileLock.Unlock())
+}
+
+// Put sets the value for the given key. It overwrites
+// any previous value for that key.
+func (db *LogDB) Put(key *database.Key, value []byte) error {
+	defer writeDuration.MeasureExecutionTime()()
+
+	b := &batch{}
+	b.put(key.Bytes(), value)
+	return db.write(b)
+}
+
+// Get gets the value for the given key. It returns
+// ErrNotFound if the given key does not exist.
+func (db *LogDB) Get(key *database.Key) ([]byte, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.isClosed {
+		return nil, errors.New("cannot get from a closed database")
+	}
+
+	node := indexGet(db.root, key.Bytes())
+	if node == nil {
+		return nil, errors.Wrapf(database.ErrNotFound, "key %s not found", key)
+	}
+	return db.segment.read(node.location)
+}
+
+// Has returns true if the database does contains the
+// given key.
+func (db *LogDB) Has(key *database.Key) (bool, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.isClosed {
+		return false, errors.New("cannot has from a closed database")
+	}
+
+	return indexGet(db.root, key.Bytes()) != nil, nil
+}
+
+// Delete deletes the value for the given key. Will not
+// return an error if the key doesn't exist.
+func (db *LogDB) Delete(key *database.Key) error {
+	b := &batch{}
+	b.delete(key.Bytes())
+	return db.write(b)
+}
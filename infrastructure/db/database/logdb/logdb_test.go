@@ -0,0 +1,282 @@
+package logdb
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kobradag/kobrad/infrastructure/db/database"
+	"github.com/pkg/errors"
+)
+
+func prepareDatabaseForTest(t *testing.T, testName string) (db *LogDB, path string) {
+	path = t.TempDir()
+	db, err := NewLogDB(path)
+	if err != nil {
+		t.Fatalf("%s: NewLogDB unexpectedly "+
+			"failed: %s", testName, err)
+	}
+	return db, path
+}
+
+func reopenForTest(t *testing.T, testName string, db *LogDB, path string) *LogDB {
+	err := db.Close()
+	if err != nil {
+		t.Fatalf("%s: Close unexpectedly "+
+			"failed: %s", testName, err)
+	}
+	db, err = NewLogDB(path)
+	if err != nil {
+		t.Fatalf("%s: NewLogDB unexpectedly "+
+			"failed: %s", testName, err)
+	}
+	return db
+}
+
+func testKey(i int) *database.Key {
+	return database.MakeBucket([]byte("bucket")).Key([]byte(fmt.Sprintf("key%03d", i)))
+}
+
+func expectValue(t *testing.T, testName string, db *LogDB, key *database.Key, expectedValue []byte) {
+	value, err := db.Get(key)
+	if expectedValue == nil {
+		if !database.IsNotFoundError(err) {
+			t.Fatalf("%s: Get %s: expected ErrNotFound but got value %x and error %v",
+				testName, key, value, err)
+		}
+		return
+	}
+	if err != nil {
+		t.Fatalf("%s: Get %s unexpectedly failed: %s", testName, key, err)
+	}
+	if !bytes.Equal(value, expectedValue) {
+		t.Fatalf("%s: Get %s returned %x, want %x", testName, key, value, expectedValue)
+	}
+}
+
+func TestLogDBReopen(t *testing.T) {
+	const testName = "TestLogDBReopen"
+	db, path := prepareDatabaseForTest(t, testName)
+
+	dbTx, err := db.Begin()
+	if err != nil {
+		t.Fatalf("%s: Begin unexpectedly failed: %s", testName, err)
+	}
+	for i := 0; i < 10; i++ {
+		err := dbTx.Put(testKey(i), []byte{byte(i)})
+		if err != nil {
+			t.Fatalf("%s: Put unexpectedly failed: %s", testName, err)
+		}
+	}
+	err = dbTx.Commit()
+	if err != nil {
+		t.Fatalf("%s: Commit unexpectedly failed: %s", testName, err)
+	}
+	err = db.Put(testKey(3), []byte("overwritten"))
+	if err != nil {
+		t.Fatalf("%s: Put unexpectedly failed: %s", testName, err)
+	}
+	err = db.Delete(testKey(5))
+	if err != nil {
+		t.Fatalf("%s: Delete unexpectedly failed: %s", testName, err)
+	}
+	err = db.Put(testKey(10), []byte{})
+	if err != nil {
+		t.Fatalf("%s: Put unexpectedly failed: %s", testName, err)
+	}
+
+	db = reopenForTest(t, testName, db, path)
+	defer db.Close()
+
+	for i := 0; i < 10; i++ {
+		switch i {
+		case 3:
+			expectValue(t, testName, db, testKey(i), []byte("overwritten"))
+		case 5:
+			expectValue(t, testName, db, testKey(i), nil)
+		default:
+			expectValue(t, testName, db, testKey(i), []byte{byte(i)})
+		}
+	}
+	expectValue(t, testName, db, testKey(10), []byte{})
+}
+
+func TestLogDBTornRecord(t *testing.T) {
+	const testName = "TestLogDBTornRecord"
+	db, path := prepareDatabaseForTest(t, testName)
+
+	err := db.Put(testKey(0), []byte("value0"))
+	if err != nil {
+		t.Fatalf("%s: Put unexpectedly failed: %s", testName, err)
+	}
+	sizeBeforeTornRecord := db.segment.size
+	err = db.Put(testKey(1), []byte("value1"))
+	if err != nil {
+		t.Fatalf("%s: Put unexpectedly failed: %s", testName, err)
+	}
+	err = db.Close()
+	if err != nil {
+		t.Fatalf("%s: Close unexpectedly failed: %s", testName, err)
+	}
+
+	// Simulate a crash in the middle of writing the second record
+	segmentPath := filepath.Join(path, segmentFileName(1))
+	err = os.Truncate(segmentPath, sizeBeforeTornRecord+recordHeaderLength+2)
+	if err != nil {
+		t.Fatalf("%s: Truncate unexpectedly failed: %s", testName, err)
+	}
+
+	db, err = NewLogDB(path)
+	if err != nil {
+		t.Fatalf("%s: NewLogDB unexpectedly failed: %s", testName, err)
+	}
+	expectValue(t, testName, db, testKey(0), []byte("value0"))
+	expectValue(t, testName, db, testKey(1), nil)
+
+	// Records that are written after the torn one was truncated survive
+	err = db.Put(testKey(2), []byte("value2"))
+	if err != nil {
+		t.Fatalf("%s: Put unexpectedly failed: %s", testName, err)
+	}
+	db = reopenForTest(t, testName, db, path)
+	defer db.Close()
+	expectValue(t, testName, db, testKey(0), []byte("value0"))
+	expectValue(t, testName, db, testKey(2), []byte("value2"))
+}
+
+func TestLogDBCorruptedRecord(t *testing.T) {
+	const testName = "TestLogDBCorruptedRecord"
+	db, path := prepareDatabaseForTest(t, testName)
+
+	err := db.Put(testKey(0), []byte("value0"))
+	if err != nil {
+		t.Fatalf("%s: Put unexpectedly failed: %s", testName, err)
+	}
+	sizeBeforeCorruptedRecord := db.segment.size
+	for i := 1; i < 3; i++ {
+		err = db.Put(testKey(i), []byte(fmt.Sprintf("value%d", i)))
+		if err != nil {
+			t.Fatalf("%s: Put unexpectedly failed: %s", testName, err)
+		}
+	}
+	err = db.Close()
+	if err != nil {
+		t.Fatalf("%s: Close unexpectedly failed: %s", testName, err)
+	}
+
+	// Flip a byte in the payload of the second record, which isn't the last
+	segmentPath := filepath.Join(path, segmentFileName(1))
+	segmentBytes, err := os.ReadFile(segmentPath)
+	if err != nil {
+		t.Fatalf("%s: ReadFile unexpectedly failed: %s", testName, err)
+	}
+	sizeBeforeReopen := int64(len(segmentBytes))
+	segmentBytes[sizeBeforeCorruptedRecord+recordHeaderLength] ^= 0xff
+	err = os.WriteFile(segmentPath, segmentBytes, 0600)
+	if err != nil {
+		t.Fatalf("%s: WriteFile unexpectedly failed: %s", testName, err)
+	}
+
+	_, err = NewLogDB(path)
+	if !errors.Is(err, ErrCorrupted) {
+		t.Fatalf("%s: NewLogDB: expected ErrCorrupted but got %v", testName, err)
+	}
+
+	// The records after the corrupted one are kept
+	stat, err := os.Stat(segmentPath)
+	if err != nil {
+		t.Fatalf("%s: Stat unexpectedly failed: %s", testName, err)
+	}
+	if stat.Size() != sizeBeforeReopen {
+		t.Fatalf("%s: the segment was truncated from %d to %d bytes", testName, sizeBeforeReopen, stat.Size())
+	}
+}
+
+func TestLogDBCompact(t *testing.T) {
+	const testName = "TestLogDBCompact"
+	db, path := prepareDatabaseForTest(t, testName)
+
+	for i := 0; i < 100; i++ {
+		err := db.Put(testKey(i), bytes.Repeat([]byte{byte(i)}, 100))
+		if err != nil {
+			t.Fatalf("%s: Put unexpectedly failed: %s", testName, err)
+		}
+	}
+	for i := 0; i < 100; i += 2 {
+		err := db.Delete(testKey(i))
+		if err != nil {
+			t.Fatalf("%s: Delete unexpectedly failed: %s", testName, err)
+		}
+	}
+
+	// A cursor that is open during the compaction keeps reading the
+	// data as it was when the cursor was opened
+	cursor, err := db.Cursor(database.MakeBucket([]byte("bucket")))
+	if err != nil {
+		t.Fatalf("%s: Cursor unexpectedly failed: %s", testName, err)
+	}
+	sizeBeforeCompaction := db.segment.size
+	err = db.Compact()
+	if err != nil {
+		t.Fatalf("%s: Compact unexpectedly failed: %s", testName, err)
+	}
+	if db.segment.size >= sizeBeforeCompaction/2 {
+		t.Fatalf("%s: the size of the data file was %d before the compaction and %d after it",
+			testName, sizeBeforeCompaction, db.segment.size)
+	}
+	err = db.Put(testKey(1), []byte("after compaction"))
+	if err != nil {
+		t.Fatalf("%s: Put unexpectedly failed: %s", testName, err)
+	}
+
+	numEntries := 0
+	for ok := cursor.First(); ok; ok = cursor.Next() {
+		value, err := cursor.Value()
+		if err != nil {
+			t.Fatalf("%s: Value unexpectedly failed: %s", testName, err)
+		}
+		if len(value) != 100 || value[0]%2 != 1 {
+			t.Fatalf("%s: unexpected value %x", testName, value)
+		}
+		numEntries++
+	}
+	if numEntries != 50 {
+		t.Fatalf("%s: the cursor iterated over %d entries, want 50", testName, numEntries)
+	}
+	err = cursor.Close()
+	if err != nil {
+		t.Fatalf("%s: Close unexpectedly failed: %s", testName, err)
+	}
+
+	// The old data file is removed once its last cursor is closed
+	_, err = os.Stat(filepath.Join(path, segmentFileName(1)))
+	if !os.IsNotExist(err) {
+		t.Fatalf("%s: the old data file wasn't removed: %v", testName, err)
+	}
+
+	db = reopenForTest(t, testName, db, path)
+	defer db.Close()
+	for i := 0; i < 100; i++ {
+		switch {
+		case i == 1:
+			expectValue(t, testName, db, testKey(i), []byte("after compaction"))
+		case i%2 == 0:
+			expectValue(t, testName, db, testKey(i), nil)
+		default:
+			expectValue(t, testName, db, testKey(i), bytes.Repeat([]byte{byte(i)}, 100))
+		}
+	}
+}
+
+func TestLogDBExclusiveOpen(t *testing.T) {
+	const testName = "TestLogDBExclusiveOpen"
+	db, path := prepareDatabaseForTest(t, testName)
+	defer db.Close()
+
+	_, err := NewLogDB(path)
+	if err == nil {
+		t.Fatalf("%s: opening a database that is already open unexpectedly succeeded", testName)
+	}
+}
@@ -0,0 +1,12 @@
+package logdb
+
+import (
+	"github.com/kobradag/kobrad/infrastructure/metrics"
+)
+
+var (
+	writeDuration = metrics.NewHistogram("kobrad_logdb_write_duration_seconds",
+		"The time it took to write single keys to the logdb database", metrics.DurationBuckets)
+	commitDuration = metrics.NewHistogram("kobrad_logdb_commit_duration_seconds",
+		"The time it took to commit logdb database transactions", metrics.DurationBuckets)
+)
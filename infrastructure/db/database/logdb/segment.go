@@ -0,0 +1,353 @@
+package logdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+)
+
+// A segment file starts with segmentMagic, and then holds a sequence of
+// records, each of which is a batch of operations that were written
+// atomically:
+//
+//	record: | payload length (4 bytes) | payload CRC-32C (4 bytes) | payload |
+//	payload: a sequence of operations
+//	operation: | type (1 byte) | key length (uvarint) | key | value length (uvarint) | value |
+//
+// Delete operations have no value length and value.
+var segmentMagic = []byte("kobralogdb\x00\x01")
+
+const (
+	segmentFileExtension     = ".log"
+	tempSegmentFileExtension = ".log.tmp"
+	recordHeaderLength       = 8
+
+	operationPut    = byte(1)
+	operationDelete = byte(2)
+)
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// ErrCorrupted is returned when a segment holds a bad record that isn't at
+// its end, and therefore can't be the result of a crash in the middle of a
+// write.
+var ErrCorrupted = errors.New("logdb segment is corrupted")
+
+// segment is a data file. Only the segment with the highest ID is valid: a
+// segment replaces the ones before it when the database is compacted.
+type segment struct {
+	id   uint64
+	path string
+	file *os.File
+	size int64
+
+	// refs counts the database and the cursors that read from the
+	// segment. An obsolete segment is removed once it's no longer read.
+	refs       int32
+	isObsolete int32
+}
+
+func segmentFileName(id uint64) string {
+	return fmt.Sprintf("%016d%s", id, segmentFileExtension)
+}
+
+// latestSegmentID returns the ID of the latest segment in the given
+// directory, and removes the rest of the segments, which are leftovers of a
+// compaction that didn't finish cleaning up, along with the temporary files
+// of compactions that didn't finish. It returns false if there are no
+// segments.
+func latestSegmentID(path string) (uint64, bool, error) {
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return 0, false, errors.WithStack(err)
+	}
+
+	var ids []uint64
+	for _, entry := range entries {
+		name := entry.Name()
+		if strings.HasSuffix(name, tempSegmentFileExtension) {
+			err := os.Remove(filepath.Join(path, name))
+			if err != nil {
+				return 0, false, errors.WithStack(err)
+			}
+			continue
+		}
+		if !strings.HasSuffix(name, segmentFileExtension) {
+			continue
+		}
+		id, err := strconv.ParseUint(strings.TrimSuffix(name, segmentFileExtension), 10, 64)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		return 0, false, nil
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	for _, id := range ids[:len(ids)-1] {
+		err := os.Remove(filepath.Join(path, segmentFileName(id)))
+		if err != nil {
+			return 0, false, errors.WithStack(err)
+		}
+	}
+	return ids[len(ids)-1], true, nil
+}
+
+// createSegment creates an empty segment file at the given path
+func createSegment(id uint64, path string) (*segment, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	_, err = file.Write(segmentMagic)
+	if err != nil {
+		file.Close()
+		return nil, errors.WithStack(err)
+	}
+	return &segment{
+		id:   id,
+		path: path,
+		file: file,
+		size: int64(len(segmentMagic)),
+		refs: 1,
+	}, nil
+}
+
+// openSegment opens the segment file at the given path, and calls apply
+// for every operation in it, in order. A record that was torn by a crash at
+// the end of the file is truncated. A bad record anywhere else means that
+// later writes would be lost with it, so it fails with ErrCorrupted instead.
+func openSegment(id uint64, path string, apply func(operationType byte, key []byte, location valueLocation)) (*segment, error) {
+	file, err := os.OpenFile(path, os.O_RDWR, 0600)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	seg := &segment{
+		id:   id,
+		path: path,
+		file: file,
+		refs: 1,
+	}
+
+	stat, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, errors.WithStack(err)
+	}
+	fileSize := stat.Size()
+
+	magic := make([]byte, len(segmentMagic))
+	_, err = file.ReadAt(magic, 0)
+	if err != nil || !bytes.Equal(magic, segmentMagic) {
+		file.Close()
+		return nil, errors.Errorf("%s is not a logdb segment file", path)
+	}
+
+	offset := int64(len(segmentMagic))
+	header := make([]byte, recordHeaderLength)
+	for offset < fileSize {
+		_, err := file.ReadAt(header, offset)
+		if err != nil {
+			break
+		}
+		payloadLength := int64(binary.LittleEndian.Uint32(header[:4]))
+		if offset+recordHeaderLength+payloadLength > fileSize {
+			break
+		}
+		payload := make([]byte, payloadLength)
+		_, err = file.ReadAt(payload, offset+recordHeaderLength)
+		if err != nil || crc32.Checksum(payload, crcTable) != binary.LittleEndian.Uint32(header[4:]) {
+			if offset+recordHeaderLength+payloadLength == fileSize {
+				break
+			}
+			file.Close()
+			return nil, errors.Wrapf(ErrCorrupted, "bad record at offset %d of %s", offset, path)
+		}
+
+		err = decodeOperations(payload, offset+recordHeaderLength, apply)
+		if err != nil {
+			file.Close()
+			return nil, errors.Wrapf(err, "corrupted record at offset %d of %s", offset, path)
+		}
+		offset += recordHeaderLength + payloadLength
+	}
+
+	if offset < fileSize {
+		log.Warnf("Truncating the torn record at the end of %s, from offset %d", path, offset)
+		err := file.Truncate(offset)
+		if err != nil {
+			file.Close()
+			return nil, errors.WithStack(err)
+		}
+	}
+	seg.size = offset
+	return seg, nil
+}
+
+// decodeOperations calls apply for every operation in the given payload,
+// which starts at payloadOffset in the segment file
+func decodeOperations(payload []byte, payloadOffset int64,
+	apply func(operationType byte, key []byte, location valueLocation)) error {
+
+	position := 0
+	readLength := func() (int, error) {
+		length, n := binary.Uvarint(payload[position:])
+		if n <= 0 || length > uint64(len(payload)-position-n) {
+			return 0, errors.New("malformed length")
+		}
+		position += n
+		return int(length), nil
+	}
+
+	for position < len(payload) {
+		operationType := payload[position]
+		position++
+
+		keyLength, err := readLength()
+		if err != nil {
+			return err
+		}
+		key := payload[position : position+keyLength]
+		position += keyLength
+
+		switch operationType {
+		case operationPut:
+			valueLength, err := readLength()
+			if err != nil {
+				return err
+			}
+			apply(operationType, key, valueLocation{
+				offset: payloadOffset + int64(position),
+				length: uint32(valueLength),
+			})
+			position += valueLength
+		case operationDelete:
+			apply(operationType, key, valueLocation{})
+		default:
+			return errors.Errorf("unknown operation type %d", operationType)
+		}
+	}
+	return nil
+}
+
+// batch is a sequence of operations that are encoded as the payload of a
+// single record
+type batch struct {
+	payload    []byte
+	operations []batchOperation
+}
+
+type batchOperation struct {
+	operationType byte
+	key           []byte
+
+	// valuePosition is the position of the value in the payload
+	valuePosition int
+	valueLength   int
+}
+
+func (b *batch) put(key []byte, value []byte) {
+	b.payload = append(b.payload, operationPut)
+	b.payload = appendUvarint(b.payload, uint64(len(key)))
+	b.payload = append(b.payload, key...)
+	b.payload = appendUvarint(b.payload, uint64(len(value)))
+	b.operations = append(b.operations, batchOperation{
+		operationType: operationPut,
+		key:           key,
+		valuePosition: len(b.payload),
+		valueLength:   len(value),
+	})
+	b.payload = append(b.payload, value...)
+}
+
+func (b *batch) delete(key []byte) {
+	b.payload = append(b.payload, operationDelete)
+	b.payload = appendUvarint(b.payload, uint64(len(key)))
+	b.payload = append(b.payload, key...)
+	b.operations = append(b.operations, batchOperation{
+		operationType: operationDelete,
+		key:           key,
+	})
+}
+
+func appendUvarint(buffer []byte, value uint64) []byte {
+	var encoded [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(encoded[:], value)
+	return append(buffer, encoded[:n]...)
+}
+
+func (b *batch) reset() {
+	b.payload = b.payload[:0]
+	b.operations = b.operations[:0]
+}
+
+// append writes the given batch as a record at the end of the segment. It
+// returns the offset of the payload of the record.
+func (s *segment) append(b *batch) (int64, error) {
+	record := make([]byte, recordHeaderLength+len(b.payload))
+	binary.LittleEndian.PutUint32(record[:4], uint32(len(b.payload)))
+	binary.LittleEndian.PutUint32(record[4:8], crc32.Checksum(b.payload, crcTable))
+	copy(record[recordHeaderLength:], b.payload)
+
+	_, err := s.file.WriteAt(record, s.size)
+	if err != nil {
+		// Drop whatever part of the record that was written, so that
+		// the next record is written right after the previous one
+		truncateErr := s.file.Truncate(s.size)
+		if truncateErr != nil {
+			return 0, errors.Wrapf(err, "failed truncating a partially written record: %s", truncateErr)
+		}
+		return 0, errors.WithStack(err)
+	}
+
+	payloadOffset := s.size + recordHeaderLength
+	s.size += int64(len(record))
+	return payloadOffset, nil
+}
+
+// read reads the value at the given location
+func (s *segment) read(location valueLocation) ([]byte, error) {
+	value := make([]byte, location.length)
+	_, err := s.file.ReadAt(value, location.offset)
+	if err != nil && !(errors.Is(err, io.EOF) && location.length == 0) {
+		return nil, errors.WithStack(err)
+	}
+	return value, nil
+}
+
+func (s *segment) acquire() {
+	atomic.AddInt32(&s.refs, 1)
+}
+
+// release drops a reference to the segment. The segment file is closed
+// once it has no references, and removed if the segment is obsolete.
+func (s *segment) release() error {
+	if atomic.AddInt32(&s.refs, -1) > 0 {
+		return nil
+	}
+
+	err := s.file.Close()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if atomic.LoadInt32(&s.isObsolete) == 1 {
+		return errors.WithStack(os.Remove(s.path))
+	}
+	return nil
+}
+
+func (s *segment) markObsolete() {
+	atomic.StoreInt32(&s.isObsolete, 1)
+}
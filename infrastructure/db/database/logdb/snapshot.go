@@ -0,0 +1,80 @@
+package logdb
+
+import (
+	"github.com/kobradag/kobrad/infrastructure/db/database"
+	"github.com/pkg/errors"
+)
+
+// LogDBSnapshot is a read-only view of a logdb instance. The index is
+// persistent, so a snapshot is its root as it was when the snapshot was
+// taken, along with the segment that the root points into.
+type LogDBSnapshot struct {
+	root       *indexNode
+	segment    *segment
+	isReleased bool
+}
+
+// Snapshot takes a consistent read-only view of the logdb instance.
+func (db *LogDB) Snapshot() (database.Snapshot, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.isClosed {
+		return nil, errors.New("cannot take a snapshot of a closed database")
+	}
+
+	// The snapshot holds the segment, so that it isn't removed by a
+	// compaction while it's read from
+	db.segment.acquire()
+	return &LogDBSnapshot{
+		root:       db.root,
+		segment:    db.segment,
+		isReleased: false,
+	}, nil
+}
+
+// Get gets the value for the given key. It returns
+// ErrNotFound if the given key does not exist.
+func (s *LogDBSnapshot) Get(key *database.Key) ([]byte, error) {
+	if s.isReleased {
+		return nil, errors.New("cannot get from a released snapshot")
+	}
+	node := indexGet(s.root, key.Bytes())
+	if node == nil {
+		return nil, errors.Wrapf(database.ErrNotFound, "key %s not found", key)
+	}
+	return s.segment.read(node.location)
+}
+
+// Has returns true if the database does contains the
+// given key.
+func (s *LogDBSnapshot) Has(key *database.Key) (bool, error) {
+	if s.isReleased {
+		return false, errors.New("cannot has from a released snapshot")
+	}
+	return indexGet(s.root, key.Bytes()) != nil, nil
+}
+
+// Cursor begins a new cursor over the given bucket.
+func (s *LogDBSnapshot) Cursor(bucket *database.Bucket) (database.Cursor, error) {
+	if s.isReleased {
+		return nil, errors.New("cannot open a cursor from a released snapshot")
+	}
+	s.segment.acquire()
+	return &LogDBCursor{
+		iterator: newIndexIterator(s.root),
+		segment:  s.segment,
+		bucket:   bucket,
+		isClosed: false,
+	}, nil
+}
+
+// Release releases the snapshot.
+func (s *LogDBSnapshot) Release() error {
+	if s.isReleased {
+		return errors.New("cannot release an already released snapshot")
+	}
+	s.isReleased = true
+	s.root = nil
+	return s.segment.release()
+}
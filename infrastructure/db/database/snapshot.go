@@ -0,0 +1,13 @@
+package database
+
+// Snapshot is a read-only view of a database as it was when the snapshot
+// was taken. Writes to the database that happen after that aren't visible
+// through the snapshot, so it can be read consistently while the database
+// keeps being written to.
+type Snapshot interface {
+	DataReader
+
+	// Release releases the snapshot. Cursors that were opened from
+	// the snapshot must be closed first.
+	Release() error
+}
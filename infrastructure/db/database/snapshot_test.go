@@ -0,0 +1,104 @@
+// All tests within this file should call testForAllDatabaseTypes
+// over the actual test. This is to make sure that all supported
+// database types adhere to the assumptions defined in the
+// interfaces in this package.
+
+package database_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/kobradag/kobrad/infrastructure/db/database"
+)
+
+func TestSnapshot(t *testing.T) {
+	testForAllDatabaseTypes(t, "TestSnapshot", testSnapshot)
+}
+
+func testSnapshot(t *testing.T, db database.Database, testName string) {
+	entries := populateDatabaseForTest(t, db, testName)
+
+	snapshot, err := db.Snapshot()
+	if err != nil {
+		t.Fatalf("%s: Snapshot unexpectedly failed: %s", testName, err)
+	}
+
+	// Modify the database after the snapshot was taken
+	err = db.Put(entries[0].key, []byte("modified"))
+	if err != nil {
+		t.Fatalf("%s: Put unexpectedly failed: %s", testName, err)
+	}
+	err = db.Delete(entries[1].key)
+	if err != nil {
+		t.Fatalf("%s: Delete unexpectedly failed: %s", testName, err)
+	}
+	addedKey := database.MakeBucket(nil).Key([]byte("added"))
+	err = db.Put(addedKey, []byte("added"))
+	if err != nil {
+		t.Fatalf("%s: Put unexpectedly failed: %s", testName, err)
+	}
+	err = db.Compact()
+	if err != nil {
+		t.Fatalf("%s: Compact unexpectedly failed: %s", testName, err)
+	}
+
+	// The snapshot must see the database as it was when it was taken
+	for _, entry := range entries[:2] {
+		value, err := snapshot.Get(entry.key)
+		if err != nil {
+			t.Fatalf("%s: Get unexpectedly failed: %s", testName, err)
+		}
+		if !bytes.Equal(value, entry.value) {
+			t.Fatalf("%s: Get returned %s, want %s", testName, value, entry.value)
+		}
+	}
+	exists, err := snapshot.Has(addedKey)
+	if err != nil {
+		t.Fatalf("%s: Has unexpectedly failed: %s", testName, err)
+	}
+	if exists {
+		t.Fatalf("%s: a key that was added after the snapshot was taken is visible through it", testName)
+	}
+
+	cursor, err := snapshot.Cursor(database.MakeBucket(nil))
+	if err != nil {
+		t.Fatalf("%s: Cursor unexpectedly failed: %s", testName, err)
+	}
+	count := 0
+	for ok := cursor.First(); ok; ok = cursor.Next() {
+		value, err := cursor.Value()
+		if err != nil {
+			t.Fatalf("%s: Value unexpectedly failed: %s", testName, err)
+		}
+		if !bytes.Equal(value, []byte("value")) {
+			t.Fatalf("%s: the cursor returned the value %s", testName, value)
+		}
+		count++
+	}
+	if count != len(entries) {
+		t.Fatalf("%s: the cursor iterated over %d entries, want %d", testName, count, len(entries))
+	}
+	err = cursor.Close()
+	if err != nil {
+		t.Fatalf("%s: Close unexpectedly failed: %s", testName, err)
+	}
+
+	err = snapshot.Release()
+	if err != nil {
+		t.Fatalf("%s: Release unexpectedly failed: %s", testName, err)
+	}
+	_, err = snapshot.Get(entries[0].key)
+	if err == nil {
+		t.Fatalf("%s: Get from a released snapshot unexpectedly succeeded", testName)
+	}
+
+	// The database itself sees the modifications
+	value, err := db.Get(entries[0].key)
+	if err != nil {
+		t.Fatalf("%s: Get unexpectedly failed: %s", testName, err)
+	}
+	if !bytes.Equal(value, []byte("modified")) {
+		t.Fatalf("%s: Get returned %s, want modified", testName, value)
+	}
+}
@@ -0,0 +1,50 @@
+package database
+
+import "time"
+
+// StatsReporter is implemented by databases that report statistics about
+// their internal structure
+type StatsReporter interface {
+	// Stats returns the current statistics of the database.
+	Stats() (*Stats, error)
+}
+
+// Stats holds the statistics of a database that stores its data in levels
+// of tables, which are compacted from one level to the next
+type Stats struct {
+	Levels []*LevelStats
+
+	// The number of compactions of the memtable, of level 0, of the rest of
+	// the levels, and of compactions that were triggered by seeks
+	MemCompactionCount       uint32
+	Level0CompactionCount    uint32
+	NonLevel0CompactionCount uint32
+	SeekCompactionCount      uint32
+
+	// WriteDelayCount and WriteDelayDuration count the writes that were
+	// delayed until compactions caught up
+	WriteDelayCount    int32
+	WriteDelayDuration time.Duration
+	IsWritePaused      bool
+
+	ReadBytes  uint64
+	WriteBytes uint64
+
+	BlockCacheSize     int
+	OpenedTableCount   int
+	AliveSnapshotCount int32
+	AliveIteratorCount int32
+}
+
+// LevelStats holds the statistics of a single level
+type LevelStats struct {
+	Level      int
+	TableCount int
+	Size       int64
+
+	// ReadBytes and WriteBytes count the data that compactions read from
+	// and wrote to the level, and CompactionDuration the time they took
+	ReadBytes          int64
+	WriteBytes         int64
+	CompactionDuration time.Duration
+}
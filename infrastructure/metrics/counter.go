@@ -0,0 +1,116 @@
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// Counter is a metric whose value only ever increases, such as the
+// number of processed blocks
+type Counter struct {
+	metricName string
+	help       string
+	value      uint64
+}
+
+// NewCounter creates and registers a new Counter
+func NewCounter(name string, help string) *Counter {
+	counter := &Counter{metricName: name, help: help}
+	defaultRegistry.register(counter)
+	return counter
+}
+
+// Inc increments the counter by 1
+func (c *Counter) Inc() {
+	c.Add(1)
+}
+
+// Add increments the counter by the given delta
+func (c *Counter) Add(delta uint64) {
+	atomic.AddUint64(&c.value, delta)
+}
+
+// Value returns the current value of the counter
+func (c *Counter) Value() uint64 {
+	return atomic.LoadUint64(&c.value)
+}
+
+func (c *Counter) name() string {
+	return c.metricName
+}
+
+func (c *Counter) write(writer io.Writer) error {
+	err := writeHeader(writer, c.metricName, c.help, "counter")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(writer, "%s %d\n", c.metricName, c.Value())
+	return err
+}
+
+// CounterVec is a set of counters that share a name and are
+// distinguished by the value of a single label
+type CounterVec struct {
+	metricName string
+	help       string
+	labelName  string
+
+	mutex    sync.Mutex
+	counters map[string]*Counter
+}
+
+// NewCounterVec creates and registers a new CounterVec
+func NewCounterVec(name string, help string, labelName string) *CounterVec {
+	counterVec := &CounterVec{
+		metricName: name,
+		help:       help,
+		labelName:  labelName,
+		counters:   make(map[string]*Counter),
+	}
+	defaultRegistry.register(counterVec)
+	return counterVec
+}
+
+// WithLabelValue returns the counter for the given label value, creating it if required
+func (cv *CounterVec) WithLabelValue(labelValue string) *Counter {
+	cv.mutex.Lock()
+	defer cv.mutex.Unlock()
+
+	counter, ok := cv.counters[labelValue]
+	if !ok {
+		counter = &Counter{metricName: cv.metricName, help: cv.help}
+		cv.counters[labelValue] = counter
+	}
+	return counter
+}
+
+func (cv *CounterVec) name() string {
+	return cv.metricName
+}
+
+func (cv *CounterVec) write(writer io.Writer) error {
+	err := writeHeader(writer, cv.metricName, cv.help, "counter")
+	if err != nil {
+		return err
+	}
+
+	cv.mutex.Lock()
+	labelValues := make([]string, 0, len(cv.counters))
+	for labelValue := range cv.counters {
+		labelValues = append(labelValues, labelValue)
+	}
+	cv.mutex.Unlock()
+	sort.Strings(labelValues)
+
+	for _, labelValue := range labelValues {
+		_, err := fmt.Fprintf(writer, "%s%s %d\n", cv.metricName, formatLabels(cv.labelName, labelValue),
+			cv.WithLabelValue(labelValue).Value())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,13 @@
+/*
+Package metrics implements counters, gauges and histograms that are exposed
+over HTTP in the Prometheus text exposition format.
+
+Metrics are meant to be defined as package-level variables of the packages
+they measure, for example:
+
+	var blocksProcessed = metrics.NewCounter("kobrad_blocks_processed_total",
+		"The number of blocks processed")
+
+and are served on the /metrics path once Start is called.
+*/
+package metrics
@@ -0,0 +1,60 @@
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"sync/atomic"
+)
+
+// Gauge is a metric whose value may go up and down, such as the
+// number of connected peers
+type Gauge struct {
+	metricName string
+	help       string
+	value      int64
+}
+
+// NewGauge creates and registers a new Gauge
+func NewGauge(name string, help string) *Gauge {
+	gauge := &Gauge{metricName: name, help: help}
+	defaultRegistry.register(gauge)
+	return gauge
+}
+
+// Set sets the gauge to the given value
+func (g *Gauge) Set(value int64) {
+	atomic.StoreInt64(&g.value, value)
+}
+
+// Add adds the given delta, which may be negative, to the gauge
+func (g *Gauge) Add(delta int64) {
+	atomic.AddInt64(&g.value, delta)
+}
+
+// Inc increments the gauge by 1
+func (g *Gauge) Inc() {
+	g.Add(1)
+}
+
+// Dec decrements the gauge by 1
+func (g *Gauge) Dec() {
+	g.Add(-1)
+}
+
+// Value returns the current value of the gauge
+func (g *Gauge) Value() int64 {
+	return atomic.LoadInt64(&g.value)
+}
+
+func (g *Gauge) name() string {
+	return g.metricName
+}
+
+func (g *Gauge) write(writer io.Writer) error {
+	err := writeHeader(writer, g.metricName, g.help, "gauge")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(writer, "%s %d\n", g.metricName, g.Value())
+	return err
+}
@@ -0,0 +1,177 @@
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// DurationBuckets are the default histogram buckets for durations, in seconds
+var DurationBuckets = []float64{0.0005, 0.001, 0.005, 0.01, 0.05, 0.1, 0.5, 1, 5, 10, 30}
+
+// Histogram is a metric that counts observed values, such as durations,
+// in configurable buckets
+type Histogram struct {
+	metricName string
+	help       string
+
+	mutex        sync.Mutex
+	upperBounds  []float64
+	bucketCounts []uint64
+	sum          float64
+	count        uint64
+}
+
+// NewHistogram creates and registers a new Histogram with the given bucket
+// upper bounds, which must be sorted in increasing order
+func NewHistogram(name string, help string, upperBounds []float64) *Histogram {
+	histogram := newHistogram(name, help, upperBounds)
+	defaultRegistry.register(histogram)
+	return histogram
+}
+
+func newHistogram(name string, help string, upperBounds []float64) *Histogram {
+	return &Histogram{
+		metricName:   name,
+		help:         help,
+		upperBounds:  upperBounds,
+		bucketCounts: make([]uint64, len(upperBounds)),
+	}
+}
+
+// Observe adds the given value to the histogram
+func (h *Histogram) Observe(value float64) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	index := sort.SearchFloat64s(h.upperBounds, value)
+	if index < len(h.bucketCounts) {
+		h.bucketCounts[index]++
+	}
+	h.sum += value
+	h.count++
+}
+
+// MeasureExecutionTime starts measuring the execution time of a code block and
+// returns a function that observes the elapsed time, in seconds, when called
+func (h *Histogram) MeasureExecutionTime() func() {
+	start := time.Now()
+	return func() {
+		h.Observe(time.Since(start).Seconds())
+	}
+}
+
+func (h *Histogram) name() string {
+	return h.metricName
+}
+
+func (h *Histogram) write(writer io.Writer) error {
+	err := writeHeader(writer, h.metricName, h.help, "histogram")
+	if err != nil {
+		return err
+	}
+	return h.writeSamples(writer)
+}
+
+// writeSamples writes the bucket, sum and count samples of the histogram,
+// each labeled by the given label name and value pairs
+func (h *Histogram) writeSamples(writer io.Writer, labelNamesAndValues ...string) error {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	cumulativeCount := uint64(0)
+	for i, upperBound := range h.upperBounds {
+		cumulativeCount += h.bucketCounts[i]
+		labels := formatLabels(append(labelNamesAndValues, "le", formatFloat(upperBound))...)
+		_, err := fmt.Fprintf(writer, "%s_bucket%s %d\n", h.metricName, labels, cumulativeCount)
+		if err != nil {
+			return err
+		}
+	}
+	labels := formatLabels(append(labelNamesAndValues, "le", formatFloat(math.Inf(1)))...)
+	_, err := fmt.Fprintf(writer, "%s_bucket%s %d\n", h.metricName, labels, h.count)
+	if err != nil {
+		return err
+	}
+
+	labels = formatLabels(labelNamesAndValues...)
+	_, err = fmt.Fprintf(writer, "%s_sum%s %s\n%s_count%s %d\n",
+		h.metricName, labels, formatFloat(h.sum), h.metricName, labels, h.count)
+	return err
+}
+
+func formatFloat(value float64) string {
+	if math.IsInf(value, 1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+// HistogramVec is a set of histograms that share a name and buckets, and are
+// distinguished by the value of a single label
+type HistogramVec struct {
+	metricName  string
+	help        string
+	labelName   string
+	upperBounds []float64
+
+	mutex      sync.Mutex
+	histograms map[string]*Histogram
+}
+
+// NewHistogramVec creates and registers a new HistogramVec
+func NewHistogramVec(name string, help string, labelName string, upperBounds []float64) *HistogramVec {
+	histogramVec := &HistogramVec{
+		metricName:  name,
+		help:        help,
+		labelName:   labelName,
+		upperBounds: upperBounds,
+		histograms:  make(map[string]*Histogram),
+	}
+	defaultRegistry.register(histogramVec)
+	return histogramVec
+}
+
+// WithLabelValue returns the histogram for the given label value, creating it if required
+func (hv *HistogramVec) WithLabelValue(labelValue string) *Histogram {
+	hv.mutex.Lock()
+	defer hv.mutex.Unlock()
+
+	histogram, ok := hv.histograms[labelValue]
+	if !ok {
+		histogram = newHistogram(hv.metricName, hv.help, hv.upperBounds)
+		hv.histograms[labelValue] = histogram
+	}
+	return histogram
+}
+
+func (hv *HistogramVec) name() string {
+	return hv.metricName
+}
+
+func (hv *HistogramVec) write(writer io.Writer) error {
+	err := writeHeader(writer, hv.metricName, hv.help, "histogram")
+	if err != nil {
+		return err
+	}
+
+	hv.mutex.Lock()
+	labelValues := make([]string, 0, len(hv.histograms))
+	for labelValue := range hv.histograms {
+		labelValues = append(labelValues, labelValue)
+	}
+	hv.mutex.Unlock()
+	sort.Strings(labelValues)
+
+	for _, labelValue := range labelValues {
+		err := hv.WithLabelValue(labelValue).writeSamples(writer, hv.labelName, labelValue)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,9 @@
+package metrics
+
+import (
+	"github.com/kobradag/kobrad/infrastructure/logger"
+	"github.com/kobradag/kobrad/util/panics"
+)
+
+var log = logger.RegisterSubSystem("MTRC")
+var spawn = panics.GoroutineWrapperFunc(log)
@@ -0,0 +1,81 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRegistryWrite(t *testing.T) {
+	testRegistry := &registry{metrics: make(map[string]metric)}
+
+	counter := &Counter{metricName: "test_counter_total", help: "A test counter"}
+	testRegistry.register(counter)
+	counter.Add(3)
+	counter.Inc()
+
+	gauge := &Gauge{metricName: "test_gauge", help: "A test gauge"}
+	testRegistry.register(gauge)
+	gauge.Set(10)
+	gauge.Dec()
+
+	counterVec := &CounterVec{metricName: "test_counter_vec_total", help: "A test counter vec",
+		labelName: "method", counters: make(map[string]*Counter)}
+	testRegistry.register(counterVec)
+	counterVec.WithLabelValue("b").Inc()
+	counterVec.WithLabelValue("a").Add(2)
+
+	histogramVec := &HistogramVec{metricName: "test_histogram_seconds", help: "A test histogram",
+		labelName: "method", upperBounds: []float64{0.1, 1}, histograms: make(map[string]*Histogram)}
+	testRegistry.register(histogramVec)
+	histogramVec.WithLabelValue("getInfo").Observe(0.05)
+	histogramVec.WithLabelValue("getInfo").Observe(0.5)
+	histogramVec.WithLabelValue("getInfo").Observe(5)
+
+	builder := &strings.Builder{}
+	err := testRegistry.write(builder)
+	if err != nil {
+		t.Fatalf("write: %s", err)
+	}
+
+	expected := `# HELP test_counter_total A test counter
+# TYPE test_counter_total counter
+test_counter_total 4
+# HELP test_counter_vec_total A test counter vec
+# TYPE test_counter_vec_total counter
+test_counter_vec_total{method="a"} 2
+test_counter_vec_total{method="b"} 1
+# HELP test_gauge A test gauge
+# TYPE test_gauge gauge
+test_gauge 9
+# HELP test_histogram_seconds A test histogram
+# TYPE test_histogram_seconds histogram
+test_histogram_seconds_bucket{method="getInfo",le="0.1"} 1
+test_histogram_seconds_bucket{method="getInfo",le="1"} 2
+test_histogram_seconds_bucket{method="getInfo",le="+Inf"} 3
+test_histogram_seconds_sum{method="getInfo"} 5.55
+test_histogram_seconds_count{method="getInfo"} 3
+`
+	if builder.String() != expected {
+		t.Fatalf("Unexpected output. Want:\n%s\nGot:\n%s", expected, builder.String())
+	}
+}
+
+func TestRegisterDuplicate(t *testing.T) {
+	testRegistry := &registry{metrics: make(map[string]metric)}
+	testRegistry.register(&Counter{metricName: "test_duplicate"})
+
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("Expected registering a duplicate metric to panic")
+		}
+	}()
+	testRegistry.register(&Gauge{metricName: "test_duplicate"})
+}
+
+func TestFormatLabels(t *testing.T) {
+	result := formatLabels("name", `a "quoted" \ value`)
+	expected := `{name="a \"quoted\" \\ value"}`
+	if result != expected {
+		t.Fatalf("Expected %s but got %s", expected, result)
+	}
+}
@@ -0,0 +1,84 @@
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// metric is a named set of samples that can be written in the Prometheus
+// text exposition format
+type metric interface {
+	name() string
+	write(writer io.Writer) error
+}
+
+type registry struct {
+	mutex   sync.Mutex
+	metrics map[string]metric
+}
+
+var defaultRegistry = &registry{metrics: make(map[string]metric)}
+
+// register adds the given metric to the registry. It panics if a metric
+// with the same name was already registered, since metrics are meant to be
+// defined once, as package-level variables.
+func (r *registry) register(m metric) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, ok := r.metrics[m.name()]; ok {
+		panic(fmt.Sprintf("metric %s is already registered", m.name()))
+	}
+	r.metrics[m.name()] = m
+}
+
+// write writes all the registered metrics, sorted by name
+func (r *registry) write(writer io.Writer) error {
+	r.mutex.Lock()
+	metrics := make([]metric, 0, len(r.metrics))
+	for _, m := range r.metrics {
+		metrics = append(metrics, m)
+	}
+	r.mutex.Unlock()
+
+	sort.Slice(metrics, func(i, j int) bool {
+		return metrics[i].name() < metrics[j].name()
+	})
+	for _, m := range metrics {
+		err := m.write(writer)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeHeader(writer io.Writer, name string, help string, metricType string) error {
+	_, err := fmt.Fprintf(writer, "# HELP %s %s\n# TYPE %s %s\n", name, escapeHelp(help), name, metricType)
+	return err
+}
+
+func escapeHelp(help string) string {
+	return strings.NewReplacer(`\`, `\\`, "\n", `\n`).Replace(help)
+}
+
+func escapeLabelValue(labelValue string) string {
+	return strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`).Replace(labelValue)
+}
+
+// formatLabels formats the given label name and value pairs as the label
+// set of a sample, for example: {method="getInfo",le="0.5"}
+func formatLabels(labelNamesAndValues ...string) string {
+	if len(labelNamesAndValues) == 0 {
+		return ""
+	}
+	labels := make([]string, 0, len(labelNamesAndValues)/2)
+	for i := 0; i+1 < len(labelNamesAndValues); i += 2 {
+		labels = append(labels,
+			fmt.Sprintf(`%s="%s"`, labelNamesAndValues[i], escapeLabelValue(labelNamesAndValues[i+1])))
+	}
+	return "{" + strings.Join(labels, ",") + "}"
+}
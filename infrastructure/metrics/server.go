@@ -0,0 +1,28 @@
+package metrics
+
+import (
+	"net/http"
+)
+
+// contentType is the content type of the Prometheus text exposition format
+const contentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// Start starts an HTTP server that exposes all the registered metrics
+// on the /metrics path of the given listen address
+func Start(listenAddress string) {
+	spawn("metrics.Start", func() {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/metrics", handleMetrics)
+
+		log.Infof("Metrics server listening on %s", listenAddress)
+		log.Error(http.ListenAndServe(listenAddress, mux))
+	})
+}
+
+func handleMetrics(writer http.ResponseWriter, _ *http.Request) {
+	writer.Header().Set("Content-Type", contentType)
+	err := defaultRegistry.write(writer)
+	if err != nil {
+		log.Warnf("Error writing metrics: %s", err)
+	}
+}
@@ -12,6 +12,12 @@ import (
 var notBannedAddressBucket = database.MakeBucket([]byte("not-banned-addresses"))
 var bannedAddressBucket = database.MakeBucket([]byte("banned-addresses"))
 
+// StoredData returns the buckets and the keys in which the address manager
+// stores its data
+func StoredData() (buckets []*database.Bucket, keys []*database.Key) {
+	return []*database.Bucket{notBannedAddressBucket, bannedAddressBucket}, nil
+}
+
 type addressStore struct {
 	database           database.Database
 	notBannedAddresses map[addressKey]*address
@@ -10,6 +10,7 @@ import (
 	routerpkg "github.com/kobradag/kobrad/infrastructure/network/netadapter/router"
 	"github.com/kobradag/kobrad/infrastructure/network/netadapter/server"
 	"github.com/kobradag/kobrad/infrastructure/network/netadapter/server/grpcserver"
+	"github.com/kobradag/kobrad/infrastructure/network/netadapter/server/jsonrpcserver"
 	"github.com/pkg/errors"
 )
 
@@ -28,6 +29,7 @@ type NetAdapter struct {
 	p2pServer            server.P2PServer
 	p2pRouterInitializer RouterInitializer
 	rpcServer            server.Server
+	jsonRPCServer        server.Server
 	rpcRouterInitializer RouterInitializer
 	stop                 uint32
 
@@ -62,6 +64,15 @@ func NewNetAdapter(cfg *config.Config) (*NetAdapter, error) {
 	adapter.p2pServer.SetOnConnectedHandler(adapter.onP2PConnectedHandler)
 	adapter.rpcServer.SetOnConnectedHandler(adapter.onRPCConnectedHandler)
 
+	if len(cfg.RPCListenersJSON) > 0 {
+		adapter.jsonRPCServer, err = jsonrpcserver.NewJSONRPCServer(cfg.RPCListenersJSON, cfg.RPCMaxClients,
+			cfg.RPCMaxWebsockets, cfg.RPCAllowedOrigins)
+		if err != nil {
+			return nil, err
+		}
+		adapter.jsonRPCServer.SetOnConnectedHandler(adapter.onRPCConnectedHandler)
+	}
+
 	return &adapter, nil
 }
 
@@ -82,6 +93,12 @@ func (na *NetAdapter) Start() error {
 	if err != nil {
 		return err
 	}
+	if na.jsonRPCServer != nil {
+		err = na.jsonRPCServer.Start()
+		if err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -95,6 +112,12 @@ func (na *NetAdapter) Stop() error {
 	if err != nil {
 		return err
 	}
+	if na.jsonRPCServer != nil {
+		err = na.jsonRPCServer.Stop()
+		if err != nil {
+			return err
+		}
+	}
 	return na.rpcServer.Stop()
 }
 
@@ -75,6 +75,12 @@ func (c *NetConnection) IsOutbound() bool {
 	return c.connection.IsOutbound()
 }
 
+// Authorization returns the credentials the client of an RPC connection
+// presented when connecting, or an empty string if none were presented
+func (c *NetConnection) Authorization() string {
+	return c.connection.Authorization()
+}
+
 // NetAddress returns the NetAddress associated with this connection
 func (c *NetConnection) NetAddress() *appmessage.NetAddress {
 	return appmessage.NewNetAddress(c.connection.Address())
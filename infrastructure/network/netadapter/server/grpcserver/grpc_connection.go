@@ -22,6 +22,10 @@ type gRPCConnection struct {
 	router                   *router.Router
 	lowLevelClientConnection *grpc.ClientConn
 
+	// authorization is the value of the authorization metadata
+	// an inbound client sent, if any
+	authorization string
+
 	// streamLock protects concurrent access to stream.
 	// Note that it's an RWMutex. Despite what the name
 	// implies, we use it to RLock() send() and receive() because
@@ -127,6 +131,10 @@ func (c *gRPCConnection) Address() *net.TCPAddr {
 	return c.address
 }
 
+func (c *gRPCConnection) Authorization() string {
+	return c.authorization
+}
+
 func (c *gRPCConnection) receive() (*protowire.KobradMessage, error) {
 	// We use RLock here and in send() because they can work
 	// in parallel. closeSend(), however, must not have either
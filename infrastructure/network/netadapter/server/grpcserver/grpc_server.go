@@ -7,6 +7,7 @@ import (
 	"github.com/kobradag/kobrad/util/panics"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
 	"net"
 	"sync"
@@ -110,6 +111,7 @@ func (s *gRPCServer) handleInboundConnection(ctx context.Context, stream grpcStr
 	}
 
 	connection := newConnection(s, tcpAddress, stream, nil)
+	connection.authorization = authorizationFromContext(ctx)
 
 	err = s.onConnectedHandler(connection)
 	if err != nil {
@@ -141,3 +143,19 @@ func (s *gRPCServer) decrementInboundConnectionCount() {
 
 	s.inboundConnectionCount--
 }
+
+// AuthorizationMetadataKey is the key of the gRPC metadata in which RPC
+// clients pass their credentials, in the format of an HTTP Authorization header
+const AuthorizationMetadataKey = "authorization"
+
+func authorizationFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(AuthorizationMetadataKey)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
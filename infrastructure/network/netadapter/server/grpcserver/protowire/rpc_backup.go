@@ -0,0 +1,88 @@
+package protowire
+
+import (
+	"github.com/kobradag/kobrad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *KobradMessage_BackupRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KobradMessage_BackupRequest is nil")
+	}
+	return x.BackupRequest.toAppMessage()
+}
+
+func (x *KobradMessage_BackupRequest) fromAppMessage(message *appmessage.BackupRequestMessage) error {
+	x.BackupRequest = &BackupRequestMessage{
+		TargetDirectory: message.TargetDirectory,
+	}
+	return nil
+}
+
+func (x *BackupRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "BackupRequestMessage is nil")
+	}
+	return &appmessage.BackupRequestMessage{
+		TargetDirectory: x.TargetDirectory,
+	}, nil
+}
+
+func (x *KobradMessage_BackupResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KobradMessage_BackupResponse is nil")
+	}
+	return x.BackupResponse.toAppMessage()
+}
+
+func (x *KobradMessage_BackupResponse) fromAppMessage(message *appmessage.BackupResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	sections := make([]*BackupSection, len(message.Sections))
+	for i, section := range message.Sections {
+		sections[i] = &BackupSection{
+			Name:     section.Name,
+			KeyCount: section.KeyCount,
+			Size:     section.Size,
+		}
+	}
+	x.BackupResponse = &BackupResponseMessage{
+		Sections: sections,
+		Error:    err,
+	}
+	return nil
+}
+
+func (x *BackupResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "BackupResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+
+	if rpcErr != nil && len(x.Sections) != 0 {
+		return nil, errors.New("BackupResponseMessage contains both an error and a response")
+	}
+
+	sections := make([]*appmessage.BackupSection, len(x.Sections))
+	for i, section := range x.Sections {
+		if section == nil {
+			return nil, errors.Wrapf(errorNil, "BackupSection is nil")
+		}
+		sections[i] = &appmessage.BackupSection{
+			Name:     section.Name,
+			KeyCount: section.KeyCount,
+			Size:     section.Size,
+		}
+	}
+
+	return &appmessage.BackupResponseMessage{
+		Sections: sections,
+		Error:    rpcErr,
+	}, nil
+}
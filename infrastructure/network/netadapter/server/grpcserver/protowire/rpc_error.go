@@ -1,8 +1,11 @@
 package protowire
 
 import (
+	"strings"
+
 	"github.com/kobradag/kobrad/app/appmessage"
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 func (x *RPCError) toAppMessage() (*appmessage.RPCError, error) {
@@ -11,3 +14,36 @@ func (x *RPCError) toAppMessage() (*appmessage.RPCError, error) {
 	}
 	return &appmessage.RPCError{Message: x.Message}, nil
 }
+
+// NewErrorResponse creates the response message matching the given RPC
+// request, carrying the given error and nothing else. It allows answering
+// any request with an error without knowing its type.
+func NewErrorResponse(request appmessage.Message, rpcError *appmessage.RPCError) (appmessage.Message, error) {
+	requestMessage, err := FromAppMessage(request)
+	if err != nil {
+		return nil, err
+	}
+	reflectMessage := requestMessage.ProtoReflect()
+	payloadOneof := reflectMessage.Descriptor().Oneofs().ByName("payload")
+	requestField := reflectMessage.WhichOneof(payloadOneof)
+	if requestField == nil || !strings.HasSuffix(string(requestField.Name()), "Request") {
+		return nil, errors.Errorf("%s is not an RPC request", request.Command())
+	}
+
+	responseName := strings.TrimSuffix(string(requestField.Name()), "Request") + "Response"
+	responseField := payloadOneof.Fields().ByName(protoreflect.Name(responseName))
+	if responseField == nil {
+		return nil, errors.Errorf("%s has no matching response", request.Command())
+	}
+
+	responseMessage := &KobradMessage{}
+	response := responseMessage.ProtoReflect().NewField(responseField)
+	errorField := responseField.Message().Fields().ByName("error")
+	if errorField == nil {
+		return nil, errors.Errorf("the response to %s has no error field", request.Command())
+	}
+	response.Message().Set(errorField, protoreflect.ValueOfMessage((&RPCError{Message: rpcError.Message}).ProtoReflect()))
+	responseMessage.ProtoReflect().Set(responseField, response)
+
+	return responseMessage.ToAppMessage()
+}
@@ -0,0 +1,49 @@
+package protowire
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kobradag/kobrad/app/appmessage"
+)
+
+func TestNewErrorResponse(t *testing.T) {
+	payloadOneof := (&KobradMessage{}).ProtoReflect().Descriptor().Oneofs().ByName("payload")
+	fields := payloadOneof.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if field.Number() < 1000 || !strings.HasSuffix(string(field.Name()), "Request") {
+			continue
+		}
+
+		requestMessage := &KobradMessage{}
+		reflectMessage := requestMessage.ProtoReflect()
+		reflectMessage.Set(field, reflectMessage.NewField(field))
+		request, err := requestMessage.ToAppMessage()
+		if err != nil {
+			// Some requests can't be empty, which doesn't matter here
+			continue
+		}
+
+		response, err := NewErrorResponse(request, appmessage.RPCErrorf("test error"))
+		if err != nil {
+			t.Errorf("NewErrorResponse for %s: %+v", field.Name(), err)
+			continue
+		}
+		responseMessage, err := FromAppMessage(response)
+		if err != nil {
+			t.Errorf("FromAppMessage for the response to %s: %+v", field.Name(), err)
+			continue
+		}
+		responseField := responseMessage.ProtoReflect().WhichOneof(payloadOneof)
+		expectedName := strings.TrimSuffix(string(field.Name()), "Request") + "Response"
+		if string(responseField.Name()) != expectedName {
+			t.Errorf("Expected a %s, but got a %s", expectedName, responseField.Name())
+		}
+	}
+
+	_, err := NewErrorResponse(appmessage.NewMsgPing(1), appmessage.RPCErrorf("test error"))
+	if err == nil {
+		t.Errorf("Expected an error for a P2P message")
+	}
+}
@@ -13,9 +13,19 @@ func (x *KobradMessage_GetBlockTemplateRequest) toAppMessage() (appmessage.Messa
 }
 
 func (x *KobradMessage_GetBlockTemplateRequest) fromAppMessage(message *appmessage.GetBlockTemplateRequestMessage) error {
+	var payees []*RpcCoinbasePayee
+	if message.Payees != nil {
+		payees = make([]*RpcCoinbasePayee, len(message.Payees))
+		for i, payee := range message.Payees {
+			payees[i] = &RpcCoinbasePayee{}
+			payees[i].fromAppMessage(payee)
+		}
+	}
 	x.GetBlockTemplateRequest = &GetBlockTemplateRequestMessage{
 		PayAddress: message.PayAddress,
 		ExtraData:  message.ExtraData,
+		Payees:     payees,
+		LongPollId: message.LongPollID,
 	}
 	return nil
 }
@@ -24,12 +34,48 @@ func (x *GetBlockTemplateRequestMessage) toAppMessage() (appmessage.Message, err
 	if x == nil {
 		return nil, errors.Wrapf(errorNil, "GetBlockTemplateRequestMessage is nil")
 	}
+	var payees []*appmessage.RPCCoinbasePayee
+	if x.Payees != nil {
+		payees = make([]*appmessage.RPCCoinbasePayee, len(x.Payees))
+		for i, payee := range x.Payees {
+			appPayee, err := payee.toAppMessage()
+			if err != nil {
+				return nil, err
+			}
+			payees[i] = appPayee
+		}
+	}
 	return &appmessage.GetBlockTemplateRequestMessage{
 		PayAddress: x.PayAddress,
 		ExtraData:  x.ExtraData,
+		Payees:     payees,
+		LongPollID: x.LongPollId,
 	}, nil
 }
 
+func (x *RpcCoinbasePayee) toAppMessage() (*appmessage.RPCCoinbasePayee, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "RpcCoinbasePayee is nil")
+	}
+	scriptPublicKey, err := x.ScriptPublicKey.toAppMessage()
+	if err != nil {
+		return nil, err
+	}
+	return &appmessage.RPCCoinbasePayee{
+		ScriptPublicKey: scriptPublicKey,
+		Weight:          x.Weight,
+	}, nil
+}
+
+func (x *RpcCoinbasePayee) fromAppMessage(message *appmessage.RPCCoinbasePayee) {
+	scriptPublicKey := &RpcScriptPublicKey{}
+	scriptPublicKey.fromAppMessage(message.ScriptPublicKey)
+	*x = RpcCoinbasePayee{
+		ScriptPublicKey: scriptPublicKey,
+		Weight:          message.Weight,
+	}
+}
+
 func (x *KobradMessage_GetBlockTemplateResponse) toAppMessage() (appmessage.Message, error) {
 	if x == nil {
 		return nil, errors.Wrapf(errorNil, "KobradMessage_GetBlockTemplateResponse is nil")
@@ -54,9 +100,10 @@ func (x *KobradMessage_GetBlockTemplateResponse) fromAppMessage(message *appmess
 	}
 
 	x.GetBlockTemplateResponse = &GetBlockTemplateResponseMessage{
-		Block:    block,
-		IsSynced: message.IsSynced,
-		Error:    err,
+		Block:      block,
+		IsSynced:   message.IsSynced,
+		TemplateId: message.TemplateID,
+		Error:      err,
 	}
 	return nil
 }
@@ -82,8 +129,9 @@ func (x *GetBlockTemplateResponseMessage) toAppMessage() (appmessage.Message, er
 		}
 	}
 	return &appmessage.GetBlockTemplateResponseMessage{
-		Block:    msgBlock,
-		IsSynced: x.IsSynced,
-		Error:    rpcError,
+		Block:      msgBlock,
+		IsSynced:   x.IsSynced,
+		TemplateID: x.TemplateId,
+		Error:      rpcError,
 	}, nil
 }
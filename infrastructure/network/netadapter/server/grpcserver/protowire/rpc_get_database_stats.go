@@ -0,0 +1,111 @@
+package protowire
+
+import (
+	"github.com/kobradag/kobrad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *KobradMessage_GetDatabaseStatsRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KobradMessage_GetDatabaseStatsRequest is nil")
+	}
+	return &appmessage.GetDatabaseStatsRequestMessage{}, nil
+}
+
+func (x *KobradMessage_GetDatabaseStatsRequest) fromAppMessage(_ *appmessage.GetDatabaseStatsRequestMessage) error {
+	x.GetDatabaseStatsRequest = &GetDatabaseStatsRequestMessage{}
+	return nil
+}
+
+func (x *KobradMessage_GetDatabaseStatsResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KobradMessage_GetDatabaseStatsResponse is nil")
+	}
+	return x.GetDatabaseStatsResponse.toAppMessage()
+}
+
+func (x *KobradMessage_GetDatabaseStatsResponse) fromAppMessage(message *appmessage.GetDatabaseStatsResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	levels := make([]*DatabaseLevelStats, len(message.Levels))
+	for i, level := range message.Levels {
+		levels[i] = &DatabaseLevelStats{
+			Level:                  level.Level,
+			TableCount:             level.TableCount,
+			Size:                   level.Size,
+			ReadBytes:              level.ReadBytes,
+			WriteBytes:             level.WriteBytes,
+			CompactionMilliseconds: level.CompactionMilliseconds,
+		}
+	}
+	x.GetDatabaseStatsResponse = &GetDatabaseStatsResponseMessage{
+		DatabaseType:             message.DatabaseType,
+		Levels:                   levels,
+		MemCompactionCount:       message.MemCompactionCount,
+		Level0CompactionCount:    message.Level0CompactionCount,
+		NonLevel0CompactionCount: message.NonLevel0CompactionCount,
+		SeekCompactionCount:      message.SeekCompactionCount,
+		WriteDelayCount:          message.WriteDelayCount,
+		WriteDelayMilliseconds:   message.WriteDelayMilliseconds,
+		IsWritePaused:            message.IsWritePaused,
+		ReadBytes:                message.ReadBytes,
+		WriteBytes:               message.WriteBytes,
+		BlockCacheSize:           message.BlockCacheSize,
+		OpenedTableCount:         message.OpenedTableCount,
+		AliveSnapshotCount:       message.AliveSnapshotCount,
+		AliveIteratorCount:       message.AliveIteratorCount,
+		Error:                    err,
+	}
+	return nil
+}
+
+func (x *GetDatabaseStatsResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetDatabaseStatsResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+
+	if rpcErr != nil && len(x.Levels) != 0 {
+		return nil, errors.New("GetDatabaseStatsResponseMessage contains both an error and a response")
+	}
+
+	levels := make([]*appmessage.DatabaseLevelStats, len(x.Levels))
+	for i, level := range x.Levels {
+		if level == nil {
+			return nil, errors.Wrapf(errorNil, "DatabaseLevelStats is nil")
+		}
+		levels[i] = &appmessage.DatabaseLevelStats{
+			Level:                  level.Level,
+			TableCount:             level.TableCount,
+			Size:                   level.Size,
+			ReadBytes:              level.ReadBytes,
+			WriteBytes:             level.WriteBytes,
+			CompactionMilliseconds: level.CompactionMilliseconds,
+		}
+	}
+
+	return &appmessage.GetDatabaseStatsResponseMessage{
+		DatabaseType:             x.DatabaseType,
+		Levels:                   levels,
+		MemCompactionCount:       x.MemCompactionCount,
+		Level0CompactionCount:    x.Level0CompactionCount,
+		NonLevel0CompactionCount: x.NonLevel0CompactionCount,
+		SeekCompactionCount:      x.SeekCompactionCount,
+		WriteDelayCount:          x.WriteDelayCount,
+		WriteDelayMilliseconds:   x.WriteDelayMilliseconds,
+		IsWritePaused:            x.IsWritePaused,
+		ReadBytes:                x.ReadBytes,
+		WriteBytes:               x.WriteBytes,
+		BlockCacheSize:           x.BlockCacheSize,
+		OpenedTableCount:         x.OpenedTableCount,
+		AliveSnapshotCount:       x.AliveSnapshotCount,
+		AliveIteratorCount:       x.AliveIteratorCount,
+		Error:                    rpcErr,
+	}, nil
+}
@@ -0,0 +1,113 @@
+package protowire
+
+import (
+	"github.com/kobradag/kobrad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *KobradMessage_GetFeeEstimateRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KobradMessage_GetFeeEstimateRequest is nil")
+	}
+	return &appmessage.GetFeeEstimateRequestMessage{}, nil
+}
+
+func (x *KobradMessage_GetFeeEstimateRequest) fromAppMessage(_ *appmessage.GetFeeEstimateRequestMessage) error {
+	x.GetFeeEstimateRequest = &GetFeeEstimateRequestMessage{}
+	return nil
+}
+
+func (x *KobradMessage_GetFeeEstimateResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KobradMessage_GetFeeEstimateResponse is nil")
+	}
+	return x.GetFeeEstimateResponse.toAppMessage()
+}
+
+func (x *KobradMessage_GetFeeEstimateResponse) fromAppMessage(message *appmessage.GetFeeEstimateResponseMessage) error {
+	var rpcErr *RPCError
+	if message.Error != nil {
+		rpcErr = &RPCError{Message: message.Error.Message}
+	}
+	var estimate *RpcFeeEstimate
+	if message.Estimate != nil {
+		estimate = &RpcFeeEstimate{
+			PriorityBucket: rpcFeeRateBucketFromAppMessage(&message.Estimate.PriorityBucket),
+			NormalBucket:   rpcFeeRateBucketFromAppMessage(&message.Estimate.NormalBucket),
+			LowBucket:      rpcFeeRateBucketFromAppMessage(&message.Estimate.LowBucket),
+		}
+	}
+	x.GetFeeEstimateResponse = &GetFeeEstimateResponseMessage{
+		Estimate: estimate,
+		Error:    rpcErr,
+	}
+	return nil
+}
+
+func (x *GetFeeEstimateResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetFeeEstimateResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+
+	if rpcErr != nil && x.Estimate != nil {
+		return nil, errors.New("GetFeeEstimateResponseMessage contains both an error and a response")
+	}
+
+	var estimate *appmessage.RPCFeeEstimate
+	if rpcErr == nil {
+		estimate, err = x.Estimate.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &appmessage.GetFeeEstimateResponseMessage{
+		Estimate: estimate,
+		Error:    rpcErr,
+	}, nil
+}
+
+func (x *RpcFeeEstimate) toAppMessage() (*appmessage.RPCFeeEstimate, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "RpcFeeEstimate is nil")
+	}
+	priorityBucket, err := x.PriorityBucket.toAppMessage()
+	if err != nil {
+		return nil, err
+	}
+	normalBucket, err := x.NormalBucket.toAppMessage()
+	if err != nil {
+		return nil, err
+	}
+	lowBucket, err := x.LowBucket.toAppMessage()
+	if err != nil {
+		return nil, err
+	}
+	return &appmessage.RPCFeeEstimate{
+		PriorityBucket: *priorityBucket,
+		NormalBucket:   *normalBucket,
+		LowBucket:      *lowBucket,
+	}, nil
+}
+
+func (x *RpcFeeRateBucket) toAppMessage() (*appmessage.RPCFeeRateBucket, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "RpcFeeRateBucket is nil")
+	}
+	return &appmessage.RPCFeeRateBucket{
+		FeeRate:          x.FeeRate,
+		EstimatedSeconds: x.EstimatedSeconds,
+	}, nil
+}
+
+func rpcFeeRateBucketFromAppMessage(bucket *appmessage.RPCFeeRateBucket) *RpcFeeRateBucket {
+	return &RpcFeeRateBucket{
+		FeeRate:          bucket.FeeRate,
+		EstimatedSeconds: bucket.EstimatedSeconds,
+	}
+}
@@ -0,0 +1,115 @@
+package protowire
+
+import (
+	"github.com/kobradag/kobrad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *KobradMessage_GetTransactionsByAddressesRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KobradMessage_GetTransactionsByAddressesRequest is nil")
+	}
+	return x.GetTransactionsByAddressesRequest.toAppMessage()
+}
+
+func (x *KobradMessage_GetTransactionsByAddressesRequest) fromAppMessage(message *appmessage.GetTransactionsByAddressesRequestMessage) error {
+	x.GetTransactionsByAddressesRequest = &GetTransactionsByAddressesRequestMessage{
+		Addresses: message.Addresses,
+		Cursor:    message.Cursor,
+		Limit:     message.Limit,
+	}
+	return nil
+}
+
+func (x *GetTransactionsByAddressesRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetTransactionsByAddressesRequestMessage is nil")
+	}
+	return &appmessage.GetTransactionsByAddressesRequestMessage{
+		Addresses: x.Addresses,
+		Cursor:    x.Cursor,
+		Limit:     x.Limit,
+	}, nil
+}
+
+func (x *KobradMessage_GetTransactionsByAddressesResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KobradMessage_GetTransactionsByAddressesResponse is nil")
+	}
+	return x.GetTransactionsByAddressesResponse.toAppMessage()
+}
+
+func (x *KobradMessage_GetTransactionsByAddressesResponse) fromAppMessage(message *appmessage.GetTransactionsByAddressesResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	entries := make([]*TransactionsByAddressesEntry, len(message.Entries))
+	for i, entry := range message.Entries {
+		entries[i] = &TransactionsByAddressesEntry{}
+		entries[i].fromAppMessage(entry)
+	}
+	x.GetTransactionsByAddressesResponse = &GetTransactionsByAddressesResponseMessage{
+		Entries:    entries,
+		NextCursor: message.NextCursor,
+		Error:      err,
+	}
+	return nil
+}
+
+func (x *GetTransactionsByAddressesResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetTransactionsByAddressesResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+
+	if rpcErr != nil && len(x.Entries) != 0 {
+		return nil, errors.New("GetTransactionsByAddressesResponseMessage contains both an error and a response")
+	}
+
+	entries := make([]*appmessage.TransactionsByAddressesEntry, len(x.Entries))
+	for i, entry := range x.Entries {
+		entryAsAppMessage, err := entry.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+		entries[i] = entryAsAppMessage
+	}
+
+	return &appmessage.GetTransactionsByAddressesResponseMessage{
+		Entries:    entries,
+		NextCursor: x.NextCursor,
+		Error:      rpcErr,
+	}, nil
+}
+
+func (x *TransactionsByAddressesEntry) toAppMessage() (*appmessage.TransactionsByAddressesEntry, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "TransactionsByAddressesEntry is nil")
+	}
+	return &appmessage.TransactionsByAddressesEntry{
+		Address:                x.Address,
+		TransactionID:          x.TransactionId,
+		IncludingBlockHash:     x.IncludingBlockHash,
+		AcceptingBlockHash:     x.AcceptingBlockHash,
+		AcceptingBlockDAAScore: x.AcceptingBlockDaaScore,
+		Received:               x.Received,
+		Sent:                   x.Sent,
+	}, nil
+}
+
+func (x *TransactionsByAddressesEntry) fromAppMessage(message *appmessage.TransactionsByAddressesEntry) {
+	*x = TransactionsByAddressesEntry{
+		Address:                message.Address,
+		TransactionId:          message.TransactionID,
+		IncludingBlockHash:     message.IncludingBlockHash,
+		AcceptingBlockHash:     message.AcceptingBlockHash,
+		AcceptingBlockDaaScore: message.AcceptingBlockDAAScore,
+		Received:               message.Received,
+		Sent:                   message.Sent,
+	}
+}
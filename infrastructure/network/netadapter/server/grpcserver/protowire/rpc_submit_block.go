@@ -15,8 +15,16 @@ func (x *KobradMessage_SubmitBlockRequest) toAppMessage() (appmessage.Message, e
 }
 
 func (x *KobradMessage_SubmitBlockRequest) fromAppMessage(message *appmessage.SubmitBlockRequestMessage) error {
-	x.SubmitBlockRequest = &SubmitBlockRequestMessage{Block: &RpcBlock{}}
-	x.SubmitBlockRequest.AllowNonDAABlocks = message.AllowNonDAABlocks
+	x.SubmitBlockRequest = &SubmitBlockRequestMessage{
+		AllowNonDAABlocks: message.AllowNonDAABlocks,
+		TemplateId:        message.TemplateID,
+		Nonce:             message.Nonce,
+		Timestamp:         message.Timestamp,
+	}
+	if message.Block == nil {
+		return nil
+	}
+	x.SubmitBlockRequest.Block = &RpcBlock{}
 	return x.SubmitBlockRequest.Block.fromAppMessage(message.Block)
 }
 
@@ -24,13 +32,21 @@ func (x *SubmitBlockRequestMessage) toAppMessage() (appmessage.Message, error) {
 	if x == nil {
 		return nil, errors.Wrapf(errorNil, "SubmitBlockRequestMessage is nil")
 	}
-	blockAppMessage, err := x.Block.toAppMessage()
-	if err != nil {
-		return nil, err
+	// A block that's submitted by the ID of its template has no body
+	var blockAppMessage *appmessage.RPCBlock
+	if x.TemplateId == "" || x.Block != nil {
+		var err error
+		blockAppMessage, err = x.Block.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
 	}
 	return &appmessage.SubmitBlockRequestMessage{
 		Block:             blockAppMessage,
 		AllowNonDAABlocks: x.GetAllowNonDAABlocks(),
+		TemplateID:        x.TemplateId,
+		Nonce:             x.Nonce,
+		Timestamp:         x.Timestamp,
 	}, nil
 }
 
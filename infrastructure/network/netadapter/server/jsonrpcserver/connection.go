@@ -0,0 +1,311 @@
+package jsonrpcserver
+
+import (
+	"encoding/json"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/kobradag/kobrad/app/appmessage"
+	"github.com/kobradag/kobrad/infrastructure/network/netadapter/router"
+	"github.com/kobradag/kobrad/infrastructure/network/netadapter/server"
+	"github.com/pkg/errors"
+	"golang.org/x/net/websocket"
+)
+
+// jsonRPCConnection is a connection of a JSON-RPC client. A plain HTTP
+// request is a connection that lives for a single request, while a WebSocket
+// is a persistent connection that receives notifications as well.
+type jsonRPCConnection struct {
+	address       *net.TCPAddr
+	webSocket     *websocket.Conn
+	authorization string
+	router        *router.Router
+
+	// pendingIDs are the IDs of the requests that were passed to the
+	// router and are awaiting their responses, in the order they were
+	// received. Responses are sent in the same order as the requests.
+	pendingIDs     []json.RawMessage
+	pendingIDsLock sync.Mutex
+
+	writeLock sync.Mutex
+
+	stopChan                chan struct{}
+	onDisconnectedHandler   server.OnDisconnectedHandler
+	onInvalidMessageHandler server.OnInvalidMessageHandler
+
+	isConnected uint32
+}
+
+func newConnection(address *net.TCPAddr, webSocket *websocket.Conn, authorization string) *jsonRPCConnection {
+	return &jsonRPCConnection{
+		address:       address,
+		webSocket:     webSocket,
+		authorization: authorization,
+		stopChan:      make(chan struct{}),
+		isConnected:   1,
+	}
+}
+
+func (c *jsonRPCConnection) Start(router *router.Router) {
+	if c.onDisconnectedHandler == nil {
+		panic(errors.New("onDisconnectedHandler is nil"))
+	}
+
+	c.router = router
+
+	if c.webSocket == nil {
+		return
+	}
+	spawn("jsonRPCConnection.Start-connectionLoops", func() {
+		err := c.connectionLoops()
+		if err != nil {
+			log.Errorf("error from connectionLoops for %s: %s", c.address, err)
+		}
+	})
+}
+
+func (c *jsonRPCConnection) String() string {
+	return c.Address().String()
+}
+
+func (c *jsonRPCConnection) IsConnected() bool {
+	return atomic.LoadUint32(&c.isConnected) != 0
+}
+
+func (c *jsonRPCConnection) IsOutbound() bool {
+	return false
+}
+
+func (c *jsonRPCConnection) SetOnDisconnectedHandler(onDisconnectedHandler server.OnDisconnectedHandler) {
+	c.onDisconnectedHandler = onDisconnectedHandler
+}
+
+func (c *jsonRPCConnection) SetOnInvalidMessageHandler(onInvalidMessageHandler server.OnInvalidMessageHandler) {
+	c.onInvalidMessageHandler = onInvalidMessageHandler
+}
+
+// Disconnect disconnects the connection
+// Calling this function a second time doesn't do anything
+//
+// This is part of the Connection interface
+func (c *jsonRPCConnection) Disconnect() {
+	if !atomic.CompareAndSwapUint32(&c.isConnected, 1, 0) {
+		return
+	}
+
+	close(c.stopChan)
+
+	if c.webSocket != nil {
+		// ignore error because we don't really know what's the status of the connection
+		_ = c.webSocket.Close()
+	}
+
+	log.Debugf("Disconnecting from %s", c)
+	if c.onDisconnectedHandler != nil {
+		c.onDisconnectedHandler()
+	}
+}
+
+func (c *jsonRPCConnection) Address() *net.TCPAddr {
+	return c.address
+}
+
+func (c *jsonRPCConnection) Authorization() string {
+	return c.authorization
+}
+
+// exchange passes the given request to the router, waits for its response
+// and disconnects. It is used for plain HTTP requests.
+func (c *jsonRPCConnection) exchange(request *jsonRPCRequest, message appmessage.Message) *jsonRPCResponse {
+	defer c.Disconnect()
+
+	err := c.router.EnqueueIncomingMessage(message)
+	if err != nil {
+		return newErrorResponse(request.ID, enqueueError(request, err))
+	}
+
+	response, err := c.router.OutgoingRoute().Dequeue()
+	if err != nil {
+		return newErrorResponse(request.ID, newJSONRPCError(errorCodeInternal, "%s", err))
+	}
+	return toResponse(request.ID, response)
+}
+
+func (c *jsonRPCConnection) connectionLoops() error {
+	errChan := make(chan error, 1) // buffered channel because one of the loops might try write after disconnect
+
+	spawn("jsonRPCConnection.receiveLoop", func() { errChan <- c.receiveLoop() })
+	spawn("jsonRPCConnection.sendLoop", func() { errChan <- c.sendLoop() })
+
+	err := <-errChan
+
+	c.Disconnect()
+
+	return err
+}
+
+func (c *jsonRPCConnection) receiveLoop() error {
+	for c.IsConnected() {
+		var data []byte
+		err := websocket.Message.Receive(c.webSocket, &data)
+		if err != nil {
+			if err == io.EOF || !c.IsConnected() {
+				return nil
+			}
+			return err
+		}
+
+		request := &jsonRPCRequest{}
+		err = json.Unmarshal(data, request)
+		if err != nil {
+			err = c.write(newErrorResponse(nil, newJSONRPCError(errorCodeParse, "parse error: %s", err)))
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		message, rpcErr := toAppMessage(request)
+		if rpcErr != nil {
+			if request.isNotification() {
+				continue
+			}
+			err = c.write(newErrorResponse(request.ID, rpcErr))
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		log.Debugf("incoming '%s' message from %s", message.Command(), c)
+
+		c.pushPendingID(request.ID)
+		err = c.router.EnqueueIncomingMessage(message)
+		if err != nil {
+			if errors.Is(err, router.ErrRouteClosed) {
+				return nil
+			}
+			// ErrRouteCapacityReached isn't an invalid message error, so
+			// we return it in order to log it later on.
+			if errors.Is(err, router.ErrRouteCapacityReached) {
+				return err
+			}
+
+			c.removeLastPendingID()
+			if request.isNotification() {
+				continue
+			}
+			err = c.write(newErrorResponse(request.ID, enqueueError(request, err)))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (c *jsonRPCConnection) sendLoop() error {
+	outgoingRoute := c.router.OutgoingRoute()
+	for c.IsConnected() {
+		message, err := outgoingRoute.Dequeue()
+		if err != nil {
+			if errors.Is(err, router.ErrRouteClosed) {
+				return nil
+			}
+			return err
+		}
+
+		log.Debugf("outgoing '%s' message to %s", message.Command(), c)
+
+		name, payload, _, err := fromAppMessage(message)
+		if err != nil {
+			return err
+		}
+		if strings.HasSuffix(name, notificationSuffix) {
+			err = c.write(&jsonRPCNotification{
+				JSONRPC: jsonRPCVersion,
+				Method:  name,
+				Params:  payload,
+			})
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		id, ok := c.popPendingID()
+		if !ok {
+			log.Warnf("Got an unexpected '%s' message for %s", message.Command(), c)
+			continue
+		}
+		if len(id) == 0 {
+			// The request was a JSON-RPC notification, so no response is expected
+			continue
+		}
+		err = c.write(toResponse(id, message))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *jsonRPCConnection) write(value interface{}) error {
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+
+	return websocket.JSON.Send(c.webSocket, value)
+}
+
+func (c *jsonRPCConnection) pushPendingID(id json.RawMessage) {
+	c.pendingIDsLock.Lock()
+	defer c.pendingIDsLock.Unlock()
+
+	c.pendingIDs = append(c.pendingIDs, id)
+}
+
+func (c *jsonRPCConnection) popPendingID() (json.RawMessage, bool) {
+	c.pendingIDsLock.Lock()
+	defer c.pendingIDsLock.Unlock()
+
+	if len(c.pendingIDs) == 0 {
+		return nil, false
+	}
+	id := c.pendingIDs[0]
+	c.pendingIDs = c.pendingIDs[1:]
+	return id, true
+}
+
+// removeLastPendingID removes the ID pushed by the receive loop for a
+// request that could not be passed to the router
+func (c *jsonRPCConnection) removeLastPendingID() {
+	c.pendingIDsLock.Lock()
+	defer c.pendingIDsLock.Unlock()
+
+	c.pendingIDs = c.pendingIDs[:len(c.pendingIDs)-1]
+}
+
+// toResponse converts the given appmessage response to a JSON-RPC response
+func toResponse(id json.RawMessage, message appmessage.Message) *jsonRPCResponse {
+	_, payload, rpcErr, err := fromAppMessage(message)
+	if err != nil {
+		return newErrorResponse(id, newJSONRPCError(errorCodeInternal, "%s", err))
+	}
+	if rpcErr != nil {
+		return newErrorResponse(id, rpcErr)
+	}
+	return newResultResponse(id, payload)
+}
+
+// enqueueError returns the JSON-RPC error for a request the router refused.
+// The router refuses requests it has no route for, which are requests that
+// the RPC server doesn't handle.
+func enqueueError(request *jsonRPCRequest, err error) *jsonRPCError {
+	if errors.Is(err, router.ErrRouteClosed) || errors.Is(err, router.ErrRouteCapacityReached) {
+		return newJSONRPCError(errorCodeInternal, "%s", err)
+	}
+	return newJSONRPCError(errorCodeMethodNotFound, "method %s is not supported", request.Method)
+}
@@ -0,0 +1,172 @@
+package jsonrpcserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/kobradag/kobrad/app/appmessage"
+	"github.com/kobradag/kobrad/infrastructure/network/netadapter/server/grpcserver/protowire"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const jsonRPCVersion = "2.0"
+
+// The error codes defined by the JSON-RPC 2.0 specification, and
+// errorCodeServer which is used for errors returned by the RPC handlers
+const (
+	errorCodeParse          = -32700
+	errorCodeInvalidRequest = -32600
+	errorCodeMethodNotFound = -32601
+	errorCodeInvalidParams  = -32602
+	errorCodeInternal       = -32603
+	errorCodeServer         = -32000
+)
+
+// rpcFieldNumberStart is the number of the first RPC message in the payload of
+// KobradMessage. All the messages numbered below it are P2P messages.
+const rpcFieldNumberStart = 1000
+
+const (
+	requestSuffix      = "Request"
+	responseSuffix     = "Response"
+	notificationSuffix = "Notification"
+)
+
+type jsonRPCRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// isNotification returns whether the request is a JSON-RPC notification,
+// which is a request that the client expects no response to
+func (r *jsonRPCRequest) isNotification() bool {
+	return len(r.ID) == 0
+}
+
+type jsonRPCResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *jsonRPCError   `json:"error,omitempty"`
+}
+
+type jsonRPCNotification struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+}
+
+type jsonRPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func newJSONRPCError(code int, format string, args ...interface{}) *jsonRPCError {
+	return &jsonRPCError{
+		Code:    code,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+var nullID = json.RawMessage("null")
+
+func newResultResponse(id json.RawMessage, result json.RawMessage) *jsonRPCResponse {
+	return &jsonRPCResponse{JSONRPC: jsonRPCVersion, ID: id, Result: result}
+}
+
+func newErrorResponse(id json.RawMessage, err *jsonRPCError) *jsonRPCResponse {
+	if len(id) == 0 {
+		id = nullID
+	}
+	return &jsonRPCResponse{JSONRPC: jsonRPCVersion, ID: id, Error: err}
+}
+
+var payloadOneof = (&protowire.KobradMessage{}).ProtoReflect().Descriptor().Oneofs().ByName("payload")
+
+// methods maps JSON-RPC method names to the KobradMessage payload fields of
+// the corresponding requests. The method name of a request is the name of its
+// payload field without the "Request" suffix - e.g. the method of
+// getBlockDagInfoRequest is getBlockDagInfo.
+var methods = func() map[string]protoreflect.FieldDescriptor {
+	methods := make(map[string]protoreflect.FieldDescriptor)
+	fields := payloadOneof.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		name := field.JSONName()
+		if field.Number() < rpcFieldNumberStart || !strings.HasSuffix(name, requestSuffix) {
+			continue
+		}
+		methods[strings.TrimSuffix(name, requestSuffix)] = field
+	}
+	return methods
+}()
+
+// isSubscriptionMethod returns whether the given method subscribes to or
+// unsubscribes from notifications, which requires a persistent connection
+func isSubscriptionMethod(method string) bool {
+	return strings.HasPrefix(method, "notify") || strings.HasPrefix(method, "stopNotifying")
+}
+
+var marshalOptions = protojson.MarshalOptions{EmitUnpopulated: true}
+
+// toAppMessage converts the given JSON-RPC request to the appmessage request it maps onto
+func toAppMessage(request *jsonRPCRequest) (appmessage.Message, *jsonRPCError) {
+	if request.JSONRPC != jsonRPCVersion {
+		return nil, newJSONRPCError(errorCodeInvalidRequest, "jsonrpc must be %s", jsonRPCVersion)
+	}
+	field, ok := methods[request.Method]
+	if !ok {
+		return nil, newJSONRPCError(errorCodeMethodNotFound, "method %s not found", request.Method)
+	}
+
+	kobradMessage := &protowire.KobradMessage{}
+	reflectMessage := kobradMessage.ProtoReflect()
+	payload := reflectMessage.NewField(field)
+	if len(request.Params) > 0 && string(request.Params) != "null" {
+		err := protojson.Unmarshal(request.Params, payload.Message().Interface())
+		if err != nil {
+			return nil, newJSONRPCError(errorCodeInvalidParams, "invalid params: %s", err)
+		}
+	}
+	reflectMessage.Set(field, payload)
+
+	message, err := kobradMessage.ToAppMessage()
+	if err != nil {
+		return nil, newJSONRPCError(errorCodeInvalidParams, "invalid params: %s", err)
+	}
+	return message, nil
+}
+
+// fromAppMessage converts the given appmessage response or notification to
+// JSON. It returns the JSON name of the payload field, the payload itself,
+// and the error the payload carries, if any.
+func fromAppMessage(message appmessage.Message) (string, json.RawMessage, *jsonRPCError, error) {
+	kobradMessage, err := protowire.FromAppMessage(message)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	reflectMessage := kobradMessage.ProtoReflect()
+	field := reflectMessage.WhichOneof(payloadOneof)
+	if field == nil {
+		return "", nil, nil, errors.Errorf("message %s has no payload", message.Command())
+	}
+	payload := reflectMessage.Get(field).Message()
+
+	errorField := payload.Descriptor().Fields().ByName("error")
+	if errorField != nil && errorField.Kind() == protoreflect.MessageKind && payload.Has(errorField) {
+		rpcError := payload.Get(errorField).Message()
+		errorMessage := rpcError.Get(rpcError.Descriptor().Fields().ByName("message")).String()
+		return field.JSONName(), nil, newJSONRPCError(errorCodeServer, "%s", errorMessage), nil
+	}
+
+	payloadJSON, err := marshalOptions.Marshal(payload.Interface())
+	if err != nil {
+		return "", nil, nil, err
+	}
+	return field.JSONName(), payloadJSON, nil, nil
+}
@@ -0,0 +1,283 @@
+package jsonrpcserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kobradag/kobrad/app/appmessage"
+	"github.com/kobradag/kobrad/infrastructure/network/netadapter/router"
+	"github.com/kobradag/kobrad/infrastructure/network/netadapter/server"
+	"golang.org/x/net/websocket"
+)
+
+func TestToAppMessage(t *testing.T) {
+	request := &jsonRPCRequest{
+		JSONRPC: jsonRPCVersion,
+		ID:      json.RawMessage("1"),
+		Method:  "getBlock",
+		Params:  json.RawMessage(`{"hash": "abcd", "includeTransactions": true}`),
+	}
+	message, rpcErr := toAppMessage(request)
+	if rpcErr != nil {
+		t.Fatalf("toAppMessage: %s", rpcErr.Message)
+	}
+	getBlockRequest, ok := message.(*appmessage.GetBlockRequestMessage)
+	if !ok {
+		t.Fatalf("Expected a GetBlockRequestMessage, but got %T", message)
+	}
+	if getBlockRequest.Hash != "abcd" || !getBlockRequest.IncludeTransactions {
+		t.Errorf("Unexpected request %+v", getBlockRequest)
+	}
+
+	tests := []struct {
+		name          string
+		request       *jsonRPCRequest
+		expectedError int
+	}{
+		{
+			name:          "unknown method",
+			request:       &jsonRPCRequest{JSONRPC: jsonRPCVersion, Method: "noSuchMethod"},
+			expectedError: errorCodeMethodNotFound,
+		},
+		{
+			name:          "p2p message",
+			request:       &jsonRPCRequest{JSONRPC: jsonRPCVersion, Method: "requestAddresses"},
+			expectedError: errorCodeMethodNotFound,
+		},
+		{
+			name:          "response message",
+			request:       &jsonRPCRequest{JSONRPC: jsonRPCVersion, Method: "getInfoResponse"},
+			expectedError: errorCodeMethodNotFound,
+		},
+		{
+			name: "positional params",
+			request: &jsonRPCRequest{JSONRPC: jsonRPCVersion, Method: "getBlock",
+				Params: json.RawMessage(`["abcd", true]`)},
+			expectedError: errorCodeInvalidParams,
+		},
+		{
+			name:          "wrong version",
+			request:       &jsonRPCRequest{JSONRPC: "1.0", Method: "getInfo"},
+			expectedError: errorCodeInvalidRequest,
+		},
+	}
+	for _, test := range tests {
+		_, rpcErr := toAppMessage(test.request)
+		if rpcErr == nil {
+			t.Errorf("%s: expected an error", test.name)
+			continue
+		}
+		if rpcErr.Code != test.expectedError {
+			t.Errorf("%s: expected error code %d, but got %d", test.name, test.expectedError, rpcErr.Code)
+		}
+	}
+}
+
+func TestFromAppMessage(t *testing.T) {
+	name, payload, rpcErr, err := fromAppMessage(appmessage.NewGetInfoResponseMessage("id", 3, "1.0.0", true, false))
+	if err != nil {
+		t.Fatalf("fromAppMessage: %+v", err)
+	}
+	if name != "getInfoResponse" || rpcErr != nil {
+		t.Fatalf("Unexpected name %s or error %v", name, rpcErr)
+	}
+	var result map[string]interface{}
+	err = json.Unmarshal(payload, &result)
+	if err != nil {
+		t.Fatalf("Unmarshal: %s", err)
+	}
+	if result["p2pId"] != "id" || result["isSynced"] != false {
+		t.Errorf("Unexpected payload %s", payload)
+	}
+
+	errorResponse := &appmessage.GetInfoResponseMessage{}
+	errorResponse.Error = appmessage.RPCErrorf("something went wrong")
+	_, _, rpcErr, err = fromAppMessage(errorResponse)
+	if err != nil {
+		t.Fatalf("fromAppMessage: %+v", err)
+	}
+	if rpcErr == nil || rpcErr.Code != errorCodeServer || rpcErr.Message != "something went wrong" {
+		t.Errorf("Unexpected error %+v", rpcErr)
+	}
+}
+
+func TestHTTPRequest(t *testing.T) {
+	jsonRPCServer, err := NewJSONRPCServer(nil, 0, 0, nil)
+	if err != nil {
+		t.Fatalf("NewJSONRPCServer: %+v", err)
+	}
+	jsonRPCServer.SetOnConnectedHandler(func(connection server.Connection) error {
+		connectionRouter := router.NewRouter("test")
+		incomingRoute, err := connectionRouter.AddIncomingRoute("test",
+			[]appmessage.MessageCommand{appmessage.CmdGetInfoRequestMessage})
+		if err != nil {
+			return err
+		}
+		connection.SetOnDisconnectedHandler(connectionRouter.Close)
+		connection.Start(connectionRouter)
+
+		go func() {
+			_, err := incomingRoute.Dequeue()
+			if err != nil {
+				return
+			}
+			_ = connectionRouter.OutgoingRoute().Enqueue(
+				appmessage.NewGetInfoResponseMessage("id", 3, "1.0.0", true, false))
+		}()
+		return nil
+	})
+	httpServer := httptest.NewServer(jsonRPCServer.(http.Handler))
+	defer httpServer.Close()
+
+	post := func(body string) *jsonRPCResponse {
+		httpResponse, err := http.Post(httpServer.URL, "application/json", bytes.NewBufferString(body))
+		if err != nil {
+			t.Fatalf("Post: %s", err)
+		}
+		defer httpResponse.Body.Close()
+
+		response := &jsonRPCResponse{}
+		err = json.NewDecoder(httpResponse.Body).Decode(response)
+		if err != nil {
+			t.Fatalf("Decode: %s", err)
+		}
+		return response
+	}
+
+	response := post(`{"jsonrpc": "2.0", "id": 7, "method": "getInfo"}`)
+	if response.Error != nil {
+		t.Fatalf("Unexpected error: %s", response.Error.Message)
+	}
+	if string(response.ID) != "7" || len(response.Result) == 0 {
+		t.Errorf("Unexpected response %+v", response)
+	}
+
+	response = post(`{"jsonrpc": "2.0", "id": 8, "method": "getBlock"}`)
+	if response.Error == nil || response.Error.Code != errorCodeMethodNotFound {
+		t.Errorf("Expected a method not found error for a method without a route, but got %+v", response.Error)
+	}
+
+	response = post(`{"jsonrpc": "2.0", "id": 9, "method": "notifyBlockAdded"}`)
+	if response.Error == nil || response.Error.Code != errorCodeInvalidRequest {
+		t.Errorf("Expected subscriptions to be refused over HTTP, but got %+v", response.Error)
+	}
+
+	response = post(`{not json`)
+	if response.Error == nil || response.Error.Code != errorCodeParse || string(response.ID) != "null" {
+		t.Errorf("Expected a parse error, but got %+v", response)
+	}
+}
+
+func TestCrossOriginRequests(t *testing.T) {
+	jsonRPCServer, err := NewJSONRPCServer(nil, 0, 0, []string{"https://explorer.example.com/"})
+	if err != nil {
+		t.Fatalf("NewJSONRPCServer: %+v", err)
+	}
+	jsonRPCServer.SetOnConnectedHandler(func(connection server.Connection) error {
+		connectionRouter := router.NewRouter("test")
+		connection.SetOnDisconnectedHandler(connectionRouter.Close)
+		connection.Start(connectionRouter)
+		return nil
+	})
+	httpServer := httptest.NewServer(jsonRPCServer.(http.Handler))
+	defer httpServer.Close()
+
+	post := func(contentType string, origin string) int {
+		httpRequest, err := http.NewRequest(http.MethodPost, httpServer.URL,
+			bytes.NewBufferString(`{"jsonrpc": "2.0", "id": 1, "method": "noSuchMethod"}`))
+		if err != nil {
+			t.Fatalf("NewRequest: %s", err)
+		}
+		httpRequest.Header.Set("Content-Type", contentType)
+		if origin != "" {
+			httpRequest.Header.Set("Origin", origin)
+		}
+		httpResponse, err := http.DefaultClient.Do(httpRequest)
+		if err != nil {
+			t.Fatalf("Do: %s", err)
+		}
+		httpResponse.Body.Close()
+		return httpResponse.StatusCode
+	}
+
+	tests := []struct {
+		name               string
+		contentType        string
+		origin             string
+		expectedStatusCode int
+	}{
+		{name: "no origin", contentType: "application/json", expectedStatusCode: http.StatusOK},
+		{name: "charset", contentType: "application/json; charset=utf-8", expectedStatusCode: http.StatusOK},
+		{name: "same origin", contentType: "application/json", origin: httpServer.URL,
+			expectedStatusCode: http.StatusOK},
+		{name: "allowed origin", contentType: "application/json", origin: "https://Explorer.example.com",
+			expectedStatusCode: http.StatusOK},
+		{name: "cross origin", contentType: "application/json", origin: "https://evil.example.com",
+			expectedStatusCode: http.StatusForbidden},
+		{name: "plain text", contentType: "text/plain", expectedStatusCode: http.StatusUnsupportedMediaType},
+		{name: "form", contentType: "application/x-www-form-urlencoded",
+			expectedStatusCode: http.StatusUnsupportedMediaType},
+	}
+	for _, test := range tests {
+		statusCode := post(test.contentType, test.origin)
+		if statusCode != test.expectedStatusCode {
+			t.Errorf("%s: expected status code %d, but got %d", test.name, test.expectedStatusCode, statusCode)
+		}
+	}
+
+	webSocketURL := "ws" + strings.TrimPrefix(httpServer.URL, "http")
+	_, err = websocket.Dial(webSocketURL, "", "https://evil.example.com")
+	if err == nil {
+		t.Errorf("Expected a cross-origin WebSocket to be refused")
+	}
+	webSocket, err := websocket.Dial(webSocketURL, "", "https://explorer.example.com")
+	if err != nil {
+		t.Fatalf("Expected a WebSocket from an allowed origin to be accepted, but got: %s", err)
+	}
+	webSocket.Close()
+}
+
+func TestMaxClients(t *testing.T) {
+	jsonRPCServer, err := NewJSONRPCServer(nil, 1, 0, nil)
+	if err != nil {
+		t.Fatalf("NewJSONRPCServer: %+v", err)
+	}
+	jsonRPCServer.SetOnConnectedHandler(func(connection server.Connection) error {
+		connectionRouter := router.NewRouter("test")
+		connection.SetOnDisconnectedHandler(connectionRouter.Close)
+		connection.Start(connectionRouter)
+		return nil
+	})
+	httpServer := httptest.NewServer(jsonRPCServer.(http.Handler))
+	defer httpServer.Close()
+
+	webSocketURL := "ws" + strings.TrimPrefix(httpServer.URL, "http")
+	webSocket, err := websocket.Dial(webSocketURL, "", httpServer.URL)
+	if err != nil {
+		t.Fatalf("Dial: %s", err)
+	}
+	defer webSocket.Close()
+
+	// The WebSocket is counted once its handler starts, so wait for the
+	// server to refuse new clients
+	for i := 0; ; i++ {
+		httpResponse, err := http.Post(httpServer.URL, "application/json",
+			bytes.NewBufferString(`{"jsonrpc": "2.0", "id": 1, "method": "noSuchMethod"}`))
+		if err != nil {
+			t.Fatalf("Post: %s", err)
+		}
+		httpResponse.Body.Close()
+		if httpResponse.StatusCode == http.StatusServiceUnavailable {
+			break
+		}
+		if i == 100 {
+			t.Fatalf("Expected the request to be refused, but got status code %d", httpResponse.StatusCode)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,310 @@
+package jsonrpcserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kobradag/kobrad/infrastructure/network/netadapter/server"
+	"github.com/kobradag/kobrad/util/panics"
+	"github.com/pkg/errors"
+	"golang.org/x/net/websocket"
+)
+
+// maxRequestSize is the max size of a single JSON-RPC request, whether it
+// arrives over plain HTTP or as a WebSocket message
+const maxRequestSize = 32 * 1024 * 1024 // 32 MB
+
+type jsonRPCServer struct {
+	onConnectedHandler server.OnConnectedHandler
+	listeningAddresses []string
+	allowedOrigins     map[string]struct{}
+	httpServers        []*http.Server
+
+	maxClients      int
+	maxWebSockets   int
+	clientCount     int
+	webSocketCount  int
+	clientCountLock sync.Mutex
+}
+
+// NewJSONRPCServer creates a new server that serves JSON-RPC 2.0 requests
+// over plain HTTP POST requests and over WebSockets. Every JSON-RPC method
+// maps onto one of the RPC request messages, so the server is driven by the
+// same router initializer as the gRPC RPC server.
+//
+// Every HTTP request being served and every open WebSocket counts as a
+// client against maxClients, and WebSockets are further limited by
+// maxWebSockets. Requests from web pages, which carry an Origin header, are
+// refused unless they come from the node's own origin or from one of
+// allowedOrigins, so that any page the operator opens can't call the RPC
+// server of a local node. An allowed origin of "*" allows every origin.
+func NewJSONRPCServer(listeningAddresses []string, maxClients int, maxWebSockets int,
+	allowedOrigins []string) (server.Server, error) {
+
+	allowedOriginsSet := make(map[string]struct{}, len(allowedOrigins))
+	for _, allowedOrigin := range allowedOrigins {
+		allowedOriginsSet[strings.ToLower(strings.TrimSuffix(allowedOrigin, "/"))] = struct{}{}
+	}
+	return &jsonRPCServer{
+		listeningAddresses: listeningAddresses,
+		allowedOrigins:     allowedOriginsSet,
+		maxClients:         maxClients,
+		maxWebSockets:      maxWebSockets,
+	}, nil
+}
+
+func (s *jsonRPCServer) Start() error {
+	if s.onConnectedHandler == nil {
+		return errors.New("onConnectedHandler is nil")
+	}
+
+	for _, listenAddress := range s.listeningAddresses {
+		err := s.listenOn(listenAddress)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *jsonRPCServer) listenOn(listenAddress string) error {
+	listener, err := net.Listen("tcp", listenAddress)
+	if err != nil {
+		return errors.Wrapf(err, "JSON-RPC error listening on %s", listenAddress)
+	}
+
+	httpServer := &http.Server{Handler: s}
+	s.httpServers = append(s.httpServers, httpServer)
+
+	spawn("jsonRPCServer.listenOn-Serve", func() {
+		err := httpServer.Serve(listener)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			panics.Exit(log, fmt.Sprintf("error serving JSON-RPC on %s: %+v", listenAddress, err))
+		}
+	})
+
+	log.Infof("JSON-RPC Server listening on %s", listener.Addr())
+	return nil
+}
+
+func (s *jsonRPCServer) Stop() error {
+	const stopTimeout = 2 * time.Second
+
+	for _, httpServer := range s.httpServers {
+		ctx, cancel := context.WithTimeout(context.Background(), stopTimeout)
+		err := httpServer.Shutdown(ctx)
+		cancel()
+		if err != nil {
+			log.Warnf("Could not gracefully stop the JSON-RPC server: %s", err)
+			_ = httpServer.Close()
+		}
+	}
+	return nil
+}
+
+// SetOnConnectedHandler sets the client connected handler
+// function for the server
+func (s *jsonRPCServer) SetOnConnectedHandler(onConnectedHandler server.OnConnectedHandler) {
+	s.onConnectedHandler = onConnectedHandler
+}
+
+func (s *jsonRPCServer) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	defer panics.HandlePanic(log, "jsonRPCServer.ServeHTTP", nil)
+
+	if strings.EqualFold(request.Header.Get("Upgrade"), "websocket") {
+		websocket.Server{Handler: s.handleWebSocket, Handshake: s.checkWebSocketOrigin}.ServeHTTP(writer, request)
+		return
+	}
+	if request.Method != http.MethodPost {
+		writer.Header().Set("Allow", http.MethodPost)
+		http.Error(writer, "JSON-RPC requests must be sent with POST", http.StatusMethodNotAllowed)
+		return
+	}
+	err := s.checkOrigin(request)
+	if err != nil {
+		log.Warnf("Rejecting JSON-RPC request from %s: %s", request.RemoteAddr, err)
+		http.Error(writer, err.Error(), http.StatusForbidden)
+		return
+	}
+	// Web pages may only send a cross-origin POST request without a CORS
+	// preflight if its content type is a form or plain text
+	mediaType, _, err := mime.ParseMediaType(request.Header.Get("Content-Type"))
+	if err != nil || mediaType != "application/json" {
+		http.Error(writer, "JSON-RPC requests must have the content type application/json",
+			http.StatusUnsupportedMediaType)
+		return
+	}
+
+	_, err = s.incrementClientCountAndLimitIfRequired(false)
+	if err != nil {
+		log.Warnf("Rejecting JSON-RPC request from %s: %s", request.RemoteAddr, err)
+		http.Error(writer, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	defer s.decrementClientCount(false)
+
+	s.handleHTTPRequest(writer, request)
+}
+
+// checkOrigin returns an error if the given request was sent by a web page
+// whose origin isn't allowed. Requests without an Origin header weren't
+// sent by a browser, so they're allowed.
+func (s *jsonRPCServer) checkOrigin(request *http.Request) error {
+	origin := request.Header.Get("Origin")
+	if origin == "" {
+		return nil
+	}
+	if _, ok := s.allowedOrigins["*"]; ok {
+		return nil
+	}
+	if _, ok := s.allowedOrigins[strings.ToLower(origin)]; ok {
+		return nil
+	}
+	originURL, err := url.Parse(origin)
+	if err == nil && strings.EqualFold(originURL.Host, request.Host) {
+		return nil
+	}
+	return errors.Errorf("origin %s is not allowed", origin)
+}
+
+func (s *jsonRPCServer) checkWebSocketOrigin(_ *websocket.Config, request *http.Request) error {
+	err := s.checkOrigin(request)
+	if err != nil {
+		log.Warnf("Rejecting WebSocket from %s: %s", request.RemoteAddr, err)
+	}
+	return err
+}
+
+func (s *jsonRPCServer) handleHTTPRequest(writer http.ResponseWriter, httpRequest *http.Request) {
+	data, err := io.ReadAll(http.MaxBytesReader(writer, httpRequest.Body, maxRequestSize))
+	if err != nil {
+		writeHTTPResponse(writer, newErrorResponse(nil, newJSONRPCError(errorCodeParse, "parse error: %s", err)))
+		return
+	}
+	request := &jsonRPCRequest{}
+	err = json.Unmarshal(data, request)
+	if err != nil {
+		writeHTTPResponse(writer, newErrorResponse(nil, newJSONRPCError(errorCodeParse, "parse error: %s", err)))
+		return
+	}
+
+	message, rpcErr := toAppMessage(request)
+	if rpcErr == nil && isSubscriptionMethod(request.Method) {
+		rpcErr = newJSONRPCError(errorCodeInvalidRequest,
+			"method %s is only available over WebSocket connections", request.Method)
+	}
+	if rpcErr != nil {
+		writeHTTPResponse(writer, newErrorResponse(request.ID, rpcErr))
+		return
+	}
+
+	address, err := net.ResolveTCPAddr("tcp", httpRequest.RemoteAddr)
+	if err != nil {
+		writeHTTPResponse(writer, newErrorResponse(request.ID, newJSONRPCError(errorCodeInternal, "%s", err)))
+		return
+	}
+	connection := newConnection(address, nil, authorization(httpRequest))
+	err = s.onConnectedHandler(connection)
+	if err != nil {
+		writeHTTPResponse(writer, newErrorResponse(request.ID, newJSONRPCError(errorCodeInternal, "%s", err)))
+		return
+	}
+
+	response := connection.exchange(request, message)
+	if request.isNotification() {
+		writer.WriteHeader(http.StatusNoContent)
+		return
+	}
+	writeHTTPResponse(writer, response)
+}
+
+func writeHTTPResponse(writer http.ResponseWriter, response *jsonRPCResponse) {
+	writer.Header().Set("Content-Type", "application/json")
+	err := json.NewEncoder(writer).Encode(response)
+	if err != nil {
+		log.Debugf("Error writing a JSON-RPC response: %s", err)
+	}
+}
+
+func (s *jsonRPCServer) handleWebSocket(webSocket *websocket.Conn) {
+	webSocketCount, err := s.incrementClientCountAndLimitIfRequired(true)
+	if err != nil {
+		log.Warnf("Rejecting WebSocket from %s: %s", webSocket.Request().RemoteAddr, err)
+		return
+	}
+	defer s.decrementClientCount(true)
+
+	address, err := net.ResolveTCPAddr("tcp", webSocket.Request().RemoteAddr)
+	if err != nil {
+		log.Warnf("Rejecting WebSocket from %s: %s", webSocket.Request().RemoteAddr, err)
+		return
+	}
+
+	webSocket.MaxPayloadBytes = maxRequestSize
+	connection := newConnection(address, webSocket, authorization(webSocket.Request()))
+	err = s.onConnectedHandler(connection)
+	if err != nil {
+		log.Warnf("Rejecting WebSocket from %s: %s", address, err)
+		return
+	}
+
+	log.Infof("JSON-RPC WebSocket connection from %s #%d", address, webSocketCount)
+
+	<-connection.stopChan
+}
+
+// authorization returns the credentials presented with the given request.
+// Since browsers can't set headers on WebSocket requests, a bearer token
+// may also be passed in the access_token query parameter.
+func authorization(request *http.Request) string {
+	authorizationHeader := request.Header.Get("Authorization")
+	if authorizationHeader != "" {
+		return authorizationHeader
+	}
+	accessToken := request.URL.Query().Get("access_token")
+	if accessToken != "" {
+		return "Bearer " + accessToken
+	}
+	return ""
+}
+
+// incrementClientCountAndLimitIfRequired counts a new client, and returns
+// the number of WebSockets if it's one
+func (s *jsonRPCServer) incrementClientCountAndLimitIfRequired(isWebSocket bool) (int, error) {
+	s.clientCountLock.Lock()
+	defer s.clientCountLock.Unlock()
+
+	if s.maxClients > 0 && s.clientCount == s.maxClients {
+		return s.webSocketCount, errors.Errorf("limit of %d JSON-RPC clients has been exceeded", s.maxClients)
+	}
+	if isWebSocket {
+		if s.maxWebSockets > 0 && s.webSocketCount == s.maxWebSockets {
+			return s.webSocketCount, errors.Errorf("limit of %d JSON-RPC WebSocket connections has been exceeded",
+				s.maxWebSockets)
+		}
+		s.webSocketCount++
+	}
+
+	s.clientCount++
+	return s.webSocketCount, nil
+}
+
+func (s *jsonRPCServer) decrementClientCount(isWebSocket bool) {
+	s.clientCountLock.Lock()
+	defer s.clientCountLock.Unlock()
+
+	if isWebSocket {
+		s.webSocketCount--
+	}
+	s.clientCount--
+}
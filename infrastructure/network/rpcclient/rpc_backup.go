@@ -0,0 +1,22 @@
+package rpcclient
+
+import "github.com/kobradag/kobrad/app/appmessage"
+
+// Backup sends an RPC request respective to the function's name and returns the RPC server's response.
+// The backup may take a while, so the timeout of the client should be set accordingly. The server keeps handling
+// the other requests of the client meanwhile, but refuses any other backup request on the same connection.
+func (c *RPCClient) Backup(targetDirectory string) (*appmessage.BackupResponseMessage, error) {
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewBackupRequestMessage(targetDirectory))
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdBackupResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	backupResponse := response.(*appmessage.BackupResponseMessage)
+	if backupResponse.Error != nil {
+		return nil, c.convertRPCError(backupResponse.Error)
+	}
+	return backupResponse, nil
+}
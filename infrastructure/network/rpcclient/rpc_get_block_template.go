@@ -4,7 +4,32 @@ import "github.com/kobradag/kobrad/app/appmessage"
 
 // GetBlockTemplate sends an RPC request respective to the function's name and returns the RPC server's response
 func (c *RPCClient) GetBlockTemplate(miningAddress, extraData string) (*appmessage.GetBlockTemplateResponseMessage, error) {
-	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewGetBlockTemplateRequestMessage(miningAddress, extraData))
+	return c.getBlockTemplate(appmessage.NewGetBlockTemplateRequestMessage(miningAddress, extraData))
+}
+
+// GetBlockTemplateWithPayees sends an RPC request respective to the function's name and returns the RPC server's response.
+// The rewards of the block are split to the given payees in proportion to their weights.
+func (c *RPCClient) GetBlockTemplateWithPayees(payees []*appmessage.RPCCoinbasePayee,
+	extraData string) (*appmessage.GetBlockTemplateResponseMessage, error) {
+
+	return c.getBlockTemplate(appmessage.NewGetBlockTemplateRequestMessageWithPayees(payees, extraData))
+}
+
+// GetBlockTemplateLongPoll operates the same as GetBlockTemplate, except that the RPC server responds only once
+// the template differs materially from the template of the given ID, or after the server's long-poll timeout.
+// The server refuses any other block template request on the same connection while the long poll is pending.
+func (c *RPCClient) GetBlockTemplateLongPoll(miningAddress, extraData, longPollID string) (
+	*appmessage.GetBlockTemplateResponseMessage, error) {
+
+	request := appmessage.NewGetBlockTemplateRequestMessage(miningAddress, extraData)
+	request.LongPollID = longPollID
+	return c.getBlockTemplate(request)
+}
+
+func (c *RPCClient) getBlockTemplate(request *appmessage.GetBlockTemplateRequestMessage) (
+	*appmessage.GetBlockTemplateResponseMessage, error) {
+
+	err := c.rpcRouter.outgoingRoute().Enqueue(request)
 	if err != nil {
 		return nil, err
 	}
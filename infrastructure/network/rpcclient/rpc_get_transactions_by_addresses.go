@@ -0,0 +1,28 @@
+package rpcclient
+
+import (
+	"github.com/kobradag/kobrad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+// GetTransactionsByAddresses sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) GetTransactionsByAddresses(addresses []string, cursor string,
+	limit uint32) (*appmessage.GetTransactionsByAddressesResponseMessage, error) {
+
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewGetTransactionsByAddressesRequestMessage(addresses, cursor, limit))
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdGetTransactionsByAddressesResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	getTransactionsByAddressesResponse := response.(*appmessage.GetTransactionsByAddressesResponseMessage)
+	if getTransactionsByAddressesResponse.Error != nil {
+		if getTransactionsByAddressesResponse.Error.Message == appmessage.AddressIndexUnavailableMessage {
+			return nil, errors.WithStack(ErrAddressIndexUnavailable)
+		}
+		return nil, c.convertRPCError(getTransactionsByAddressesResponse.Error)
+	}
+	return getTransactionsByAddressesResponse, nil
+}
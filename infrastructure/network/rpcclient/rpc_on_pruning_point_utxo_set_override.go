@@ -10,18 +10,10 @@ import (
 // Additionally, it starts listening for the appropriate notification using the given handler function
 func (c *RPCClient) RegisterPruningPointUTXOSetNotifications(onPruningPointUTXOSetNotifications func()) error {
 
-	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewNotifyPruningPointUTXOSetOverrideRequestMessage())
-	if err != nil {
-		return err
-	}
-	response, err := c.route(appmessage.CmdNotifyPruningPointUTXOSetOverrideResponseMessage).DequeueWithTimeout(c.timeout)
+	err := c.RenewPruningPointUTXOSetNotifications()
 	if err != nil {
 		return err
 	}
-	notifyPruningPointUTXOSetOverrideResponse := response.(*appmessage.NotifyPruningPointUTXOSetOverrideResponseMessage)
-	if notifyPruningPointUTXOSetOverrideResponse.Error != nil {
-		return c.convertRPCError(notifyPruningPointUTXOSetOverrideResponse.Error)
-	}
 	spawn("RegisterPruningPointUTXOSetNotifications", func() {
 		for {
 			notification, err := c.route(appmessage.CmdPruningPointUTXOSetOverrideNotificationMessage).Dequeue()
@@ -38,6 +30,25 @@ func (c *RPCClient) RegisterPruningPointUTXOSetNotifications(onPruningPointUTXOS
 	return nil
 }
 
+// RenewPruningPointUTXOSetNotifications sends the RPC request of RegisterPruningPointUTXOSetNotifications
+// without starting another listener. The listener keeps handling notifications after the client reconnects,
+// but the node forgets the registration, so it has to be renewed.
+func (c *RPCClient) RenewPruningPointUTXOSetNotifications() error {
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewNotifyPruningPointUTXOSetOverrideRequestMessage())
+	if err != nil {
+		return err
+	}
+	response, err := c.route(appmessage.CmdNotifyPruningPointUTXOSetOverrideResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return err
+	}
+	notifyPruningPointUTXOSetOverrideResponse := response.(*appmessage.NotifyPruningPointUTXOSetOverrideResponseMessage)
+	if notifyPruningPointUTXOSetOverrideResponse.Error != nil {
+		return c.convertRPCError(notifyPruningPointUTXOSetOverrideResponse.Error)
+	}
+	return nil
+}
+
 // UnregisterPruningPointUTXOSetNotifications sends an RPC request respective to the function's name and returns the RPC server's response.
 // Additionally, it stops listening for the appropriate notification using the given handler function
 func (c *RPCClient) UnregisterPruningPointUTXOSetNotifications() error {
@@ -6,8 +6,12 @@ import (
 )
 
 func (c *RPCClient) submitBlock(block *externalapi.DomainBlock, allowNonDAABlocks bool) (appmessage.RejectReason, error) {
-	err := c.rpcRouter.outgoingRoute().Enqueue(
+	return c.sendSubmitBlockRequest(
 		appmessage.NewSubmitBlockRequestMessage(appmessage.DomainBlockToRPCBlock(block), allowNonDAABlocks))
+}
+
+func (c *RPCClient) sendSubmitBlockRequest(request *appmessage.SubmitBlockRequestMessage) (appmessage.RejectReason, error) {
+	err := c.rpcRouter.outgoingRoute().Enqueue(request)
 	if err != nil {
 		return appmessage.RejectReasonNone, err
 	}
@@ -31,3 +35,9 @@ func (c *RPCClient) SubmitBlock(block *externalapi.DomainBlock) (appmessage.Reje
 func (c *RPCClient) SubmitBlockAlsoIfNonDAA(block *externalapi.DomainBlock) (appmessage.RejectReason, error) {
 	return c.submitBlock(block, true)
 }
+
+// SubmitBlockByTemplateID sends an RPC request respective to the function's name and returns the RPC server's response.
+// The submitted block is the template of the given ID with the given nonce, and with the given timestamp unless it's 0.
+func (c *RPCClient) SubmitBlockByTemplateID(templateID string, nonce uint64, timestamp int64) (appmessage.RejectReason, error) {
+	return c.sendSubmitBlockRequest(appmessage.NewSubmitBlockByTemplateIDRequestMessage(templateID, nonce, timestamp, false))
+}
@@ -0,0 +1,22 @@
+package rpcclient
+
+import "github.com/kobradag/kobrad/app/appmessage"
+
+// SubmitTransactionReplacement sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) SubmitTransactionReplacement(transaction *appmessage.RPCTransaction) (
+	*appmessage.SubmitTransactionReplacementResponseMessage, error) {
+
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewSubmitTransactionReplacementRequestMessage(transaction))
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdSubmitTransactionReplacementResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	submitTransactionReplacementResponse := response.(*appmessage.SubmitTransactionReplacementResponseMessage)
+	if submitTransactionReplacementResponse.Error != nil {
+		return nil, c.convertRPCError(submitTransactionReplacementResponse.Error)
+	}
+	return submitTransactionReplacementResponse, nil
+}
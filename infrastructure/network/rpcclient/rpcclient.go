@@ -20,20 +20,30 @@ type RPCClient struct {
 	*grpcclient.GRPCClient
 
 	rpcAddress           string
+	authorization        string
 	rpcRouter            *rpcRouter
 	isConnected          uint32
 	isClosed             uint32
 	isReconnecting       uint32
 	lastDisconnectedTime time.Time
 
+	onReconnectedHandler func()
+
 	timeout time.Duration
 }
 
 // NewRPCClient сreates a new RPC client with a default call timeout value
 func NewRPCClient(rpcAddress string) (*RPCClient, error) {
+	return NewRPCClientWithAuthorization(rpcAddress, "")
+}
+
+// NewRPCClientWithAuthorization creates a new RPC client with a default call
+// timeout value, which authenticates by presenting the given authorization
+func NewRPCClientWithAuthorization(rpcAddress string, authorization string) (*RPCClient, error) {
 	rpcClient := &RPCClient{
-		rpcAddress: rpcAddress,
-		timeout:    defaultTimeout,
+		rpcAddress:    rpcAddress,
+		authorization: authorization,
+		timeout:       defaultTimeout,
 	}
 	err := rpcClient.connect()
 	if err != nil {
@@ -44,7 +54,7 @@ func NewRPCClient(rpcAddress string) (*RPCClient, error) {
 }
 
 func (c *RPCClient) connect() error {
-	rpcClient, err := grpcclient.Connect(c.rpcAddress)
+	rpcClient, err := grpcclient.ConnectWithAuthorization(c.rpcAddress, c.authorization)
 	if err != nil {
 		return errors.Wrapf(err, "error connecting to address %s", c.rpcAddress)
 	}
@@ -118,6 +128,9 @@ func (c *RPCClient) Reconnect() error {
 		if time.Since(c.lastDisconnectedTime) > retryDelay {
 			err := c.connect()
 			if err == nil {
+				if c.onReconnectedHandler != nil {
+					c.onReconnectedHandler()
+				}
 				return nil
 			}
 			log.Warnf("Could not automatically reconnect to %s: %s", c.rpcAddress, err)
@@ -150,6 +163,13 @@ func (c *RPCClient) handleClientError(err error) {
 	c.handleClientDisconnected()
 }
 
+// SetOnReconnectedHandler sets a handler that is called whenever the client
+// reconnects. Notification registrations don't survive a reconnection, so
+// this is where they should be made again.
+func (c *RPCClient) SetOnReconnectedHandler(onReconnectedHandler func()) {
+	c.onReconnectedHandler = onReconnectedHandler
+}
+
 // SetTimeout sets the timeout by which to wait for RPC responses
 func (c *RPCClient) SetTimeout(timeout time.Duration) {
 	c.timeout = timeout
@@ -177,6 +197,10 @@ func (c *RPCClient) route(command appmessage.MessageCommand) *routerpkg.Route {
 // ErrRPC is an error in the RPC protocol
 var ErrRPC = errors.New("rpc error")
 
+// ErrAddressIndexUnavailable is returned by the address index methods when
+// the node runs without --addressindex
+var ErrAddressIndexUnavailable = errors.New("the address index is unavailable")
+
 func (c *RPCClient) convertRPCError(rpcError *appmessage.RPCError) error {
 	return errors.Wrap(ErrRPC, rpcError.Message)
 }
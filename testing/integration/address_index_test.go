@@ -0,0 +1,102 @@
+package integration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kobradag/kobrad/app/appmessage"
+	"github.com/kobradag/kobrad/domain/consensus/utils/consensushashing"
+)
+
+func TestAddressIndex(t *testing.T) {
+	// Setup a single kobrad instance
+	harnessParams := &harnessParams{
+		p2pAddress:              p2pAddress1,
+		rpcAddress:              rpcAddress1,
+		miningAddress:           miningAddress1,
+		miningAddressPrivateKey: miningAddress1PrivateKey,
+		addressIndex:            true,
+	}
+	kobrad, teardown := setupHarness(t, harnessParams)
+	defer teardown()
+
+	// skip the first block because it's paying to genesis script
+	mineNextBlock(t, kobrad)
+
+	// Mine some blocks. The coinbase transaction of every block pays
+	// the mining address and is accepted by the block mined on top of it
+	const blockAmountToMine = 10
+	coinbaseTransactionIDs := make(map[string]struct{}, blockAmountToMine)
+	for i := 0; i < blockAmountToMine; i++ {
+		block := mineNextBlock(t, kobrad)
+		coinbaseTransactionIDs[consensushashing.TransactionID(block.Transactions[0]).String()] = struct{}{}
+	}
+
+	// The address index is updated asynchronously, so we wait until
+	// all the coinbase transactions but the last appear in it
+	var allEntries []*appmessage.TransactionsByAddressesEntry
+	deadline := time.Now().Add(defaultTimeout)
+	for {
+		response, err := kobrad.rpcClient.GetTransactionsByAddresses([]string{miningAddress1}, "", 0)
+		if err != nil {
+			t.Fatalf("Error getting transactions by addresses: %s", err)
+		}
+		allEntries = response.Entries
+		if len(allEntries) >= blockAmountToMine-1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected at least %d entries but got %d", blockAmountToMine-1, len(allEntries))
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	for i, entry := range allEntries {
+		if entry.Address != miningAddress1 {
+			t.Fatalf("Unexpected address. Want: %s, got: %s", miningAddress1, entry.Address)
+		}
+		if _, ok := coinbaseTransactionIDs[entry.TransactionID]; !ok {
+			t.Fatalf("Unexpected transaction %s", entry.TransactionID)
+		}
+		if entry.Received == 0 || entry.Sent != 0 {
+			t.Fatalf("Unexpected amounts of coinbase transaction %s: received %d, sent %d",
+				entry.TransactionID, entry.Received, entry.Sent)
+		}
+		if i > 0 && entry.AcceptingBlockDAAScore < allEntries[i-1].AcceptingBlockDAAScore {
+			t.Fatalf("Entries are not ordered by their accepting block DAA score")
+		}
+	}
+
+	// Page through the history and make sure the pages add up to it
+	const pageSize = 3
+	var pagedEntries []*appmessage.TransactionsByAddressesEntry
+	cursor := ""
+	for {
+		response, err := kobrad.rpcClient.GetTransactionsByAddresses([]string{miningAddress1}, cursor, pageSize)
+		if err != nil {
+			t.Fatalf("Error getting transactions by addresses: %s", err)
+		}
+		if len(response.Entries) > pageSize {
+			t.Fatalf("Expected at most %d entries but got %d", pageSize, len(response.Entries))
+		}
+		pagedEntries = append(pagedEntries, response.Entries...)
+		if response.NextCursor == "" {
+			break
+		}
+		cursor = response.NextCursor
+	}
+	if len(pagedEntries) < len(allEntries) {
+		t.Fatalf("Expected at least %d paged entries but got %d", len(allEntries), len(pagedEntries))
+	}
+	for i, entry := range allEntries {
+		if *pagedEntries[i] != *entry {
+			t.Fatalf("Unexpected paged entry %d. Want: %+v, got: %+v", i, entry, pagedEntries[i])
+		}
+	}
+
+	// Make sure that a malformed cursor is reported as an error
+	_, err := kobrad.rpcClient.GetTransactionsByAddresses([]string{miningAddress1}, "not-a-cursor", 0)
+	if err == nil {
+		t.Fatalf("Expected an error when using a malformed cursor")
+	}
+}
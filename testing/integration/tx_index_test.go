@@ -0,0 +1,71 @@
+package integration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kobradag/kobrad/app/appmessage"
+	"github.com/kobradag/kobrad/domain/consensus/utils/consensushashing"
+)
+
+func TestTXIndex(t *testing.T) {
+	// Setup a single kobrad instance
+	harnessParams := &harnessParams{
+		p2pAddress:              p2pAddress1,
+		rpcAddress:              rpcAddress1,
+		miningAddress:           miningAddress1,
+		miningAddressPrivateKey: miningAddress1PrivateKey,
+		txIndex:                 true,
+	}
+	kobrad, teardown := setupHarness(t, harnessParams)
+	defer teardown()
+
+	// Mine some blocks. The coinbase transaction of every block
+	// is accepted by the block mined on top of it
+	const blockAmountToMine = 10
+	firstBlock := mineNextBlock(t, kobrad)
+	for i := 1; i < blockAmountToMine; i++ {
+		mineNextBlock(t, kobrad)
+	}
+
+	firstBlockHash := consensushashing.BlockHash(firstBlock)
+	coinbaseTransactionID := consensushashing.TransactionID(firstBlock.Transactions[0])
+
+	// The TX index is updated asynchronously, so we wait until
+	// the coinbase transaction appears in it
+	var response *appmessage.GetTransactionResponseMessage
+	var err error
+	deadline := time.Now().Add(defaultTimeout)
+	for {
+		response, err = kobrad.rpcClient.GetTransaction(coinbaseTransactionID.String())
+		if err == nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Error getting transaction %s: %s", coinbaseTransactionID, err)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	if response.IncludingBlockHash != firstBlockHash.String() {
+		t.Fatalf("Unexpected including block hash. Want: %s, got: %s",
+			firstBlockHash, response.IncludingBlockHash)
+	}
+	if response.AcceptingBlockHash == firstBlockHash.String() {
+		t.Fatalf("A block is not expected to accept its own coinbase transaction")
+	}
+	if response.Transaction.VerboseData.TransactionID != coinbaseTransactionID.String() {
+		t.Fatalf("Unexpected transaction ID. Want: %s, got: %s",
+			coinbaseTransactionID, response.Transaction.VerboseData.TransactionID)
+	}
+	if response.Confirmations == 0 || response.Confirmations >= blockAmountToMine {
+		t.Fatalf("Unexpected amount of confirmations: %d", response.Confirmations)
+	}
+
+	// Make sure that an unknown transaction is reported as an error
+	_, err = kobrad.rpcClient.GetTransaction(
+		"0000000000000000000000000000000000000000000000000000000000000000")
+	if err == nil {
+		t.Fatalf("Expected an error when getting an unknown transaction")
+	}
+}